@@ -8,6 +8,10 @@ var Config = struct {
 	Host string `env:"HOST" envDefault:"localhost"`
 	// Port - Flagr server port
 	Port int `env:"PORT" envDefault:"18000"`
+	// GRPCEnabled - to enable the gRPC evaluation service, which listens on its own port
+	GRPCEnabled bool `env:"FLAGR_GRPC_ENABLED" envDefault:"false"`
+	// GRPCPort - the port of the gRPC evaluation service
+	GRPCPort int `env:"FLAGR_GRPC_PORT" envDefault:"18001"`
 
 	// LogrusLevel sets the logrus logging level
 	LogrusLevel string `env:"FLAGR_LOGRUS_LEVEL" envDefault:"info"`
@@ -39,9 +43,35 @@ var Config = struct {
 	EvalCacheRefreshTimeout time.Duration `env:"FLAGR_EVALCACHE_REFRESHTIMEOUT" envDefault:"59s"`
 	// EvalCacheRefreshInterval - time interval of getting the flags data from DB into the in-memory evaluation cache
 	EvalCacheRefreshInterval time.Duration `env:"FLAGR_EVALCACHE_REFRESHINTERVAL" envDefault:"3s"`
+	// EvalChangesHeartbeatInterval - time interval of the heartbeats of the flag changes stream when there are no changes,
+	// which keeps the idle connection from being closed by the proxies. A value <= 0 disables the heartbeats
+	EvalChangesHeartbeatInterval time.Duration `env:"FLAGR_EVAL_CHANGES_HEARTBEAT_INTERVAL" envDefault:"15s"`
 	// EvalOnlyMode - will only expose the evaluation related endpoints.
 	// This field will be derived from DBDriver
 	EvalOnlyMode bool `env:"FLAGR_EVAL_ONLY_MODE" envDefault:"false"`
+	// EvalBatchConcurrency - the max number of entities of a batch evaluation request evaluated concurrently
+	EvalBatchConcurrency int `env:"FLAGR_EVAL_BATCH_CONCURRENCY" envDefault:"8"`
+	// EvalStickyAssignmentStore - the store of the sticky assignments of the flags with stickyAssignments
+	// Possible values: sql, memory. The memory store is per replica and lost on restarts, it's mostly for tests.
+	EvalStickyAssignmentStore string `env:"FLAGR_EVAL_STICKY_ASSIGNMENT_STORE" envDefault:"sql"`
+	// EvalStickyAssignmentTimeout - timeout of reading and writing the sticky assignments in the sql store.
+	// The evaluation continues without the assignment if the read times out.
+	EvalStickyAssignmentTimeout time.Duration `env:"FLAGR_EVAL_STICKY_ASSIGNMENT_TIMEOUT" envDefault:"200ms"`
+	// EvalStickyAssignmentCacheSize - the max number of the sticky assignments cached in memory by the sql store, 0 disables the cache
+	EvalStickyAssignmentCacheSize int `env:"FLAGR_EVAL_STICKY_ASSIGNMENT_CACHE_SIZE" envDefault:"100000"`
+	// EvalStickyAssignmentWriteQueueSize - the max number of the new sticky assignments waiting to be written by the sql store,
+	// the new assignments are dropped when it's full
+	EvalStickyAssignmentWriteQueueSize int `env:"FLAGR_EVAL_STICKY_ASSIGNMENT_WRITE_QUEUE_SIZE" envDefault:"10000"`
+	// EvalStickyAssignmentCleanupInterval - time interval of deleting the sticky assignments of the old salts and the deleted flags
+	// from the sql store, if they're not written during the last interval. It should be much longer than EvalCacheRefreshInterval.
+	// A value <= 0 disables the cleanup.
+	EvalStickyAssignmentCleanupInterval time.Duration `env:"FLAGR_EVAL_STICKY_ASSIGNMENT_CLEANUP_INTERVAL" envDefault:"1h"`
+
+	// SchedulerEnabled - to apply the scheduled changes and the rollout ramp steps of flags in the background.
+	// It's safe to enable it on multiple replicas sharing the same DB, each change or step is only applied once.
+	SchedulerEnabled bool `env:"FLAGR_SCHEDULER_ENABLED" envDefault:"true"`
+	// SchedulerInterval - time interval of checking the due scheduled changes and rollout ramp steps
+	SchedulerInterval time.Duration `env:"FLAGR_SCHEDULER_INTERVAL" envDefault:"10s"`
 
 	/**
 	DBDriver and DBConnectionStr define how we can write and read flags data.
@@ -188,7 +218,7 @@ var Config = struct {
 	*/
 	JWTAuthEnabled              bool     `env:"FLAGR_JWT_AUTH_ENABLED" envDefault:"false"`
 	JWTAuthDebug                bool     `env:"FLAGR_JWT_AUTH_DEBUG" envDefault:"false"`
-	JWTAuthPrefixWhitelistPaths []string `env:"FLAGR_JWT_AUTH_WHITELIST_PATHS" envDefault:"/api/v1/health,/api/v1/evaluation,/static" envSeparator:","`
+	JWTAuthPrefixWhitelistPaths []string `env:"FLAGR_JWT_AUTH_WHITELIST_PATHS" envDefault:"/api/v1/health,/api/v1/evaluation,/api/v1/ofrep,/static" envSeparator:","`
 	JWTAuthExactWhitelistPaths  []string `env:"FLAGR_JWT_AUTH_EXACT_WHITELIST_PATHS" envDefault:",/" envSeparator:","`
 	JWTAuthCookieTokenName      string   `env:"FLAGR_JWT_AUTH_COOKIE_TOKEN_NAME" envDefault:"access_token"`
 	JWTAuthSecret               string   `env:"FLAGR_JWT_AUTH_SECRET" envDefault:""`
@@ -202,6 +232,10 @@ var Config = struct {
 	// "HS256" and "RS256" supported
 	JWTAuthSigningMethod string `env:"FLAGR_JWT_AUTH_SIGNING_METHOD" envDefault:"HS256"`
 
+	// JWTAuthPrefixExcludedPaths are the paths that need the token even if they're whitelisted, e.g. the
+	// evaluation endpoints that reveal the flag definitions and their history to any caller
+	JWTAuthPrefixExcludedPaths []string `env:"FLAGR_JWT_AUTH_EXCLUDED_PATHS" envDefault:"/api/v1/evaluation/dry_run,/api/v1/evaluation/snapshot,/api/v1/evaluation/simulation" envSeparator:","`
+
 	// Identify users through headers
 	HeaderAuthEnabled   bool   `env:"FLAGR_HEADER_AUTH_ENABLED" envDefault:"false"`
 	HeaderAuthUserField string `env:"FLAGR_HEADER_AUTH_USER_FIELD" envDefault:"X-Email"`
@@ -216,8 +250,11 @@ var Config = struct {
 	BasicAuthEnabled              bool     `env:"FLAGR_BASIC_AUTH_ENABLED" envDefault:"false"`
 	BasicAuthUsername             string   `env:"FLAGR_BASIC_AUTH_USERNAME" envDefault:""`
 	BasicAuthPassword             string   `env:"FLAGR_BASIC_AUTH_PASSWORD" envDefault:""`
-	BasicAuthPrefixWhitelistPaths []string `env:"FLAGR_BASIC_AUTH_WHITELIST_PATHS" envDefault:"/api/v1/health,/api/v1/flags,/api/v1/evaluation" envSeparator:","`
+	BasicAuthPrefixWhitelistPaths []string `env:"FLAGR_BASIC_AUTH_WHITELIST_PATHS" envDefault:"/api/v1/health,/api/v1/flags,/api/v1/evaluation,/api/v1/ofrep" envSeparator:","`
 	BasicAuthExactWhitelistPaths  []string `env:"FLAGR_BASIC_AUTH_EXACT_WHITELIST_PATHS" envDefault:"" envSeparator:","`
+	// BasicAuthPrefixExcludedPaths are the paths that need the basic auth even if they're whitelisted, e.g.
+	// the evaluation endpoints that reveal the flag definitions and their history to any caller
+	BasicAuthPrefixExcludedPaths []string `env:"FLAGR_BASIC_AUTH_EXCLUDED_PATHS" envDefault:"/api/v1/evaluation/dry_run,/api/v1/evaluation/snapshot,/api/v1/evaluation/simulation" envSeparator:","`
 
 	// WebPrefix - base path for web and API
 	// e.g. FLAGR_WEB_PREFIX=/foo
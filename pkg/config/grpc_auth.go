@@ -0,0 +1,68 @@
+package config
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/urfave/negroni"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type grpcAuthorizedKey struct{}
+
+// SetupGRPCAuthInterceptor setup an interceptor that authorizes the gRPC calls with the same JWT
+// and basic auth middlewares as the HTTP API. The metadata of a call are the headers of the request,
+// and paths maps the full method of a call to the path of the request, e.g. the endpoint of the REST
+// API that the method mirrors, so that the whitelists apply. It's nil if no auth is enabled.
+func SetupGRPCAuthInterceptor(paths map[string]string) grpc.UnaryServerInterceptor {
+	n := negroni.New()
+	if Config.JWTAuthEnabled {
+		n.Use(setupJWTAuthMiddleware())
+	}
+	if Config.BasicAuthEnabled {
+		n.Use(setupBasicAuthMiddleware())
+	}
+	if len(n.Handlers()) == 0 {
+		return nil
+	}
+	n.UseHandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		*r.Context().Value(grpcAuthorizedKey{}).(*bool) = true
+	})
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		path, ok := paths[info.FullMethod]
+		if !ok {
+			path = info.FullMethod
+		}
+
+		authorized := false
+		r, err := http.NewRequestWithContext(context.WithValue(ctx, grpcAuthorizedKey{}, &authorized), http.MethodPost, Config.WebPrefix+path, nil)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		for k, vs := range md {
+			for _, v := range vs {
+				r.Header.Add(k, v)
+			}
+		}
+
+		n.ServeHTTP(&discardResponseWriter{header: http.Header{}}, r)
+		if !authorized {
+			return nil, status.Error(codes.Unauthenticated, "Not authorized")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// discardResponseWriter discards the responses of the auth middlewares for the gRPC calls
+type discardResponseWriter struct {
+	header http.Header
+}
+
+func (w *discardResponseWriter) Header() http.Header         { return w.header }
+func (w *discardResponseWriter) Write(b []byte) (int, error) { return len(b), nil }
+func (w *discardResponseWriter) WriteHeader(int)             {}
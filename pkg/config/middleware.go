@@ -36,7 +36,7 @@ func SetupGlobalMiddleware(handler http.Handler) http.Handler {
 	n := negroni.New()
 
 	if Config.MiddlewareGzipEnabled {
-		n.Use(gzip.Gzip(gzip.DefaultCompression))
+		n.Use(&skipEventStreamMiddleware{Handler: gzip.Gzip(gzip.DefaultCompression)})
 	}
 
 	if Config.MiddlewareVerboseLoggerEnabled {
@@ -108,7 +108,39 @@ func SetupGlobalMiddleware(handler http.Handler) http.Handler {
 		n.UseHandler(handler)
 	}
 
-	return n
+	return withoutEventStreamWriteDeadline(n)
+}
+
+// isEventStream tells if the request is for a stream of server-sent events, e.g. the flag changes stream
+func isEventStream(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
+// withoutEventStreamWriteDeadline lifts the write timeout of the server for the long-lived event
+// streams. It wraps all the middlewares, as their response writers cannot set the deadline.
+func withoutEventStreamWriteDeadline(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isEventStream(r) {
+			if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+				logrus.WithField("err", err).Warn("failed to lift the write deadline of the event stream")
+			}
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// skipEventStreamMiddleware skips the middleware for the event streams, e.g. the gzip one that
+// buffers the events instead of flushing them one by one
+type skipEventStreamMiddleware struct {
+	negroni.Handler
+}
+
+func (s *skipEventStreamMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+	if isEventStream(r) {
+		next(w, r)
+		return
+	}
+	s.Handler.ServeHTTP(w, r, next)
 }
 
 type recoveryLogger struct{}
@@ -153,6 +185,7 @@ func setupJWTAuthMiddleware() *jwtAuth {
 	return &jwtAuth{
 		PrefixWhitelistPaths: Config.JWTAuthPrefixWhitelistPaths,
 		ExactWhitelistPaths:  Config.JWTAuthExactWhitelistPaths,
+		PrefixExcludedPaths:  Config.JWTAuthPrefixExcludedPaths,
 		JWTMiddleware: jwtmiddleware.New(jwtmiddleware.Options{
 			ValidationKeyGetter: func(token *jwt.Token) (interface{}, error) {
 				return validationKey, errParsingKey
@@ -190,12 +223,19 @@ func jwtErrorHandler(w http.ResponseWriter, r *http.Request, err string) {
 type jwtAuth struct {
 	PrefixWhitelistPaths []string
 	ExactWhitelistPaths  []string
+	PrefixExcludedPaths  []string
 	JWTMiddleware        *jwtmiddleware.JWTMiddleware
 }
 
 func (a *jwtAuth) whitelist(req *http.Request) bool {
 	path := req.URL.Path
 
+	for _, p := range a.PrefixExcludedPaths {
+		if p != "" && strings.HasPrefix(path, p) {
+			return false
+		}
+	}
+
 	// If we set to 401 unauthorized, let the client handles the 401 itself
 	if Config.JWTAuthNoTokenStatusCode == http.StatusUnauthorized {
 		for _, p := range a.ExactWhitelistPaths {
@@ -230,6 +270,7 @@ func setupBasicAuthMiddleware() *basicAuth {
 		Password:             []byte(Config.BasicAuthPassword),
 		PrefixWhitelistPaths: Config.BasicAuthPrefixWhitelistPaths,
 		ExactWhitelistPaths:  Config.BasicAuthExactWhitelistPaths,
+		PrefixExcludedPaths:  Config.BasicAuthPrefixExcludedPaths,
 	}
 }
 
@@ -238,11 +279,18 @@ type basicAuth struct {
 	Password             []byte
 	PrefixWhitelistPaths []string
 	ExactWhitelistPaths  []string
+	PrefixExcludedPaths  []string
 }
 
 func (a *basicAuth) whitelist(req *http.Request) bool {
 	path := req.URL.Path
 
+	for _, p := range a.PrefixExcludedPaths {
+		if p != "" && strings.HasPrefix(path, p) {
+			return false
+		}
+	}
+
 	for _, p := range a.ExactWhitelistPaths {
 		if p == path {
 			return true
@@ -118,6 +118,27 @@ func TestJWTAuthMiddleware(t *testing.T) {
 		assert.Equal(t, http.StatusOK, res.Code)
 	})
 
+	t.Run("it will redirect if jwt enabled with an excluded path under the whitelist", func(t *testing.T) {
+		Config.JWTAuthEnabled = true
+		defer func() { Config.JWTAuthEnabled = false }()
+		hh := SetupGlobalMiddleware(h)
+
+		for _, path := range []string{"/api/v1/evaluation/dry_run", "/api/v1/evaluation/snapshot", "/api/v1/evaluation/simulation"} {
+			res := httptest.NewRecorder()
+			res.Body = new(bytes.Buffer)
+			req, _ := http.NewRequest("POST", fmt.Sprintf("http://localhost:18000%s", path), nil)
+			hh.ServeHTTP(res, req)
+			assert.Equal(t, http.StatusTemporaryRedirect, res.Code, path)
+
+			res = httptest.NewRecorder()
+			res.Body = new(bytes.Buffer)
+			req, _ = http.NewRequest("POST", fmt.Sprintf("http://localhost:18000%s", path), nil)
+			req.Header.Add("Authorization", "Bearer "+validHS256JWTToken)
+			hh.ServeHTTP(res, req)
+			assert.Equal(t, http.StatusOK, res.Code, path)
+		}
+	})
+
 	t.Run("it will pass if jwt enabled with correct header token", func(t *testing.T) {
 		Config.JWTAuthEnabled = true
 		defer func() { Config.JWTAuthEnabled = false }()
@@ -351,6 +372,37 @@ func TestBasicAuthMiddleware(t *testing.T) {
 		assert.Equal(t, http.StatusOK, res.Code)
 	})
 
+	t.Run("it will return 401 for an excluded path under the whitelist if basic auth is enabled", func(t *testing.T) {
+		Config.BasicAuthEnabled = true
+		Config.BasicAuthUsername = "admin"
+		Config.BasicAuthPassword = "password"
+		defer func() {
+			Config.BasicAuthEnabled = false
+			Config.BasicAuthUsername = ""
+			Config.BasicAuthPassword = ""
+		}()
+
+		hh := SetupGlobalMiddleware(h)
+		res := httptest.NewRecorder()
+		res.Body = new(bytes.Buffer)
+		req, _ := http.NewRequest("POST", "http://localhost:18000/api/v1/evaluation/snapshot", nil)
+		hh.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+
+		res = httptest.NewRecorder()
+		res.Body = new(bytes.Buffer)
+		req, _ = http.NewRequest("POST", "http://localhost:18000/api/v1/evaluation/snapshot", nil)
+		req.SetBasicAuth("admin", "password")
+		hh.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusOK, res.Code)
+
+		res = httptest.NewRecorder()
+		res.Body = new(bytes.Buffer)
+		req, _ = http.NewRequest("POST", "http://localhost:18000/api/v1/evaluation/batch", nil)
+		hh.ServeHTTP(res, req)
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
 	t.Run("it will return 401 for web paths when enabled and no basic auth passed", func(t *testing.T) {
 		Config.BasicAuthEnabled = true
 		Config.BasicAuthUsername = "admin"
@@ -399,3 +451,25 @@ func TestBasicAuthMiddleware(t *testing.T) {
 	})
 
 }
+
+func TestSkipEventStreamMiddleware(t *testing.T) {
+	hh := SetupGlobalMiddleware(&okHandler{})
+
+	t.Run("gzip the response", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "http://localhost:18000/api/v1/flags", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		res := httptest.NewRecorder()
+		hh.ServeHTTP(res, req)
+		assert.Equal(t, "gzip", res.Header().Get("Content-Encoding"))
+	})
+
+	t.Run("skip gzip for the event stream", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "http://localhost:18000/api/v1/evaluation/changes", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		req.Header.Set("Accept", "text/event-stream")
+		res := httptest.NewRecorder()
+		hh.ServeHTTP(res, req)
+		assert.Empty(t, res.Header().Get("Content-Encoding"))
+		assert.Equal(t, "OK", res.Body.String())
+	})
+}
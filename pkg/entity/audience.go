@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/openflagr/flagr/pkg/util"
+	"gorm.io/gorm"
+)
+
+// Audience is a named set of constraints that can be shared by segments across flags
+type Audience struct {
+	gorm.Model
+
+	Key         string          `gorm:"type:varchar(64);uniqueIndex:idx_audience_key"`
+	Description string          `gorm:"type:text"`
+	Constraints ConstraintArray `gorm:"foreignKey:AudienceID"`
+
+	// ConstraintGroups are joined with AND together with the Constraints
+	ConstraintGroups ConstraintGroups `gorm:"type:text"`
+
+	// Purely for evaluation
+	AudienceEvaluation AudienceEvaluation `gorm:"-" json:"-"`
+}
+
+// AudienceEvaluation is a struct that holds the necessary info for evaluation
+type AudienceEvaluation struct {
+	ConditionsExpr Condition
+	// UsesNow is whether the constraints reference the built-in now property
+	UsesNow bool
+}
+
+// PreloadAudienceConstraints preloads the constraints of audiences
+func PreloadAudienceConstraints(db *gorm.DB) *gorm.DB {
+	return db.Preload("Constraints", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at")
+	})
+}
+
+// Preload preloads the constraints of the audience
+func (a *Audience) Preload(db *gorm.DB) error {
+	return PreloadAudienceConstraints(db).First(a, a.Model.ID).Error
+}
+
+// Validate validates the Audience
+func (a *Audience) Validate() error {
+	if ok, reason := util.IsSafeKey(a.Key); !ok {
+		return fmt.Errorf("invalid audience key. reason: %s", reason)
+	}
+	for _, c := range a.Constraints {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
+	return a.ConstraintGroups.Validate()
+}
+
+// PrepareEvaluation prepares the audience for evaluation by parsing constraints
+func (a *Audience) PrepareEvaluation() error {
+	expr, err := NewCondition(a.Constraints, a.ConstraintGroups)
+	if err != nil {
+		return err
+	}
+	a.AudienceEvaluation = AudienceEvaluation{
+		ConditionsExpr: expr,
+		UsesNow:        usesNow(a.Constraints, a.ConstraintGroups),
+	}
+	return nil
+}
+
+// LinkIDLists links the ID lists referenced by the constraints of the audience for evaluation
+func (a *Audience) LinkIDLists(lists map[string]*IDList) {
+	if a.AudienceEvaluation.ConditionsExpr != nil {
+		linkIDLists(a.AudienceEvaluation.ConditionsExpr, lists)
+	}
+}
+
+// FindFlagsByAudience finds the flags having any segment that references the audience
+func FindFlagsByAudience(db *gorm.DB, audienceID uint) ([]Flag, error) {
+	fs := []Flag{}
+	err := db.
+		Where(
+			"id IN (?)",
+			db.Session(&gorm.Session{NewDB: true}).
+				Model(&Segment{}).
+				Select("segments.flag_id").
+				Joins("JOIN segments_audiences ON segments_audiences.segment_id = segments.id").
+				Where("segments_audiences.audience_id = ?", audienceID),
+		).
+		Order("id").
+		Find(&fs).
+		Error
+	return fs, err
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudienceValidate(t *testing.T) {
+	t.Run("empty case", func(t *testing.T) {
+		a := Audience{}
+		assert.Error(t, a.Validate())
+	})
+	t.Run("invalid constraint", func(t *testing.T) {
+		a := Audience{Key: "eu_users", Constraints: []Constraint{{Property: "country", Operator: "UNKNOWN", Value: `"DE"`}}}
+		assert.Error(t, a.Validate())
+	})
+	t.Run("happy code path", func(t *testing.T) {
+		a := Audience{Key: "eu_users", Constraints: []Constraint{{Property: "country", Operator: models.ConstraintOperatorIN, Value: `["DE","FR"]`}}}
+		assert.NoError(t, a.Validate())
+		assert.NoError(t, a.PrepareEvaluation())
+		assert.NotNil(t, a.AudienceEvaluation.ConditionsExpr)
+	})
+}
+
+func TestFindFlagsByAudience(t *testing.T) {
+	f := GenFixtureFlag()
+	db := PopulateTestDB(f)
+
+	a := Audience{Key: "eu_users"}
+	db.Create(&a)
+
+	fs, err := FindFlagsByAudience(db, a.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, fs)
+
+	db.Model(&Segment{Model: f.Segments[0].Model}).Association("Audiences").Append(&a)
+	fs, err = FindFlagsByAudience(db, a.ID)
+	assert.NoError(t, err)
+	assert.Len(t, fs, 1)
+	assert.Equal(t, f.Key, fs[0].Key)
+}
@@ -13,10 +13,11 @@ import (
 type Constraint struct {
 	gorm.Model
 
-	SegmentID uint `gorm:"index:idx_constraint_segmentid"`
-	Property  string
-	Operator  string
-	Value     string `gorm:"type:text"`
+	SegmentID  uint `gorm:"index:idx_constraint_segmentid"`
+	AudienceID uint `gorm:"index:idx_constraint_audienceid"` // set instead of SegmentID if it belongs to an audience
+	Property   string
+	Operator   string
+	Value      string `gorm:"type:text"`
 }
 
 // ConstraintArray is an array of Constraint
@@ -71,10 +72,44 @@ func (c *Constraint) toExprStr() (string, error) {
 
 // Validate validates Constraint
 func (c *Constraint) Validate() error {
-	_, err := c.ToExpr()
+	_, err := c.toCondition()
 	return err
 }
 
+// toCondition compiles the constraint for evaluation, keeping the constraint
+// for the trace of the evaluation in debug mode
+func (c *Constraint) toCondition() (Condition, error) {
+	cond, err := c.compile()
+	if err != nil {
+		return nil, err
+	}
+	return &constraintCondition{
+		Condition: cond,
+		property:  c.Property,
+		operator:  c.Operator,
+		value:     c.Value,
+	}, nil
+}
+
+// compile compiles the constraint. The operators that the conditions package
+// cannot express, e.g. the semver ones, have their own Condition.
+func (c *Constraint) compile() (Condition, error) {
+	if _, ok := SemverOperators[c.Operator]; ok {
+		return newSemverCondition(c)
+	}
+	if TimeOperators[c.Operator] {
+		return newTimeCondition(c)
+	}
+	if IDListOperators[c.Operator] {
+		return newIDListCondition(c)
+	}
+	expr, err := c.ToExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &exprCondition{expr: expr}, nil
+}
+
 // ToExpr maps ConstraintArray to expr by joining 'AND'
 func (cs ConstraintArray) ToExpr() (conditions.Expr, error) {
 	strs := make([]string, 0, len(cs))
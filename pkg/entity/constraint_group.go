@@ -0,0 +1,207 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/spf13/cast"
+	"github.com/zhouzhuojie/conditions"
+)
+
+// ConstraintGroup is a group of constraints and nested groups joined with the Operator,
+// and the result is negated if Negate is true
+type ConstraintGroup struct {
+	Operator    string
+	Negate      bool              `json:",omitempty"`
+	Constraints []GroupConstraint `json:",omitempty"`
+	Groups      ConstraintGroups  `json:",omitempty"`
+}
+
+// GroupConstraint is a constraint inside a ConstraintGroup
+type GroupConstraint struct {
+	Property string
+	Operator string
+	Value    string
+}
+
+// ConstraintGroups is an array of ConstraintGroup
+type ConstraintGroups []ConstraintGroup
+
+func (c GroupConstraint) toConstraint() Constraint {
+	return Constraint{Property: c.Property, Operator: c.Operator, Value: c.Value}
+}
+
+// Validate validates the ConstraintGroup and its nested groups
+func (g *ConstraintGroup) Validate() error {
+	if g.Operator != models.ConstraintGroupOperatorAND && g.Operator != models.ConstraintGroupOperatorOR {
+		return fmt.Errorf("not supported constraint group operator: %s", g.Operator)
+	}
+	if len(g.Constraints) == 0 && len(g.Groups) == 0 {
+		return fmt.Errorf("empty constraint group")
+	}
+	for _, c := range g.Constraints {
+		cons := c.toConstraint()
+		if err := cons.Validate(); err != nil {
+			return err
+		}
+	}
+	return g.Groups.Validate()
+}
+
+// Validate validates all the constraint groups
+func (gs ConstraintGroups) Validate() error {
+	for _, g := range gs {
+		if err := g.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Scan implements scanner interface
+func (gs *ConstraintGroups) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	s := cast.ToString(value)
+	if s == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(s), gs); err != nil {
+		return fmt.Errorf("cannot scan %v into ConstraintGroups type. err: %v", value, err)
+	}
+	return nil
+}
+
+// Value implements valuer interface
+func (gs ConstraintGroups) Value() (driver.Value, error) {
+	bytes, err := json.Marshal(gs)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+func (g *ConstraintGroup) toCondition() (Condition, error) {
+	cg := &groupCondition{operator: g.Operator, negate: g.Negate}
+	for _, c := range g.Constraints {
+		cons := c.toConstraint()
+		cond, err := cons.toCondition()
+		if err != nil {
+			return nil, err
+		}
+		cg.conditions = append(cg.conditions, cond)
+	}
+	for i := range g.Groups {
+		sub, err := g.Groups[i].toCondition()
+		if err != nil {
+			return nil, err
+		}
+		cg.conditions = append(cg.conditions, sub)
+	}
+	return cg, nil
+}
+
+// Condition is the compiled form of constraints and constraint groups for evaluation
+type Condition interface {
+	Evaluate(m map[string]interface{}) (bool, error)
+	String() string
+}
+
+// NewCondition compiles the constraints and the constraint groups joined with AND,
+// which keeps the flat list of constraints as the implicit all-AND case.
+// It returns nil if there's nothing to evaluate.
+func NewCondition(cs ConstraintArray, gs ConstraintGroups) (Condition, error) {
+	if len(cs) == 0 && len(gs) == 0 {
+		return nil, nil
+	}
+
+	// like the flat constraints joined with AND before the constraint groups, an error
+	// of any of them fails the evaluation even if another one is false
+	cg := &groupCondition{operator: models.ConstraintGroupOperatorAND, strict: true}
+	for _, c := range cs {
+		cond, err := c.toCondition()
+		if err != nil {
+			return nil, err
+		}
+		cg.conditions = append(cg.conditions, cond)
+	}
+	for i := range gs {
+		sub, err := gs[i].toCondition()
+		if err != nil {
+			return nil, err
+		}
+		cg.conditions = append(cg.conditions, sub)
+	}
+	return cg, nil
+}
+
+// exprCondition is the condition of a single constraint
+type exprCondition struct {
+	expr conditions.Expr
+}
+
+func (c *exprCondition) Evaluate(m map[string]interface{}) (bool, error) {
+	return conditions.Evaluate(c.expr, m)
+}
+
+func (c *exprCondition) String() string {
+	return c.expr.String()
+}
+
+// groupCondition joins the conditions with AND or OR. An error of a condition,
+// e.g. a missing property in the entity context, only fails the group if the
+// other conditions cannot decide the result on their own, or if the group is strict.
+type groupCondition struct {
+	operator   string
+	negate     bool
+	strict     bool
+	conditions []Condition
+}
+
+func (g *groupCondition) Evaluate(m map[string]interface{}) (bool, error) {
+	// AND is decided by any false, and OR is decided by any true
+	decisive := g.operator == models.ConstraintGroupOperatorOR
+	result := !decisive
+
+	var firstErr error
+	for _, c := range g.conditions {
+		match, err := c.Evaluate(m)
+		if err != nil {
+			if g.strict {
+				return false, err
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		if match == decisive {
+			result = decisive
+			if g.strict {
+				continue
+			}
+			firstErr = nil
+			break
+		}
+	}
+	if firstErr != nil {
+		return false, firstErr
+	}
+	return result != g.negate, nil
+}
+
+func (g *groupCondition) String() string {
+	strs := make([]string, len(g.conditions))
+	for i, c := range g.conditions {
+		strs[i] = c.String()
+	}
+	s := fmt.Sprintf("(%s)", strings.Join(strs, " "+g.operator+" "))
+	if g.negate {
+		return "NOT " + s
+	}
+	return s
+}
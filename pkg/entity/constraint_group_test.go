@@ -0,0 +1,161 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstraintGroupValidate(t *testing.T) {
+	t.Run("empty group", func(t *testing.T) {
+		g := ConstraintGroup{Operator: models.ConstraintGroupOperatorAND}
+		assert.Error(t, g.Validate())
+	})
+	t.Run("invalid operator", func(t *testing.T) {
+		g := ConstraintGroup{
+			Operator:    "XOR",
+			Constraints: []GroupConstraint{{Property: "a", Operator: models.ConstraintOperatorEQ, Value: `1`}},
+		}
+		assert.Error(t, g.Validate())
+	})
+	t.Run("invalid nested constraint", func(t *testing.T) {
+		g := ConstraintGroup{
+			Operator: models.ConstraintGroupOperatorOR,
+			Groups: ConstraintGroups{
+				{
+					Operator:    models.ConstraintGroupOperatorAND,
+					Constraints: []GroupConstraint{{Property: "a", Operator: "UNKNOWN", Value: `1`}},
+				},
+			},
+		}
+		assert.Error(t, g.Validate())
+	})
+	t.Run("happy code path", func(t *testing.T) {
+		g := ConstraintGroup{
+			Operator:    models.ConstraintGroupOperatorOR,
+			Constraints: []GroupConstraint{{Property: "a", Operator: models.ConstraintOperatorEQ, Value: `1`}},
+		}
+		assert.NoError(t, g.Validate())
+	})
+}
+
+func TestConstraintGroupsScanValue(t *testing.T) {
+	gs := ConstraintGroups{
+		{
+			Operator:    models.ConstraintGroupOperatorOR,
+			Negate:      true,
+			Constraints: []GroupConstraint{{Property: "a", Operator: models.ConstraintOperatorEQ, Value: `1`}},
+		},
+	}
+	v, err := gs.Value()
+	assert.NoError(t, err)
+
+	scanned := ConstraintGroups{}
+	assert.NoError(t, scanned.Scan(v))
+	assert.Equal(t, gs, scanned)
+
+	assert.NoError(t, scanned.Scan(nil))
+	assert.Error(t, scanned.Scan(`[`))
+}
+
+func TestNewCondition(t *testing.T) {
+	country := GroupConstraint{Property: "country", Operator: models.ConstraintOperatorEQ, Value: `"US"`}
+	plan := GroupConstraint{Property: "plan", Operator: models.ConstraintOperatorEQ, Value: `"enterprise"`}
+
+	t.Run("nothing to evaluate", func(t *testing.T) {
+		c, err := NewCondition(nil, nil)
+		assert.NoError(t, err)
+		assert.Nil(t, c)
+	})
+
+	t.Run("flat constraints are joined with AND", func(t *testing.T) {
+		c, err := NewCondition(ConstraintArray{country.toConstraint(), plan.toConstraint()}, nil)
+		assert.NoError(t, err)
+
+		match, err := c.Evaluate(map[string]interface{}{"country": "US", "plan": "enterprise"})
+		assert.NoError(t, err)
+		assert.True(t, match)
+
+		match, err = c.Evaluate(map[string]interface{}{"country": "US", "plan": "free"})
+		assert.NoError(t, err)
+		assert.False(t, match)
+
+		_, err = c.Evaluate(map[string]interface{}{"country": "US"})
+		assert.Error(t, err)
+
+		// a missing property fails the flat constraints even if another one is false
+		_, err = c.Evaluate(map[string]interface{}{"country": "CA"})
+		assert.Error(t, err)
+	})
+
+	t.Run("AND group", func(t *testing.T) {
+		c, err := NewCondition(nil, ConstraintGroups{
+			{Operator: models.ConstraintGroupOperatorAND, Constraints: []GroupConstraint{country, plan}},
+		})
+		assert.NoError(t, err)
+
+		// a false constraint decides the AND group regardless of the missing property
+		match, err := c.Evaluate(map[string]interface{}{"country": "CA"})
+		assert.NoError(t, err)
+		assert.False(t, match)
+
+		_, err = c.Evaluate(map[string]interface{}{"country": "US"})
+		assert.Error(t, err)
+	})
+
+	t.Run("OR group", func(t *testing.T) {
+		c, err := NewCondition(nil, ConstraintGroups{
+			{Operator: models.ConstraintGroupOperatorOR, Constraints: []GroupConstraint{country, plan}},
+		})
+		assert.NoError(t, err)
+
+		match, err := c.Evaluate(map[string]interface{}{"country": "CA", "plan": "enterprise"})
+		assert.NoError(t, err)
+		assert.True(t, match)
+
+		// a true constraint decides OR regardless of the missing property
+		match, err = c.Evaluate(map[string]interface{}{"country": "US"})
+		assert.NoError(t, err)
+		assert.True(t, match)
+
+		match, err = c.Evaluate(map[string]interface{}{"country": "CA", "plan": "free"})
+		assert.NoError(t, err)
+		assert.False(t, match)
+
+		_, err = c.Evaluate(map[string]interface{}{"country": "CA"})
+		assert.Error(t, err)
+	})
+
+	t.Run("nested NOT group", func(t *testing.T) {
+		c, err := NewCondition(
+			ConstraintArray{{Property: "age", Operator: models.ConstraintOperatorGTE, Value: `18`}},
+			ConstraintGroups{
+				{
+					Operator: models.ConstraintGroupOperatorOR,
+					Negate:   true,
+					Groups: ConstraintGroups{
+						{Operator: models.ConstraintGroupOperatorAND, Constraints: []GroupConstraint{country, plan}},
+					},
+				},
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, `((age >= 18.000) AND NOT (((country == "US") AND (plan == "enterprise"))))`, c.String())
+
+		match, err := c.Evaluate(map[string]interface{}{"age": 20, "country": "US", "plan": "free"})
+		assert.NoError(t, err)
+		assert.True(t, match)
+
+		match, err = c.Evaluate(map[string]interface{}{"age": 20, "country": "US", "plan": "enterprise"})
+		assert.NoError(t, err)
+		assert.False(t, match)
+	})
+
+	t.Run("invalid constraint", func(t *testing.T) {
+		_, err := NewCondition(nil, ConstraintGroups{
+			{Operator: models.ConstraintGroupOperatorOR, Constraints: []GroupConstraint{{Property: "a", Operator: "UNKNOWN", Value: `1`}}},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"golang.org/x/mod/semver"
+)
+
+// SemverOperators maps from the semantic version operators to the accepted
+// results of comparing the property's version with the constraint's version
+var SemverOperators = map[string]func(cmp int) bool{
+	models.ConstraintOperatorSEMVEREQ:  func(cmp int) bool { return cmp == 0 },
+	models.ConstraintOperatorSEMVERGT:  func(cmp int) bool { return cmp > 0 },
+	models.ConstraintOperatorSEMVERGTE: func(cmp int) bool { return cmp >= 0 },
+	models.ConstraintOperatorSEMVERLT:  func(cmp int) bool { return cmp < 0 },
+	models.ConstraintOperatorSEMVERLTE: func(cmp int) bool { return cmp <= 0 },
+}
+
+// canonicalSemver parses versions like "4.10.0", "v4.10" or "4.10.0-beta.1"
+// into the "v" prefixed form of the semver package
+func canonicalSemver(s string) (string, bool) {
+	v := strings.TrimSpace(s)
+	if !strings.HasPrefix(v, "v") {
+		v = "v" + v
+	}
+	if !semver.IsValid(v) {
+		return "", false
+	}
+	return v, true
+}
+
+// semverCondition compares the property of the entity context as a semantic version
+type semverCondition struct {
+	property string
+	operator string
+	version  string
+	accept   func(cmp int) bool
+}
+
+func newSemverCondition(c *Constraint) (Condition, error) {
+	if c.Property == "" || c.Value == "" {
+		return nil, fmt.Errorf("empty Property/Operator/Value: %s/%s/%s", c.Property, c.Operator, c.Value)
+	}
+	value := c.Value
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+	}
+	version, ok := canonicalSemver(value)
+	if !ok {
+		return nil, fmt.Errorf("invalid semantic version %s for operator %s", c.Value, c.Operator)
+	}
+	return &semverCondition{
+		property: c.Property,
+		operator: c.Operator,
+		version:  version,
+		accept:   SemverOperators[c.Operator],
+	}, nil
+}
+
+func (c *semverCondition) Evaluate(m map[string]interface{}) (bool, error) {
+	v, ok := m[c.property]
+	if !ok {
+		return false, fmt.Errorf("argument: %v not found", c.property)
+	}
+	s, ok := v.(string)
+	if !ok {
+		return false, fmt.Errorf("property %s is not a semantic version string: %v", c.property, v)
+	}
+	version, ok := canonicalSemver(s)
+	if !ok {
+		return false, fmt.Errorf("property %s is not a valid semantic version: %q", c.property, s)
+	}
+	return c.accept(semver.Compare(version, c.version)), nil
+}
+
+func (c *semverCondition) String() string {
+	return fmt.Sprintf("(%s %s %q)", c.property, c.operator, strings.TrimPrefix(c.version, "v"))
+}
@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSemverConstraintValidate(t *testing.T) {
+	t.Run("valid versions", func(t *testing.T) {
+		for _, v := range []string{`"4.10.0"`, `"v4.10.0"`, `"4.10"`, `"4.10.0-beta.1"`, `4.10.0`} {
+			c := Constraint{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: v}
+			assert.NoError(t, c.Validate(), v)
+		}
+	})
+	t.Run("invalid versions", func(t *testing.T) {
+		for _, v := range []string{`"four"`, `"4.10.0.1"`, `"4.x"`} {
+			c := Constraint{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: v}
+			assert.Error(t, c.Validate(), v)
+		}
+	})
+	t.Run("empty value", func(t *testing.T) {
+		c := Constraint{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: ""}
+		assert.Error(t, c.Validate())
+	})
+}
+
+func TestSemverConstraintEvaluate(t *testing.T) {
+	eval := func(operator string, value string, appVersion interface{}) (bool, error) {
+		c := Constraint{Property: "app_version", Operator: operator, Value: value}
+		cond, err := c.toCondition()
+		assert.NoError(t, err)
+		return cond.Evaluate(map[string]interface{}{"app_version": appVersion})
+	}
+
+	t.Run("compares numerically rather than lexically", func(t *testing.T) {
+		match, err := eval(models.ConstraintOperatorSEMVERGTE, `"4.9.0"`, "4.10.0")
+		assert.NoError(t, err)
+		assert.True(t, match)
+
+		match, err = eval(models.ConstraintOperatorSEMVERLT, `"4.10.0"`, "4.9.3")
+		assert.NoError(t, err)
+		assert.True(t, match)
+	})
+
+	t.Run("all operators", func(t *testing.T) {
+		cases := []struct {
+			operator string
+			version  string
+			expected bool
+		}{
+			{models.ConstraintOperatorSEMVEREQ, "4.10.0", true},
+			{models.ConstraintOperatorSEMVEREQ, "v4.10.0", true},
+			{models.ConstraintOperatorSEMVEREQ, "4.10.1", false},
+			{models.ConstraintOperatorSEMVERGT, "4.10.1", true},
+			{models.ConstraintOperatorSEMVERGT, "4.10.0", false},
+			{models.ConstraintOperatorSEMVERGTE, "4.10.0", true},
+			{models.ConstraintOperatorSEMVERLT, "4.2.0", true},
+			{models.ConstraintOperatorSEMVERLT, "4.10.0", false},
+			{models.ConstraintOperatorSEMVERLTE, "4.10.0", true},
+			{models.ConstraintOperatorSEMVERLTE, "5.0.0", false},
+		}
+		for _, tc := range cases {
+			match, err := eval(tc.operator, `"4.10.0"`, tc.version)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, match, "%s %s", tc.version, tc.operator)
+		}
+	})
+
+	t.Run("pre-release is lower than the release", func(t *testing.T) {
+		match, err := eval(models.ConstraintOperatorSEMVERLT, `"4.10.0"`, "4.10.0-beta.1")
+		assert.NoError(t, err)
+		assert.True(t, match)
+	})
+
+	t.Run("invalid context value", func(t *testing.T) {
+		_, err := eval(models.ConstraintOperatorSEMVERGTE, `"4.10.0"`, "latest")
+		assert.EqualError(t, err, `property app_version is not a valid semantic version: "latest"`)
+
+		_, err = eval(models.ConstraintOperatorSEMVERGTE, `"4.10.0"`, 4.1)
+		assert.EqualError(t, err, "property app_version is not a semantic version string: 4.1")
+	})
+
+	t.Run("missing property", func(t *testing.T) {
+		c := Constraint{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: `"4.10.0"`}
+		cond, _ := c.toCondition()
+		_, err := cond.Evaluate(map[string]interface{}{})
+		assert.Error(t, err)
+	})
+
+	t.Run("string representation", func(t *testing.T) {
+		c := Constraint{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: `"4.10.0"`}
+		cond, _ := c.toCondition()
+		assert.Equal(t, `(app_version SEMVER_GTE "4.10.0")`, cond.String())
+	})
+}
@@ -0,0 +1,175 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// NowProperty is the built-in property of the evaluation time, which replaces the
+// property of the same name in the entity context, see WithNow
+const NowProperty = "now"
+
+// Now is the clock of the built-in now property and the scheduler.
+// It's a variable so that tests can fake it.
+var Now = time.Now
+
+// TimeOperators are the operators comparing timestamps
+var TimeOperators = map[string]bool{
+	models.ConstraintOperatorBEFORE:  true,
+	models.ConstraintOperatorAFTER:   true,
+	models.ConstraintOperatorBETWEEN: true,
+}
+
+// WithNow returns a copy of the entity context with the built-in now property set to the
+// time in unix epoch seconds, so that it works with the time operators as well as the
+// numeric ones, e.g. now GT 1735689600
+func WithNow(m map[string]interface{}, now time.Time) map[string]interface{} {
+	c := make(map[string]interface{}, len(m)+1)
+	for k, v := range m {
+		c[k] = v
+	}
+	c[NowProperty] = float64(now.Unix())
+	return c
+}
+
+// usesNow returns whether any of the constraints, or of the constraints of the groups, compares
+// the built-in now property, so that only their evaluations copy the entity context for it
+func usesNow(cs ConstraintArray, gs ConstraintGroups) bool {
+	for _, c := range cs {
+		if c.Property == NowProperty {
+			return true
+		}
+	}
+	for _, g := range gs {
+		for _, c := range g.Constraints {
+			if c.Property == NowProperty {
+				return true
+			}
+		}
+		if usesNow(nil, g.Groups) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTimestamp parses RFC3339 strings, e.g. "2025-01-01T00:00:00Z", and
+// unix epoch seconds given either as numbers or as strings
+func parseTimestamp(v interface{}) (time.Time, bool) {
+	var epoch float64
+	switch t := v.(type) {
+	case string:
+		s := strings.TrimSpace(t)
+		if ts, err := time.Parse(time.RFC3339Nano, s); err == nil {
+			return ts, true
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return time.Time{}, false
+		}
+		epoch = f
+	case float64:
+		epoch = t
+	case float32:
+		epoch = float64(t)
+	case int:
+		epoch = float64(t)
+	case int64:
+		epoch = float64(t)
+	case json.Number:
+		f, err := t.Float64()
+		if err != nil {
+			return time.Time{}, false
+		}
+		epoch = f
+	default:
+		return time.Time{}, false
+	}
+	if math.IsNaN(epoch) || math.IsInf(epoch, 0) {
+		return time.Time{}, false
+	}
+	sec, frac := math.Modf(epoch)
+	return time.Unix(int64(sec), int64(frac*1e9)), true
+}
+
+// timeCondition compares the property of the entity context with the timestamps of
+// the constraint. The now property is the server's current time if it's not set by WithNow.
+// BETWEEN includes the start and excludes the end.
+type timeCondition struct {
+	property string
+	operator string
+	value    string
+	start    time.Time
+	end      time.Time
+}
+
+func newTimeCondition(c *Constraint) (Condition, error) {
+	if c.Property == "" || c.Value == "" {
+		return nil, fmt.Errorf("empty Property/Operator/Value: %s/%s/%s", c.Property, c.Operator, c.Value)
+	}
+	tc := &timeCondition{property: c.Property, operator: c.Operator, value: c.Value}
+
+	if c.Operator == models.ConstraintOperatorBETWEEN {
+		var values []interface{}
+		if err := json.Unmarshal([]byte(c.Value), &values); err != nil || len(values) != 2 {
+			return nil, fmt.Errorf("invalid value %s for operator %s, expecting an array of the start and the end timestamps", c.Value, c.Operator)
+		}
+		start, ok := parseTimestamp(values[0])
+		if !ok {
+			return nil, fmt.Errorf("invalid timestamp %v for operator %s", values[0], c.Operator)
+		}
+		end, ok := parseTimestamp(values[1])
+		if !ok {
+			return nil, fmt.Errorf("invalid timestamp %v for operator %s", values[1], c.Operator)
+		}
+		if !start.Before(end) {
+			return nil, fmt.Errorf("invalid value %s for operator %s, the start is not before the end", c.Value, c.Operator)
+		}
+		tc.start, tc.end = start, end
+		return tc, nil
+	}
+
+	value := c.Value
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+	}
+	ts, ok := parseTimestamp(value)
+	if !ok {
+		return nil, fmt.Errorf("invalid timestamp %s for operator %s", c.Value, c.Operator)
+	}
+	tc.start, tc.end = ts, ts
+	return tc, nil
+}
+
+func (c *timeCondition) Evaluate(m map[string]interface{}) (bool, error) {
+	v, ok := m[c.property]
+	if !ok && c.property == NowProperty {
+		v, ok = float64(Now().Unix()), true
+	}
+	if !ok {
+		return false, fmt.Errorf("argument: %v not found", c.property)
+	}
+	ts, ok := parseTimestamp(v)
+	if !ok {
+		return false, fmt.Errorf("property %s is not a RFC3339 or unix epoch timestamp: %v", c.property, v)
+	}
+
+	switch c.operator {
+	case models.ConstraintOperatorBEFORE:
+		return ts.Before(c.start), nil
+	case models.ConstraintOperatorAFTER:
+		return ts.After(c.start), nil
+	default:
+		return !ts.Before(c.start) && ts.Before(c.end), nil
+	}
+}
+
+func (c *timeCondition) String() string {
+	return fmt.Sprintf("(%s %s %s)", c.property, c.operator, c.value)
+}
@@ -0,0 +1,189 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestTimeConstraintValidate(t *testing.T) {
+	t.Run("valid values", func(t *testing.T) {
+		for _, c := range []Constraint{
+			{Property: "created_at", Operator: models.ConstraintOperatorBEFORE, Value: `"2025-01-01T00:00:00Z"`},
+			{Property: "created_at", Operator: models.ConstraintOperatorAFTER, Value: `1735689600`},
+			{Property: "now", Operator: models.ConstraintOperatorBETWEEN, Value: `["2025-11-28T00:00:00-05:00", "2025-12-01T00:00:00-05:00"]`},
+			{Property: "now", Operator: models.ConstraintOperatorBETWEEN, Value: `[1735689600, "1735776000"]`},
+		} {
+			assert.NoError(t, c.Validate(), c.Value)
+		}
+	})
+	t.Run("invalid values", func(t *testing.T) {
+		for _, c := range []Constraint{
+			{Property: "created_at", Operator: models.ConstraintOperatorBEFORE, Value: `"2025-01-01"`},
+			{Property: "created_at", Operator: models.ConstraintOperatorAFTER, Value: `"tomorrow"`},
+			{Property: "now", Operator: models.ConstraintOperatorBETWEEN, Value: `"2025-11-28T00:00:00Z"`},
+			{Property: "now", Operator: models.ConstraintOperatorBETWEEN, Value: `["2025-11-28T00:00:00Z"]`},
+			{Property: "now", Operator: models.ConstraintOperatorBETWEEN, Value: `["2025-12-01T00:00:00Z", "2025-11-28T00:00:00Z"]`},
+			{Property: "now", Operator: models.ConstraintOperatorBETWEEN, Value: ""},
+		} {
+			assert.Error(t, c.Validate(), c.Value)
+		}
+	})
+}
+
+func TestTimeConstraintEvaluate(t *testing.T) {
+	eval := func(operator string, value string, m map[string]interface{}) (bool, error) {
+		c := Constraint{Property: "created_at", Operator: operator, Value: value}
+		cond, err := c.toCondition()
+		assert.NoError(t, err)
+		return cond.Evaluate(m)
+	}
+
+	t.Run("BEFORE and AFTER", func(t *testing.T) {
+		cases := []struct {
+			operator  string
+			createdAt interface{}
+			expected  bool
+		}{
+			{models.ConstraintOperatorBEFORE, "2024-12-31T23:59:59Z", true},
+			{models.ConstraintOperatorBEFORE, "2025-01-01T00:00:00Z", false},
+			{models.ConstraintOperatorBEFORE, float64(1735689599), true},
+			{models.ConstraintOperatorBEFORE, "1735689600", false},
+			{models.ConstraintOperatorAFTER, "2025-01-01T08:00:01+08:00", true},
+			{models.ConstraintOperatorAFTER, "2025-01-01T00:00:00Z", false},
+			{models.ConstraintOperatorAFTER, 1735689600.5, true},
+		}
+		for _, tc := range cases {
+			match, err := eval(tc.operator, `"2025-01-01T00:00:00Z"`, map[string]interface{}{"created_at": tc.createdAt})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, match, "%v %s", tc.createdAt, tc.operator)
+		}
+	})
+
+	t.Run("BETWEEN includes the start and excludes the end", func(t *testing.T) {
+		value := `["2025-11-28T00:00:00Z", "2025-12-01T00:00:00Z"]`
+		for createdAt, expected := range map[string]bool{
+			"2025-11-27T23:59:59Z": false,
+			"2025-11-28T00:00:00Z": true,
+			"2025-11-30T12:00:00Z": true,
+			"2025-12-01T00:00:00Z": false,
+		} {
+			match, err := eval(models.ConstraintOperatorBETWEEN, value, map[string]interface{}{"created_at": createdAt})
+			assert.NoError(t, err)
+			assert.Equal(t, expected, match, createdAt)
+		}
+	})
+
+	t.Run("invalid context value", func(t *testing.T) {
+		_, err := eval(models.ConstraintOperatorBEFORE, `"2025-01-01T00:00:00Z"`, map[string]interface{}{"created_at": "yesterday"})
+		assert.EqualError(t, err, "property created_at is not a RFC3339 or unix epoch timestamp: yesterday")
+	})
+
+	t.Run("missing property", func(t *testing.T) {
+		_, err := eval(models.ConstraintOperatorBEFORE, `"2025-01-01T00:00:00Z"`, map[string]interface{}{})
+		assert.Error(t, err)
+	})
+
+	t.Run("string representation", func(t *testing.T) {
+		c := Constraint{Property: "created_at", Operator: models.ConstraintOperatorBEFORE, Value: `"2025-01-01T00:00:00Z"`}
+		cond, _ := c.toCondition()
+		assert.Equal(t, `(created_at BEFORE "2025-01-01T00:00:00Z")`, cond.String())
+	})
+}
+
+func TestTimeConstraintNow(t *testing.T) {
+	c := Constraint{
+		Property: NowProperty,
+		Operator: models.ConstraintOperatorBETWEEN,
+		Value:    `["2025-11-28T00:00:00Z", "2025-12-01T00:00:00Z"]`,
+	}
+	cond, err := c.toCondition()
+	assert.NoError(t, err)
+
+	t.Run("uses the clock", func(t *testing.T) {
+		defer gostub.StubFunc(&Now, time.Date(2025, 11, 29, 0, 0, 0, 0, time.UTC)).Reset()
+		match, err := cond.Evaluate(map[string]interface{}{})
+		assert.NoError(t, err)
+		assert.True(t, match)
+	})
+
+	t.Run("uses the time set by WithNow", func(t *testing.T) {
+		defer gostub.StubFunc(&Now, time.Date(2025, 11, 29, 0, 0, 0, 0, time.UTC)).Reset()
+		m := WithNow(map[string]interface{}{}, time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC))
+		match, err := cond.Evaluate(m)
+		assert.NoError(t, err)
+		assert.False(t, match)
+	})
+}
+
+func TestSegmentUsesNow(t *testing.T) {
+	nowConstraint := Constraint{Property: NowProperty, Operator: models.ConstraintOperatorGT, Value: "1735689600"}
+	stateConstraint := Constraint{Property: "dl_state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`}
+	nowGroups := ConstraintGroups{{
+		Operator: models.ConstraintGroupOperatorOR,
+		Groups: ConstraintGroups{{
+			Operator:    models.ConstraintGroupOperatorAND,
+			Constraints: []GroupConstraint{{Property: NowProperty, Operator: models.ConstraintOperatorGT, Value: "1735689600"}},
+		}},
+	}}
+
+	for _, tc := range []struct {
+		name     string
+		segment  Segment
+		expected bool
+	}{
+		{name: "no constraints", segment: Segment{}, expected: false},
+		{name: "other properties", segment: Segment{Constraints: ConstraintArray{stateConstraint}}, expected: false},
+		{name: "a constraint", segment: Segment{Constraints: ConstraintArray{stateConstraint, nowConstraint}}, expected: true},
+		{name: "a nested constraint group", segment: Segment{ConstraintGroups: nowGroups}, expected: true},
+	} {
+		assert.NoError(t, tc.segment.PrepareEvaluation(), tc.name)
+		assert.Equal(t, tc.expected, tc.segment.SegmentEvaluation.UsesNow, tc.name)
+	}
+
+	t.Run("an audience", func(t *testing.T) {
+		a := &Audience{Model: gorm.Model{ID: 1}, ConstraintGroups: nowGroups}
+		assert.NoError(t, a.PrepareEvaluation())
+		assert.True(t, a.AudienceEvaluation.UsesNow)
+
+		s := Segment{Audiences: []Audience{{Model: gorm.Model{ID: 1}}, {Model: gorm.Model{ID: 2}}}}
+		assert.NoError(t, s.PrepareEvaluation())
+		assert.False(t, s.SegmentEvaluation.UsesNow)
+		s.LinkAudiences(map[uint]*Audience{1: a})
+		assert.True(t, s.SegmentEvaluation.UsesNow)
+		s.LinkAudiences(map[uint]*Audience{})
+		assert.False(t, s.SegmentEvaluation.UsesNow)
+	})
+}
+
+func TestWithNow(t *testing.T) {
+	now := time.Date(2025, 11, 29, 0, 0, 0, 0, time.UTC)
+	entityContext := map[string]interface{}{"now": "2020-01-01T00:00:00Z", "dl_state": "CA"}
+	m := WithNow(entityContext, now)
+	assert.Equal(t, map[string]interface{}{"now": float64(now.Unix()), "dl_state": "CA"}, m)
+	assert.Equal(t, "2020-01-01T00:00:00Z", entityContext["now"])
+
+	// now works with the other operators as epoch seconds
+	for _, tc := range []struct {
+		operator string
+		value    string
+		expected bool
+	}{
+		{operator: models.ConstraintOperatorGT, value: "1735689600", expected: true},
+		{operator: models.ConstraintOperatorLT, value: "1735689600", expected: false},
+		{operator: models.ConstraintOperatorGTE, value: fmt.Sprint(now.Unix()), expected: true},
+		{operator: models.ConstraintOperatorEQ, value: fmt.Sprint(now.Unix()), expected: true},
+	} {
+		c := Constraint{Property: NowProperty, Operator: tc.operator, Value: tc.value}
+		cond, err := c.toCondition()
+		assert.NoError(t, err)
+		match, err := cond.Evaluate(m)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, match, tc.operator)
+	}
+}
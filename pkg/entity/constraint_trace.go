@@ -0,0 +1,107 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// ConstraintTrace is the evaluation result of a single constraint in debug mode
+type ConstraintTrace struct {
+	Property string
+	Operator string
+	Value    string
+	Actual   interface{}
+	Result   string
+
+	// Group is the path of the constraint group of the constraint, e.g.
+	// "constraintGroups[0].groups[1]", it's empty for the flat constraints
+	Group string
+
+	// Msg is the evaluation error of the constraint, if any
+	Msg string
+}
+
+// constraintCondition is the compiled constraint, which keeps the constraint for tracing
+type constraintCondition struct {
+	Condition
+	property string
+	operator string
+	value    string
+}
+
+func (c *constraintCondition) linkIDLists(lists map[string]*IDList) {
+	linkIDLists(c.Condition, lists)
+}
+
+func (c *constraintCondition) trace(m map[string]interface{}, group string) ConstraintTrace {
+	t := ConstraintTrace{
+		Property: c.property,
+		Operator: c.operator,
+		Value:    c.value,
+		Group:    group,
+	}
+	actual, ok := m[c.property]
+	if !ok && c.property == NowProperty && TimeOperators[c.operator] {
+		actual, ok = Now().UTC().Format(time.RFC3339), true
+	}
+	t.Actual = actual
+
+	match, err := c.Evaluate(m)
+	switch {
+	case err == nil && match:
+		t.Result = models.ConstraintTraceResultMATCH
+	case err == nil:
+		t.Result = models.ConstraintTraceResultNOTMATCH
+	case !ok:
+		t.Result = models.ConstraintTraceResultMISSINGPROPERTY
+		t.Msg = fmt.Sprintf("property %s is missing in the entity context", c.property)
+	case isMissingIDList(c.Condition):
+		t.Result = models.ConstraintTraceResultERROR
+		t.Msg = err.Error()
+	default:
+		t.Result = models.ConstraintTraceResultWRONGTYPE
+		t.Msg = fmt.Sprintf("property %s of type %T cannot be evaluated: %s", c.property, actual, err)
+	}
+	return t
+}
+
+func isMissingIDList(c Condition) bool {
+	l, ok := c.(*idListCondition)
+	return ok && l.list == nil
+}
+
+// TraceCondition evaluates every constraint of the condition against the entity context
+// and returns their results in order. Unlike the evaluation, it doesn't stop at the
+// first constraint deciding the result, so it's only meant for the debug mode.
+func TraceCondition(c Condition, m map[string]interface{}) []ConstraintTrace {
+	traces := []ConstraintTrace{}
+	traceCondition(c, m, "", &traces)
+	return traces
+}
+
+func traceCondition(c Condition, m map[string]interface{}, group string, traces *[]ConstraintTrace) {
+	switch cond := c.(type) {
+	case *constraintCondition:
+		*traces = append(*traces, cond.trace(m, group))
+	case *groupCondition:
+		// the constraints of a group come before its nested groups
+		k := 0
+		for _, sub := range cond.conditions {
+			subGroup := group
+			if _, ok := sub.(*groupCondition); ok {
+				subGroup = groupPath(group, k)
+				k++
+			}
+			traceCondition(sub, m, subGroup, traces)
+		}
+	}
+}
+
+func groupPath(parent string, i int) string {
+	if parent == "" {
+		return fmt.Sprintf("constraintGroups[%d]", i)
+	}
+	return fmt.Sprintf("%s.groups[%d]", parent, i)
+}
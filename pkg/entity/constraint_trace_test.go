@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTraceCondition(t *testing.T) {
+	cs := ConstraintArray{
+		{Property: "dl_state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`},
+		{Property: "rate", Operator: models.ConstraintOperatorGT, Value: `1000`},
+		{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: `"4.10.0"`},
+		{Property: "user_id", Operator: models.ConstraintOperatorINLIST, Value: `"beta_users"`},
+	}
+	gs := ConstraintGroups{
+		{
+			Operator:    models.ConstraintGroupOperatorOR,
+			Constraints: []GroupConstraint{{Property: "country", Operator: models.ConstraintOperatorEQ, Value: `"US"`}},
+			Groups: ConstraintGroups{
+				{
+					Operator:    models.ConstraintGroupOperatorAND,
+					Constraints: []GroupConstraint{{Property: "now", Operator: models.ConstraintOperatorAFTER, Value: `"2025-01-01T00:00:00Z"`}},
+				},
+			},
+		},
+	}
+	cond, err := NewCondition(cs, gs)
+	assert.NoError(t, err)
+
+	now := time.Date(2025, 11, 28, 0, 0, 0, 0, time.UTC)
+	defer gostub.StubFunc(&Now, now).Reset()
+
+	traces := TraceCondition(cond, map[string]interface{}{
+		"dl_state":    "CA",
+		"rate":        "high",
+		"app_version": "4.9.0",
+	})
+	assert.Len(t, traces, 6)
+
+	assert.Equal(t, ConstraintTrace{
+		Property: "dl_state",
+		Operator: models.ConstraintOperatorEQ,
+		Value:    `"CA"`,
+		Actual:   "CA",
+		Result:   models.ConstraintTraceResultMATCH,
+	}, traces[0])
+
+	assert.Equal(t, models.ConstraintTraceResultWRONGTYPE, traces[1].Result)
+	assert.Equal(t, "high", traces[1].Actual)
+	assert.Contains(t, traces[1].Msg, "property rate of type string")
+
+	assert.Equal(t, models.ConstraintTraceResultNOTMATCH, traces[2].Result)
+	assert.Equal(t, "4.9.0", traces[2].Actual)
+
+	// the ID list is not linked, and user_id is missing
+	assert.Equal(t, models.ConstraintTraceResultMISSINGPROPERTY, traces[3].Result)
+	assert.Nil(t, traces[3].Actual)
+	assert.Equal(t, "property user_id is missing in the entity context", traces[3].Msg)
+
+	assert.Equal(t, "country", traces[4].Property)
+	assert.Equal(t, "constraintGroups[0]", traces[4].Group)
+	assert.Equal(t, models.ConstraintTraceResultMISSINGPROPERTY, traces[4].Result)
+
+	assert.Equal(t, "now", traces[5].Property)
+	assert.Equal(t, "constraintGroups[0].groups[0]", traces[5].Group)
+	assert.Equal(t, "2025-11-28T00:00:00Z", traces[5].Actual)
+	assert.Equal(t, models.ConstraintTraceResultMATCH, traces[5].Result)
+
+	t.Run("missing ID list", func(t *testing.T) {
+		traces := TraceCondition(cond, map[string]interface{}{"user_id": "u1"})
+		assert.Equal(t, models.ConstraintTraceResultERROR, traces[3].Result)
+		assert.Equal(t, "id list beta_users not found", traces[3].Msg)
+	})
+
+	t.Run("nil condition", func(t *testing.T) {
+		assert.Empty(t, TraceCondition(nil, map[string]interface{}{}))
+	})
+}
@@ -1,22 +1,107 @@
 package entity
 
 import (
+	"crypto/sha1"
+	"encoding/binary"
 	"fmt"
 	"hash/crc32"
+	"math"
 	"sort"
+	"strings"
 
+	"github.com/cespare/xxhash/v2"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/spaolacci/murmur3"
 	"gorm.io/gorm"
 )
 
 const (
 	// TotalBucketNum represents how many buckets we can use to determine the consistent hashing
-	// distribution and rollout
+	// distribution and rollout of the default crc32 hash algorithm
 	TotalBucketNum uint = 1000
 
 	// PercentMultiplier implies that the multiplier between percentage (100) and TotalBucketNum
 	PercentMultiplier uint = TotalBucketNum / uint(100)
+
+	// FineTotalBucketNum is the number of buckets of the non-crc32 hash algorithms,
+	// which allows basis point (0.01%) granularity of the percents
+	FineTotalBucketNum uint = 1000000
+
+	// DefaultHashAlgorithm is kept as crc32 for the stability of existing flags
+	DefaultHashAlgorithm = models.FlagHashAlgorithmCrc32
 )
 
+// hashAlgorithm is the hash function and its bucket space used by the consistent hashing
+type hashAlgorithm struct {
+	hash           func([]byte) uint64
+	totalBucketNum uint
+	percentStep    float64 // the granularity of the percents it supports
+}
+
+var hashAlgorithms = map[string]hashAlgorithm{
+	models.FlagHashAlgorithmCrc32: {
+		hash:           func(b []byte) uint64 { return uint64(crc32.ChecksumIEEE(b)) },
+		totalBucketNum: TotalBucketNum,
+		percentStep:    0.1,
+	},
+	models.FlagHashAlgorithmMurmur3: {
+		hash:           murmur3.Sum64,
+		totalBucketNum: FineTotalBucketNum,
+		percentStep:    0.01,
+	},
+	models.FlagHashAlgorithmXxhash: {
+		hash:           xxhash.Sum64,
+		totalBucketNum: FineTotalBucketNum,
+		percentStep:    0.01,
+	},
+	models.FlagHashAlgorithmSha1: {
+		hash: func(b []byte) uint64 {
+			sum := sha1.Sum(b)
+			return binary.BigEndian.Uint64(sum[:8])
+		},
+		totalBucketNum: FineTotalBucketNum,
+		percentStep:    0.01,
+	},
+}
+
+func getHashAlgorithm(name string) (hashAlgorithm, error) {
+	if name == "" {
+		name = DefaultHashAlgorithm
+	}
+	h, ok := hashAlgorithms[name]
+	if !ok {
+		return hashAlgorithm{}, fmt.Errorf("not supported hashAlgorithm: %s", name)
+	}
+	return h, nil
+}
+
+// ValidateHashAlgorithm validates the hash algorithm name, empty means the default one
+func ValidateHashAlgorithm(name string) error {
+	_, err := getHashAlgorithm(name)
+	return err
+}
+
+// ValidatePercent validates that the percent is within 0 to 100 and
+// matches the granularity the hash algorithm supports
+func ValidatePercent(hashAlgorithmName string, percent float64) error {
+	h, err := getHashAlgorithm(hashAlgorithmName)
+	if err != nil {
+		return err
+	}
+	if percent < 0 || percent > 100 {
+		return fmt.Errorf("percent %v is not within 0 to 100", percent)
+	}
+	steps := percent / h.percentStep
+	if math.Abs(steps-math.Round(steps)) > 1e-6 {
+		return fmt.Errorf(
+			"percent %v is finer than the %v%% granularity of hashAlgorithm %s",
+			percent, h.percentStep, util.SafeStringWithDefault(hashAlgorithmName, DefaultHashAlgorithm),
+		)
+	}
+	return nil
+}
+
 // Distribution is the struct represents distribution under segment and links to variant
 type Distribution struct {
 	gorm.Model
@@ -24,31 +109,33 @@ type Distribution struct {
 	VariantID  uint `gorm:"index:idx_distribution_variantid"`
 	VariantKey string
 
-	Percent uint   // Percent is an uint from 0 to 100, percent is always derived from Bitmap
-	Bitmap  string `gorm:"type:text" json:"-"`
+	Percent float64 // Percent is a number from 0 to 100, percent is always derived from Bitmap
+	Bitmap  string  `gorm:"type:text" json:"-"`
 }
 
 // DistributionArray is useful for faster evalution
 type DistributionArray struct {
 	VariantIDs          []uint
-	PercentsAccumulated []int // useful for binary search to find the rollout variant
+	PercentsAccumulated []int // in buckets, useful for binary search to find the rollout variant
+	HashAlgorithm       string
 }
 
 // DistributionDebugLog is useful for making debug logs
 type DistributionDebugLog struct {
+	BucketKey         string
 	BucketNum         uint
 	DistributionArray DistributionArray
 	VariantID         uint
-	RolloutPercent    uint
+	RolloutPercent    float64
 }
 
 // Rollout rolls out the entity based on the rolloutPercent
-func (d DistributionArray) Rollout(entityID string, salt string, rolloutPercent uint) (variantID *uint, msg string) {
+func (d DistributionArray) Rollout(entityID string, salt string, rolloutPercent float64) (variantID *uint, msg string) {
 	if entityID == "" {
 		return nil, "rollout no. empty entityID"
 	}
 
-	if rolloutPercent == uint(0) {
+	if rolloutPercent == float64(0) {
 		return nil, "rollout no. 0% rolloutPercent"
 	}
 
@@ -56,9 +143,15 @@ func (d DistributionArray) Rollout(entityID string, salt string, rolloutPercent
 		return nil, "rollout no. there's no distribution set"
 	}
 
-	num := crc32Num(entityID, salt)
+	h, err := getHashAlgorithm(d.HashAlgorithm)
+	if err != nil {
+		return nil, "rollout no. " + err.Error()
+	}
+
+	num := bucketNum(h, entityID, salt)
 	vID, index := d.bucketByNum(num)
 	log := fmt.Sprintf("%+v", DistributionDebugLog{
+		BucketKey:         entityID,
 		BucketNum:         num,
 		DistributionArray: d,
 		VariantID:         vID,
@@ -76,11 +169,11 @@ func (d DistributionArray) bucketByNum(bucketNum uint) (variantID uint, index in
 	return d.VariantIDs[index], index
 }
 
-func (d DistributionArray) rollout(bucketNum uint, rolloutPercent uint, index int) bool {
-	if rolloutPercent == uint(0) {
+func (d DistributionArray) rollout(bucketNum uint, rolloutPercent float64, index int) bool {
+	if rolloutPercent == float64(0) {
 		return false
 	}
-	if rolloutPercent == uint(100) {
+	if rolloutPercent == float64(100) {
 		return true
 	}
 
@@ -93,11 +186,56 @@ func (d DistributionArray) rollout(bucketNum uint, rolloutPercent uint, index in
 	if max-min-1 > 0 {
 		r = max - min - 1
 	}
-	return 100*(bucketNum-uint(min)) <= uint(r)*rolloutPercent
+	return float64(100*(bucketNum-uint(min))) <= float64(r)*rolloutPercent
 }
 
 func crc32Num(entityID string, salt string) uint {
 	// crc32 is good in terms of uniform distribution
 	// http://michiel.buddingh.eu/distribution-of-hash-values
-	return uint(crc32.ChecksumIEEE([]byte(salt+entityID))) % TotalBucketNum
+	return bucketNum(hashAlgorithms[models.FlagHashAlgorithmCrc32], entityID, salt)
+}
+
+func bucketNum(h hashAlgorithm, entityID string, salt string) uint {
+	return uint(h.hash([]byte(salt+entityID)) % uint64(h.totalBucketNum))
+}
+
+// ParseBucketBy parses the comma separated bucketBy setting into entityContext properties
+func ParseBucketBy(bucketBy string) ([]string, error) {
+	if strings.TrimSpace(bucketBy) == "" {
+		return nil, nil
+	}
+
+	props := []string{}
+	for _, p := range strings.Split(bucketBy, ",") {
+		p = strings.TrimSpace(p)
+		if ok, reason := util.IsSafeKey(p); !ok {
+			return nil, fmt.Errorf("invalid bucketBy property. reason: %s", reason)
+		}
+		props = append(props, p)
+	}
+	return props, nil
+}
+
+// BucketKey returns the key used for the consistent hashing of the rollout.
+// It's the entityID if bucketBy is empty, otherwise it's the values of the
+// bucketBy properties in the entityContext joined by "|".
+func BucketKey(entityID string, entityContext interface{}, bucketBy []string) (string, error) {
+	if len(bucketBy) == 0 {
+		return entityID, nil
+	}
+
+	m, ok := entityContext.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("bucketBy %v is set, but got invalid entity_context", bucketBy)
+	}
+
+	values := make([]string, 0, len(bucketBy))
+	for _, p := range bucketBy {
+		v := util.SafeString(m[p])
+		if v == "" {
+			return "", fmt.Errorf("bucketBy property %s is missing in entity_context", p)
+		}
+		values = append(values, v)
+	}
+	return strings.Join(values, "|"), nil
 }
@@ -1,8 +1,10 @@
 package entity
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/openflagr/flagr/swagger_gen/models"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -118,24 +120,24 @@ func TestRollout(t *testing.T) {
 		VariantIDs:          []uint{1111, 2222},
 		PercentsAccumulated: []int{500, 1000},
 	}
-	assert.Equal(t, d.rollout(uint(0), uint(100), 0), true)
-	assert.Equal(t, d.rollout(uint(0), uint(50), 0), true)
-	assert.Equal(t, d.rollout(uint(0), uint(1), 0), true)
-	assert.Equal(t, d.rollout(uint(0), uint(0), 0), false)
-
-	assert.Equal(t, d.rollout(uint(0), uint(50), 0), true)
-	assert.Equal(t, d.rollout(uint(249), uint(50), 0), true)
-	assert.Equal(t, d.rollout(uint(250), uint(50), 0), false)
-	assert.Equal(t, d.rollout(uint(499), uint(50), 0), false)
-
-	assert.Equal(t, d.rollout(uint(500), uint(50), 1), true)
-	assert.Equal(t, d.rollout(uint(749), uint(50), 1), true)
-	assert.Equal(t, d.rollout(uint(750), uint(50), 1), false)
-	assert.Equal(t, d.rollout(uint(999), uint(50), 1), false)
-
-	assert.Equal(t, d.rollout(uint(0), uint(34), 0), true)
-	assert.Equal(t, d.rollout(uint(500*0.34-1), uint(34), 0), true)
-	assert.Equal(t, d.rollout(uint(500*0.34), uint(34), 0), false)
+	assert.Equal(t, d.rollout(uint(0), float64(100), 0), true)
+	assert.Equal(t, d.rollout(uint(0), float64(50), 0), true)
+	assert.Equal(t, d.rollout(uint(0), float64(1), 0), true)
+	assert.Equal(t, d.rollout(uint(0), float64(0), 0), false)
+
+	assert.Equal(t, d.rollout(uint(0), float64(50), 0), true)
+	assert.Equal(t, d.rollout(uint(249), float64(50), 0), true)
+	assert.Equal(t, d.rollout(uint(250), float64(50), 0), false)
+	assert.Equal(t, d.rollout(uint(499), float64(50), 0), false)
+
+	assert.Equal(t, d.rollout(uint(500), float64(50), 1), true)
+	assert.Equal(t, d.rollout(uint(749), float64(50), 1), true)
+	assert.Equal(t, d.rollout(uint(750), float64(50), 1), false)
+	assert.Equal(t, d.rollout(uint(999), float64(50), 1), false)
+
+	assert.Equal(t, d.rollout(uint(0), float64(34), 0), true)
+	assert.Equal(t, d.rollout(uint(500*0.34-1), float64(34), 0), true)
+	assert.Equal(t, d.rollout(uint(500*0.34), float64(34), 0), false)
 }
 
 func TestRolloutWithEntity(t *testing.T) {
@@ -147,19 +149,19 @@ func TestRolloutWithEntity(t *testing.T) {
 		var vID *uint
 		var msg string
 
-		vID, msg = d.Rollout("", "salt", uint(0))
+		vID, msg = d.Rollout("", "salt", float64(0))
 		assert.Nil(t, vID)
 		assert.Contains(t, msg, "no")
 
-		vID, msg = d.Rollout("entity123", "salt", uint(0))
+		vID, msg = d.Rollout("entity123", "salt", float64(0))
 		assert.Nil(t, vID)
 		assert.Contains(t, msg, "no")
 
-		vID, msg = d.Rollout("entity123", "salt", uint(100))
+		vID, msg = d.Rollout("entity123", "salt", float64(100))
 		assert.NotNil(t, vID)
 		assert.Contains(t, msg, "yes")
 
-		vID, msg = d.Rollout("entity123", "salt", uint(1))
+		vID, msg = d.Rollout("entity123", "salt", float64(1))
 		assert.Nil(t, vID)
 		assert.Contains(t, msg, "no")
 	})
@@ -172,8 +174,106 @@ func TestRolloutWithEntity(t *testing.T) {
 		var vID *uint
 		var msg string
 
-		vID, msg = d.Rollout("entity123", "salt", uint(100))
+		vID, msg = d.Rollout("entity123", "salt", float64(100))
 		assert.Nil(t, vID)
 		assert.Contains(t, msg, "no")
 	})
 }
+
+func TestParseBucketBy(t *testing.T) {
+	t.Run("empty bucketBy", func(t *testing.T) {
+		props, err := ParseBucketBy("")
+		assert.NoError(t, err)
+		assert.Empty(t, props)
+	})
+
+	t.Run("composite bucketBy", func(t *testing.T) {
+		props, err := ParseBucketBy("org_id, region")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"org_id", "region"}, props)
+	})
+
+	t.Run("invalid bucketBy", func(t *testing.T) {
+		_, err := ParseBucketBy("org id,")
+		assert.Error(t, err)
+	})
+}
+
+func TestBucketKey(t *testing.T) {
+	t.Run("default to entityID", func(t *testing.T) {
+		key, err := BucketKey("entity1", nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "entity1", key)
+	})
+
+	t.Run("bucket by entityContext properties", func(t *testing.T) {
+		key, err := BucketKey("entity1", map[string]interface{}{"org_id": 123, "region": "us"}, []string{"org_id", "region"})
+		assert.NoError(t, err)
+		assert.Equal(t, "123|us", key)
+	})
+
+	t.Run("missing property", func(t *testing.T) {
+		_, err := BucketKey("entity1", map[string]interface{}{"region": "us"}, []string{"org_id"})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid entityContext", func(t *testing.T) {
+		_, err := BucketKey("entity1", nil, []string{"org_id"})
+		assert.Error(t, err)
+	})
+}
+
+func TestValidatePercent(t *testing.T) {
+	assert.NoError(t, ValidatePercent("", 50))
+	assert.NoError(t, ValidatePercent(models.FlagHashAlgorithmCrc32, 0.1))
+	assert.Error(t, ValidatePercent(models.FlagHashAlgorithmCrc32, 0.01))
+	assert.NoError(t, ValidatePercent(models.FlagHashAlgorithmMurmur3, 0.01))
+	assert.Error(t, ValidatePercent(models.FlagHashAlgorithmMurmur3, 0.001))
+	assert.Error(t, ValidatePercent(models.FlagHashAlgorithmXxhash, 100.01))
+	assert.Error(t, ValidatePercent("md5", 50))
+}
+
+func TestRolloutWithHashAlgorithms(t *testing.T) {
+	for _, h := range []string{
+		models.FlagHashAlgorithmCrc32,
+		models.FlagHashAlgorithmMurmur3,
+		models.FlagHashAlgorithmXxhash,
+		models.FlagHashAlgorithmSha1,
+	} {
+		t.Run(h, func(t *testing.T) {
+			s := GenFixtureSegment()
+			assert.NoError(t, s.prepareEvaluation(h))
+			d := s.SegmentEvaluation.DistributionArray
+
+			counts := map[uint]int{}
+			for i := 0; i < 10000; i++ {
+				vID, msg := d.Rollout(fmt.Sprintf("entity%d", i), "salt", float64(100))
+				assert.NotNil(t, vID, msg)
+				counts[*vID]++
+			}
+			assert.InDelta(t, 5000, counts[300], 300)
+			assert.InDelta(t, 5000, counts[301], 300)
+
+			vID1, _ := d.Rollout("entity1", "salt", float64(100))
+			vID2, _ := d.Rollout("entity1", "salt", float64(100))
+			assert.Equal(t, *vID1, *vID2)
+		})
+	}
+
+	t.Run("basis point rollout", func(t *testing.T) {
+		s := GenFixtureSegment()
+		s.Distributions[0].Percent = 99.99
+		s.Distributions[1].Percent = 0.01
+		assert.NoError(t, s.prepareEvaluation(models.FlagHashAlgorithmXxhash))
+		d := s.SegmentEvaluation.DistributionArray
+		assert.Equal(t, []int{999900, 1000000}, d.PercentsAccumulated)
+
+		rolledOut := 0
+		for i := 0; i < 100000; i++ {
+			if vID, _ := d.Rollout(fmt.Sprintf("entity%d", i), "salt", 0.5); vID != nil {
+				rolledOut++
+			}
+		}
+		assert.InDelta(t, 500, rolledOut, 100)
+	})
+}
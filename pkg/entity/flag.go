@@ -19,18 +19,46 @@ type Flag struct {
 	Segments    []Segment
 	Variants    []Variant
 	Tags        []Tag `gorm:"many2many:flags_tags;"`
+	Overrides   []VariantOverride
 	SnapshotID  uint
 	Notes       string `gorm:"type:text"`
 
 	DataRecordsEnabled bool
 	EntityType         string
 
+	// BucketBy is a comma separated list of entityContext properties used as the
+	// bucketing key for rollouts, e.g. "org_id". It defaults to the entityID if empty.
+	BucketBy string
+
+	// HashAlgorithm is the hash function used for the consistent hashing of rollouts,
+	// it defaults to crc32 if empty.
+	HashAlgorithm string
+
+	// DefaultVariantID is the variant returned when the flag is disabled, has no segments,
+	// or no segment matches. There's no default variant if it's 0.
+	DefaultVariantID uint
+
+	// ValueType is the type of the flag value in the attachments of the variants, it's
+	// untyped if empty. ValueSchema is the optional JSON Schema of the flag value.
+	ValueType   string `gorm:"type:varchar(16)"`
+	ValueSchema string `gorm:"type:text"`
+
+	// ClientVisible marks the flag to be evaluated by the bootstrap evaluation for clients
+	ClientVisible bool
+
+	// StickyAssignments keeps the variant an entity first gets from a segment, even if the
+	// segments or the distributions change, until the AssignmentSalt is reset
+	StickyAssignments bool
+	AssignmentSalt    string `gorm:"type:varchar(64)"`
+
 	FlagEvaluation FlagEvaluation `gorm:"-" json:"-"`
 }
 
 // FlagEvaluation is a struct that holds the necessary info for evaluation
 type FlagEvaluation struct {
-	VariantsMap map[uint]*Variant
+	VariantsMap  map[uint]*Variant
+	BucketBy     []string
+	OverridesMap map[variantOverrideKey]*VariantOverride
 }
 
 // Preloads just the tags
@@ -40,7 +68,7 @@ func PreloadFlagTags(db *gorm.DB) *gorm.DB {
 	})
 }
 
-// PreloadSegmentsVariantsTags preloads segments, variants and tags for flag
+// PreloadSegmentsVariantsTags preloads segments, variants, tags and overrides for flag
 func PreloadSegmentsVariantsTags(db *gorm.DB) *gorm.DB {
 	return db.
 		Preload("Segments", func(db *gorm.DB) *gorm.DB {
@@ -53,10 +81,13 @@ func PreloadSegmentsVariantsTags(db *gorm.DB) *gorm.DB {
 		}).
 		Preload("Tags", func(db *gorm.DB) *gorm.DB {
 			return db.Order("id")
+		}).
+		Preload("Overrides", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
 		})
 }
 
-// Preload preloads the segments, variants and tags into flags
+// Preload preloads the segments, variants, tags and overrides into flags
 func (f *Flag) Preload(db *gorm.DB) error {
 	return PreloadSegmentsVariantsTags(db).First(f, f.Model.ID).Error
 }
@@ -68,20 +99,48 @@ func (f *Flag) PreloadTags(db *gorm.DB) error {
 
 // PrepareEvaluation prepares the information for evaluation
 func (f *Flag) PrepareEvaluation() error {
+	bucketBy, err := ParseBucketBy(f.BucketBy)
+	if err != nil {
+		return err
+	}
 	f.FlagEvaluation = FlagEvaluation{
-		VariantsMap: make(map[uint]*Variant),
+		VariantsMap:  make(map[uint]*Variant),
+		BucketBy:     bucketBy,
+		OverridesMap: make(map[variantOverrideKey]*VariantOverride),
 	}
 	for i := range f.Segments {
-		if err := f.Segments[i].PrepareEvaluation(); err != nil {
+		if err := f.Segments[i].prepareEvaluation(f.HashAlgorithm); err != nil {
 			return err
 		}
+		// segments without their own bucketBy inherit the flag's one
+		if len(f.Segments[i].SegmentEvaluation.BucketBy) == 0 {
+			f.Segments[i].SegmentEvaluation.BucketBy = bucketBy
+		}
 	}
 	for i := range f.Variants {
 		f.FlagEvaluation.VariantsMap[f.Variants[i].Model.ID] = &f.Variants[i]
 	}
+	for i := range f.Overrides {
+		o := &f.Overrides[i]
+		f.FlagEvaluation.OverridesMap[variantOverrideKey{o.EntityType, o.EntityID}] = o
+	}
 	return nil
 }
 
+// LinkAudiences links the compiled audiences referenced by the segments for evaluation
+func (f *Flag) LinkAudiences(audiences map[uint]*Audience) {
+	for i := range f.Segments {
+		f.Segments[i].LinkAudiences(audiences)
+	}
+}
+
+// LinkIDLists links the ID lists referenced by the constraints of the segments for evaluation
+func (f *Flag) LinkIDLists(lists map[string]*IDList) {
+	for i := range f.Segments {
+		f.Segments[i].LinkIDLists(lists)
+	}
+}
+
 // CreateFlagKey creates the key based on the given key
 func CreateFlagKey(key string) (string, error) {
 	if key == "" {
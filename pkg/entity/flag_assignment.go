@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/dchest/uniuri"
+)
+
+// FlagAssignment is the sticky assignment of the variant to the entity of the flag with
+// StickyAssignments. The assignments of an old AssignmentSalt of the flag are not used.
+type FlagAssignment struct {
+	ID        uint   `gorm:"primarykey"`
+	FlagID    uint   `gorm:"uniqueIndex:idx_flag_assignment"`
+	Salt      string `gorm:"type:varchar(64);uniqueIndex:idx_flag_assignment"`
+	EntityID  string `gorm:"type:varchar(255);uniqueIndex:idx_flag_assignment"`
+	VariantID uint
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewAssignmentSalt generates a new AssignmentSalt of the flag
+func NewAssignmentSalt() string {
+	return uniuri.NewLen(16)
+}
@@ -1,12 +1,8 @@
 package entity
 
 import (
-	"fmt"
-
 	"encoding/json"
 
-	"github.com/openflagr/flagr/pkg/config"
-	"github.com/openflagr/flagr/pkg/util"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
@@ -69,20 +65,11 @@ func SaveFlagSnapshot(db *gorm.DB, flagID uint, updatedBy string) {
 		tx.Rollback()
 	}
 
-	logFlagSnapshotUpdate(flagID, updatedBy)
-}
-
-var logFlagSnapshotUpdate = func(flagID uint, updatedBy string) {
-	if config.Global.StatsdClient == nil {
-		return
+	if FlagSnapshotSaved != nil {
+		FlagSnapshotSaved(flagID, updatedBy)
 	}
-
-	config.Global.StatsdClient.Incr(
-		"flag.snapshot.updated",
-		[]string{
-			fmt.Sprintf("FlagID:%d", flagID),
-			fmt.Sprintf("UpdatedBy:%s", util.SafeStringWithDefault(updatedBy, "null")),
-		},
-		float64(1),
-	)
 }
+
+// FlagSnapshotSaved is called after a flag snapshot is saved, e.g. to count the updates in statsd.
+// It's set by the server, so that the entities don't depend on its config.
+var FlagSnapshotSaved func(flagID uint, updatedBy string)
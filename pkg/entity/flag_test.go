@@ -13,6 +13,22 @@ func TestFlagPrepareEvaluation(t *testing.T) {
 		assert.NotNil(t, f.FlagEvaluation.VariantsMap)
 		assert.NotNil(t, f.Tags)
 	})
+
+	t.Run("segments inherit the flag's bucketBy", func(t *testing.T) {
+		f := GenFixtureFlag()
+		f.BucketBy = "org_id"
+		f.Segments = append(f.Segments, GenFixtureSegment())
+		f.Segments[1].BucketBy = "account_id"
+		assert.NoError(t, f.PrepareEvaluation())
+		assert.Equal(t, []string{"org_id"}, f.Segments[0].SegmentEvaluation.BucketBy)
+		assert.Equal(t, []string{"account_id"}, f.Segments[1].SegmentEvaluation.BucketBy)
+	})
+
+	t.Run("invalid bucketBy", func(t *testing.T) {
+		f := GenFixtureFlag()
+		f.BucketBy = "org id"
+		assert.Error(t, f.PrepareEvaluation())
+	})
 }
 
 func TestFlagPreload(t *testing.T) {
@@ -0,0 +1,153 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// The value types of flags. The value of an untyped flag is just the attachment of its variant.
+const (
+	ValueTypeBoolean = "boolean"
+	ValueTypeString  = "string"
+	ValueTypeNumber  = "number"
+	ValueTypeObject  = "object"
+)
+
+// AttachmentValueKey is the key of the value in the attachment of the variant, for the
+// flags of the boolean, string and number value types, e.g. {"value": true}
+const AttachmentValueKey = "value"
+
+// ValidateValueType validates the value type of the flag, an empty one means untyped
+func ValidateValueType(valueType string) error {
+	switch valueType {
+	case "", ValueTypeBoolean, ValueTypeString, ValueTypeNumber, ValueTypeObject:
+		return nil
+	default:
+		return fmt.Errorf(
+			"invalid valueType %s. expecting one of %s, %s, %s, %s or empty",
+			valueType, ValueTypeBoolean, ValueTypeString, ValueTypeNumber, ValueTypeObject,
+		)
+	}
+}
+
+// ParseValueSchema parses the JSON Schema of the flag value. Only the local $ref, e.g.
+// "#/definitions/color", is supported, so that validating never fetches a remote schema.
+func ParseValueSchema(s string) (*spec.Schema, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var raw interface{}
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, fmt.Errorf("invalid valueSchema. reason: %s", err)
+	}
+	if _, ok := raw.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("invalid valueSchema. expecting a JSON object")
+	}
+	if ref, ok := findRemoteRef(raw); ok {
+		return nil, fmt.Errorf("invalid valueSchema. only the local $ref is supported, got %s", ref)
+	}
+
+	schema := &spec.Schema{}
+	if err := json.Unmarshal([]byte(s), schema); err != nil {
+		return nil, fmt.Errorf("invalid valueSchema. reason: %s", err)
+	}
+	if err := spec.ExpandSchema(schema, schema, nil); err != nil {
+		return nil, fmt.Errorf("invalid valueSchema. reason: %s", err)
+	}
+	return schema, nil
+}
+
+func findRemoteRef(v interface{}) (string, bool) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			if ref, ok := child.(string); ok && k == "$ref" && !strings.HasPrefix(ref, "#") {
+				return ref, true
+			}
+			if ref, ok := findRemoteRef(child); ok {
+				return ref, true
+			}
+		}
+	case []interface{}:
+		for _, child := range t {
+			if ref, ok := findRemoteRef(child); ok {
+				return ref, true
+			}
+		}
+	}
+	return "", false
+}
+
+// FlagValue returns the flag value in the attachment of the variant for the value type
+func (a Attachment) FlagValue(valueType string) interface{} {
+	switch valueType {
+	case ValueTypeBoolean, ValueTypeString, ValueTypeNumber:
+		return a[AttachmentValueKey]
+	default:
+		return map[string]interface{}(a)
+	}
+}
+
+// ValidateAttachment validates the attachment of the variant against the value type
+// and the value schema of the flag
+func (f *Flag) ValidateAttachment(a Attachment) error {
+	if err := validateAttachmentType(f.ValueType, a); err != nil {
+		return err
+	}
+	schema, err := ParseValueSchema(f.ValueSchema)
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		return nil
+	}
+
+	// round trip the value through JSON, so that it has the same types as the JSON input
+	b, err := json.Marshal(a.FlagValue(f.ValueType))
+	if err != nil {
+		return err
+	}
+	var value interface{}
+	if err := json.Unmarshal(b, &value); err != nil {
+		return err
+	}
+	if err := validate.AgainstSchema(schema, value, strfmt.Default); err != nil {
+		return fmt.Errorf("attachment doesn't match the valueSchema of the flag. reason: %s", err)
+	}
+	return nil
+}
+
+func validateAttachmentType(valueType string, a Attachment) error {
+	if valueType == "" || valueType == ValueTypeObject {
+		return nil
+	}
+	v, ok := a[AttachmentValueKey]
+	if !ok || len(a) != 1 {
+		return fmt.Errorf(
+			`attachment of the %s flag is expecting only the %q key, e.g. {"%s": ...}`,
+			valueType, AttachmentValueKey, AttachmentValueKey,
+		)
+	}
+
+	valid := false
+	switch valueType {
+	case ValueTypeBoolean:
+		_, valid = v.(bool)
+	case ValueTypeString:
+		_, valid = v.(string)
+	case ValueTypeNumber:
+		switch v.(type) {
+		case float64, float32, int, int64, json.Number:
+			valid = true
+		}
+	}
+	if !valid {
+		return fmt.Errorf("attachment value %v is not a %s", v, valueType)
+	}
+	return nil
+}
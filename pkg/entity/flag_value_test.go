@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateValueType(t *testing.T) {
+	for _, valueType := range []string{"", ValueTypeBoolean, ValueTypeString, ValueTypeNumber, ValueTypeObject} {
+		assert.NoError(t, ValidateValueType(valueType))
+	}
+	assert.Error(t, ValidateValueType("integer"))
+}
+
+func TestParseValueSchema(t *testing.T) {
+	schema, err := ParseValueSchema("")
+	assert.NoError(t, err)
+	assert.Nil(t, schema)
+
+	schema, err = ParseValueSchema(`{"type": "object", "properties": {"color": {"$ref": "#/definitions/color"}}, "definitions": {"color": {"type": "string"}}}`)
+	assert.NoError(t, err)
+	assert.NotNil(t, schema)
+
+	_, err = ParseValueSchema(`{"type": `)
+	assert.Error(t, err)
+
+	_, err = ParseValueSchema(`["string"]`)
+	assert.Error(t, err)
+
+	_, err = ParseValueSchema(`{"properties": {"color": {"$ref": "https://example.com/color.json"}}}`)
+	assert.Error(t, err)
+}
+
+func TestFlagValidateAttachment(t *testing.T) {
+	t.Run("untyped flag", func(t *testing.T) {
+		f := &Flag{}
+		assert.NoError(t, f.ValidateAttachment(nil))
+		assert.NoError(t, f.ValidateAttachment(Attachment{"anything": 1}))
+	})
+
+	t.Run("scalar types", func(t *testing.T) {
+		f := &Flag{ValueType: ValueTypeBoolean}
+		assert.NoError(t, f.ValidateAttachment(Attachment{"value": true}))
+		assert.Error(t, f.ValidateAttachment(Attachment{"value": "true"}))
+		assert.Error(t, f.ValidateAttachment(Attachment{"value": true, "extra": 1}))
+		assert.Error(t, f.ValidateAttachment(nil))
+
+		f = &Flag{ValueType: ValueTypeString}
+		assert.NoError(t, f.ValidateAttachment(Attachment{"value": "green"}))
+		assert.Error(t, f.ValidateAttachment(Attachment{"value": 1}))
+
+		f = &Flag{ValueType: ValueTypeNumber}
+		assert.NoError(t, f.ValidateAttachment(Attachment{"value": 1.5}))
+		assert.NoError(t, f.ValidateAttachment(Attachment{"value": 3}))
+		assert.Error(t, f.ValidateAttachment(Attachment{"value": "1.5"}))
+	})
+
+	t.Run("scalar type with schema", func(t *testing.T) {
+		f := &Flag{ValueType: ValueTypeNumber, ValueSchema: `{"minimum": 0, "maximum": 10}`}
+		assert.NoError(t, f.ValidateAttachment(Attachment{"value": 3}))
+		assert.Error(t, f.ValidateAttachment(Attachment{"value": 11}))
+	})
+
+	t.Run("object type with schema", func(t *testing.T) {
+		f := &Flag{
+			ValueType:   ValueTypeObject,
+			ValueSchema: `{"type": "object", "required": ["hex_color"], "properties": {"hex_color": {"type": "string", "pattern": "^#[0-9a-f]{6}$"}}}`,
+		}
+		assert.NoError(t, f.ValidateAttachment(Attachment{"hex_color": "#42b983"}))
+		assert.Error(t, f.ValidateAttachment(Attachment{"hex_colour": "#42b983"}))
+		assert.Error(t, f.ValidateAttachment(Attachment{"hex_color": "green"}))
+	})
+}
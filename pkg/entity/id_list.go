@@ -0,0 +1,357 @@
+package entity
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// idListBatchSize is the number of entries inserted or deleted in a single query
+const idListBatchSize = 1000
+
+// IDListEntryMaxLength is the max length of an entry of the ID list
+const IDListEntryMaxLength = 255
+
+// IDList is a managed list of entity IDs that constraints can reference by the key
+// with the IN_LIST and NOT_IN_LIST operators. The entries are stored out of the
+// constraints in IDListEntry, and loaded into a hash set for evaluation.
+type IDList struct {
+	gorm.Model
+
+	Key         string `gorm:"type:varchar(64);uniqueIndex:idx_id_list_key"`
+	Description string `gorm:"type:text"`
+
+	// Values are the entries, only loaded for the evaluation cache
+	Values []string `gorm:"-"`
+
+	// EntryCount is only loaded for the API
+	EntryCount int64 `gorm:"-" json:"-"`
+
+	// Purely for evaluation
+	IDListEvaluation IDListEvaluation `gorm:"-" json:"-"`
+}
+
+// IDListEvaluation is a struct that holds the necessary info for evaluation
+type IDListEvaluation struct {
+	Set map[string]struct{}
+}
+
+// IDListEntry is an entry of the IDList. It's deleted permanently instead of
+// soft deleted, so that the same entry can be appended again.
+type IDListEntry struct {
+	ID       uint   `gorm:"primarykey"`
+	IDListID uint   `gorm:"uniqueIndex:idx_id_list_entry"`
+	Value    string `gorm:"type:varchar(255);uniqueIndex:idx_id_list_entry"`
+}
+
+// IDListOperators are the operators referencing the ID lists
+var IDListOperators = map[string]bool{
+	models.ConstraintOperatorINLIST:    true,
+	models.ConstraintOperatorNOTINLIST: true,
+}
+
+// Validate validates the IDList
+func (l *IDList) Validate() error {
+	if ok, reason := util.IsSafeKey(l.Key); !ok {
+		return fmt.Errorf("invalid ID list key. reason: %s", reason)
+	}
+	return nil
+}
+
+// PrepareEvaluation prepares the ID list for evaluation by building the hash set of the entries
+func (l *IDList) PrepareEvaluation() error {
+	set := make(map[string]struct{}, len(l.Values))
+	for _, v := range l.Values {
+		set[v] = struct{}{}
+	}
+	l.IDListEvaluation = IDListEvaluation{Set: set}
+	return nil
+}
+
+// ParseIDListEntries parses the body of the entries, which is either CSV or one entry
+// per line. Empty and duplicated entries are dropped.
+func ParseIDListEntries(body string) ([]string, error) {
+	r := csv.NewReader(strings.NewReader(body))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	values := []string{}
+	seen := map[string]bool{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse the entries. %s", err)
+		}
+		for _, field := range record {
+			v := strings.TrimSpace(field)
+			if v == "" || seen[v] {
+				continue
+			}
+			if len(v) > IDListEntryMaxLength {
+				return nil, fmt.Errorf("entry %.32s... is longer than %d", v, IDListEntryMaxLength)
+			}
+			seen[v] = true
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
+
+// AppendIDListEntries appends the entries to the ID list, and ignores the existing ones
+func AppendIDListEntries(db *gorm.DB, idListID uint, values []string) error {
+	if len(values) == 0 {
+		return nil
+	}
+	entries := make([]IDListEntry, len(values))
+	for i, v := range values {
+		entries[i] = IDListEntry{IDListID: idListID, Value: v}
+	}
+	return db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(entries, idListBatchSize).Error
+}
+
+// RemoveIDListEntries removes the entries from the ID list
+func RemoveIDListEntries(db *gorm.DB, idListID uint, values []string) error {
+	for start := 0; start < len(values); start += idListBatchSize {
+		end := start + idListBatchSize
+		if end > len(values) {
+			end = len(values)
+		}
+		err := db.
+			Where("id_list_id = ? AND value IN (?)", idListID, values[start:end]).
+			Delete(&IDListEntry{}).
+			Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReplaceIDListEntries replaces all the entries of the ID list
+func ReplaceIDListEntries(db *gorm.DB, idListID uint, values []string) error {
+	if err := db.Where("id_list_id = ?", idListID).Delete(&IDListEntry{}).Error; err != nil {
+		return err
+	}
+	return AppendIDListEntries(db, idListID, values)
+}
+
+// LoadIDListEntryCounts loads the EntryCount of the ID lists
+func LoadIDListEntryCounts(db *gorm.DB, ls []IDList) error {
+	if len(ls) == 0 {
+		return nil
+	}
+	ids := make([]uint, len(ls))
+	for i, l := range ls {
+		ids[i] = l.ID
+	}
+
+	type result struct {
+		IDListID uint
+		Count    int64
+	}
+	rs := []result{}
+	err := db.Model(&IDListEntry{}).
+		Select("id_list_id, count(*) as count").
+		Where("id_list_id IN (?)", ids).
+		Group("id_list_id").
+		Scan(&rs).
+		Error
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[uint]int64, len(rs))
+	for _, r := range rs {
+		counts[r.IDListID] = r.Count
+	}
+	for i := range ls {
+		ls[i].EntryCount = counts[ls[i].ID]
+	}
+	return nil
+}
+
+// LoadIDListValues loads the Values of the ID lists for the evaluation cache
+func LoadIDListValues(db *gorm.DB, ls []IDList) error {
+	if len(ls) == 0 {
+		return nil
+	}
+	index := make(map[uint]*IDList, len(ls))
+	for i := range ls {
+		ls[i].Values = []string{}
+		index[ls[i].ID] = &ls[i]
+	}
+
+	rows, err := db.Model(&IDListEntry{}).Select("id_list_id, value").Order("id").Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var idListID uint
+		var value string
+		if err := rows.Scan(&idListID, &value); err != nil {
+			return err
+		}
+		if l, ok := index[idListID]; ok {
+			l.Values = append(l.Values, value)
+		}
+	}
+	return rows.Err()
+}
+
+// IsIDListReferenced checks if any constraint of the segments or the audiences,
+// including the ones in the constraint groups, references the ID list
+func IsIDListReferenced(db *gorm.DB, key string) (bool, error) {
+	operators := []string{models.ConstraintOperatorINLIST, models.ConstraintOperatorNOTINLIST}
+
+	cs := []Constraint{}
+	if err := db.Where("operator IN (?)", operators).Find(&cs).Error; err != nil {
+		return false, err
+	}
+	for _, c := range cs {
+		if idListKey(c.Value) == key {
+			return true, nil
+		}
+	}
+
+	segments := []Segment{}
+	if err := db.Select("id, constraint_groups").Where("constraint_groups LIKE ?", "%IN_LIST%").Find(&segments).Error; err != nil {
+		return false, err
+	}
+	for _, s := range segments {
+		if s.ConstraintGroups.referencesIDList(key) {
+			return true, nil
+		}
+	}
+
+	audiences := []Audience{}
+	if err := db.Select("id, constraint_groups").Where("constraint_groups LIKE ?", "%IN_LIST%").Find(&audiences).Error; err != nil {
+		return false, err
+	}
+	for _, a := range audiences {
+		if a.ConstraintGroups.referencesIDList(key) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (gs ConstraintGroups) referencesIDList(key string) bool {
+	for _, g := range gs {
+		for _, c := range g.Constraints {
+			if IDListOperators[c.Operator] && idListKey(c.Value) == key {
+				return true
+			}
+		}
+		if g.Groups.referencesIDList(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// IDListKeys returns the keys of the ID lists referenced by the constraints of the segment
+func (s *Segment) IDListKeys() []string {
+	return idListKeys(s.Constraints, s.ConstraintGroups)
+}
+
+// IDListKeys returns the keys of the ID lists referenced by the constraints of the audience
+func (a *Audience) IDListKeys() []string {
+	return idListKeys(a.Constraints, a.ConstraintGroups)
+}
+
+func idListKeys(cs ConstraintArray, gs ConstraintGroups) []string {
+	keys := []string{}
+	for _, c := range cs {
+		if IDListOperators[c.Operator] {
+			keys = append(keys, idListKey(c.Value))
+		}
+	}
+	for _, g := range gs {
+		for _, c := range g.Constraints {
+			if IDListOperators[c.Operator] {
+				keys = append(keys, idListKey(c.Value))
+			}
+		}
+		keys = append(keys, idListKeys(nil, g.Groups)...)
+	}
+	return keys
+}
+
+// idListKey gets the key of the ID list from the constraint's value, which can be quoted
+func idListKey(value string) string {
+	value = strings.TrimSpace(value)
+	if unquoted, err := strconv.Unquote(value); err == nil {
+		value = unquoted
+	}
+	return value
+}
+
+// idListCondition checks the property of the entity context against the linked ID list
+type idListCondition struct {
+	property string
+	operator string
+	key      string
+	list     *IDList
+}
+
+func newIDListCondition(c *Constraint) (Condition, error) {
+	if c.Property == "" || c.Value == "" {
+		return nil, fmt.Errorf("empty Property/Operator/Value: %s/%s/%s", c.Property, c.Operator, c.Value)
+	}
+	key := idListKey(c.Value)
+	if ok, reason := util.IsSafeKey(key); !ok {
+		return nil, fmt.Errorf("invalid ID list key %s for operator %s. reason: %s", c.Value, c.Operator, reason)
+	}
+	return &idListCondition{property: c.Property, operator: c.Operator, key: key}, nil
+}
+
+func (c *idListCondition) Evaluate(m map[string]interface{}) (bool, error) {
+	if c.list == nil {
+		return false, fmt.Errorf("id list %s not found", c.key)
+	}
+	v, ok := m[c.property]
+	if !ok {
+		return false, fmt.Errorf("argument: %v not found", c.property)
+	}
+	_, in := c.list.IDListEvaluation.Set[util.SafeString(v)]
+	return in == (c.operator == models.ConstraintOperatorINLIST), nil
+}
+
+func (c *idListCondition) String() string {
+	return fmt.Sprintf("(%s %s %q)", c.property, c.operator, c.key)
+}
+
+func (c *idListCondition) linkIDLists(lists map[string]*IDList) {
+	c.list = lists[c.key]
+}
+
+// idListLinker is implemented by the conditions that reference ID lists
+type idListLinker interface {
+	linkIDLists(lists map[string]*IDList)
+}
+
+func (g *groupCondition) linkIDLists(lists map[string]*IDList) {
+	for _, c := range g.conditions {
+		linkIDLists(c, lists)
+	}
+}
+
+// linkIDLists links the ID lists to the condition. A missing ID list is kept as
+// nil, which fails the evaluation of the constraint.
+func linkIDLists(c Condition, lists map[string]*IDList) {
+	if l, ok := c.(idListLinker); ok {
+		l.linkIDLists(lists)
+	}
+}
@@ -0,0 +1,162 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIDListEntries(t *testing.T) {
+	t.Run("newline separated", func(t *testing.T) {
+		values, err := ParseIDListEntries("u1\nu2\r\n\n u3 \nu1\n")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u1", "u2", "u3"}, values)
+	})
+	t.Run("comma separated", func(t *testing.T) {
+		values, err := ParseIDListEntries("u1, u2,\"u,3\"\nu4,,u1")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u1", "u2", "u,3", "u4"}, values)
+	})
+	t.Run("empty body", func(t *testing.T) {
+		values, err := ParseIDListEntries("")
+		assert.NoError(t, err)
+		assert.Empty(t, values)
+	})
+	t.Run("invalid CSV", func(t *testing.T) {
+		_, err := ParseIDListEntries("u1,\"u2")
+		assert.Error(t, err)
+	})
+	t.Run("too long entry", func(t *testing.T) {
+		_, err := ParseIDListEntries(strings.Repeat("u", IDListEntryMaxLength+1))
+		assert.Error(t, err)
+	})
+}
+
+func TestIDListEntries(t *testing.T) {
+	db := NewTestDB()
+	l := IDList{Key: "beta_users"}
+	db.Create(&l)
+	other := IDList{Key: "other_users"}
+	db.Create(&other)
+
+	assert.NoError(t, AppendIDListEntries(db, l.ID, []string{"u1", "u2"}))
+	assert.NoError(t, AppendIDListEntries(db, l.ID, []string{"u2", "u3"}))
+	assert.NoError(t, AppendIDListEntries(db, other.ID, []string{"u1"}))
+
+	ls := []IDList{l, other}
+	assert.NoError(t, LoadIDListValues(db, ls))
+	assert.Equal(t, []string{"u1", "u2", "u3"}, ls[0].Values)
+	assert.Equal(t, []string{"u1"}, ls[1].Values)
+
+	assert.NoError(t, RemoveIDListEntries(db, l.ID, []string{"u1", "u9"}))
+	assert.NoError(t, LoadIDListEntryCounts(db, ls))
+	assert.Equal(t, int64(2), ls[0].EntryCount)
+	assert.Equal(t, int64(1), ls[1].EntryCount)
+
+	assert.NoError(t, ReplaceIDListEntries(db, l.ID, []string{"u7"}))
+	assert.NoError(t, LoadIDListValues(db, ls))
+	assert.Equal(t, []string{"u7"}, ls[0].Values)
+	assert.Equal(t, []string{"u1"}, ls[1].Values)
+
+	// a removed entry can be appended again
+	assert.NoError(t, AppendIDListEntries(db, l.ID, []string{"u1"}))
+	assert.NoError(t, LoadIDListEntryCounts(db, ls))
+	assert.Equal(t, int64(2), ls[0].EntryCount)
+}
+
+func TestIDListCondition(t *testing.T) {
+	lists := map[string]*IDList{"beta_users": {Key: "beta_users", Values: []string{"u1", "42"}}}
+	assert.NoError(t, lists["beta_users"].PrepareEvaluation())
+
+	compile := func(operator string, value string) Condition {
+		cond, err := NewCondition(ConstraintArray{{Property: "user_id", Operator: operator, Value: value}}, nil)
+		assert.NoError(t, err)
+		linkIDLists(cond, lists)
+		return cond
+	}
+
+	t.Run("IN_LIST", func(t *testing.T) {
+		cond := compile(models.ConstraintOperatorINLIST, `"beta_users"`)
+		match, err := cond.Evaluate(map[string]interface{}{"user_id": "u1"})
+		assert.NoError(t, err)
+		assert.True(t, match)
+
+		match, err = cond.Evaluate(map[string]interface{}{"user_id": float64(42)})
+		assert.NoError(t, err)
+		assert.True(t, match)
+
+		match, err = cond.Evaluate(map[string]interface{}{"user_id": "u2"})
+		assert.NoError(t, err)
+		assert.False(t, match)
+	})
+
+	t.Run("NOT_IN_LIST", func(t *testing.T) {
+		cond := compile(models.ConstraintOperatorNOTINLIST, `beta_users`)
+		match, err := cond.Evaluate(map[string]interface{}{"user_id": "u1"})
+		assert.NoError(t, err)
+		assert.False(t, match)
+
+		match, err = cond.Evaluate(map[string]interface{}{"user_id": "u2"})
+		assert.NoError(t, err)
+		assert.True(t, match)
+	})
+
+	t.Run("in constraint groups", func(t *testing.T) {
+		cond, err := NewCondition(nil, ConstraintGroups{
+			{
+				Operator:    models.ConstraintGroupOperatorOR,
+				Constraints: []GroupConstraint{{Property: "user_id", Operator: models.ConstraintOperatorINLIST, Value: `"beta_users"`}},
+			},
+		})
+		assert.NoError(t, err)
+		linkIDLists(cond, lists)
+		match, err := cond.Evaluate(map[string]interface{}{"user_id": "u1"})
+		assert.NoError(t, err)
+		assert.True(t, match)
+	})
+
+	t.Run("missing ID list", func(t *testing.T) {
+		cond := compile(models.ConstraintOperatorNOTINLIST, `"deleted_users"`)
+		_, err := cond.Evaluate(map[string]interface{}{"user_id": "u1"})
+		assert.EqualError(t, err, "id list deleted_users not found")
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		c := Constraint{Property: "user_id", Operator: models.ConstraintOperatorINLIST, Value: `"#beta users"`}
+		assert.Error(t, c.Validate())
+	})
+}
+
+func TestIsIDListReferenced(t *testing.T) {
+	f := GenFixtureFlag()
+	db := PopulateTestDB(f)
+
+	referenced, err := IsIDListReferenced(db, "beta_users")
+	assert.NoError(t, err)
+	assert.False(t, referenced)
+
+	db.Create(&Constraint{SegmentID: f.Segments[0].ID, Property: "user_id", Operator: models.ConstraintOperatorINLIST, Value: `"beta_users"`})
+	referenced, err = IsIDListReferenced(db, "beta_users")
+	assert.NoError(t, err)
+	assert.True(t, referenced)
+
+	db.Create(&Audience{
+		Key: "not_blocked",
+		ConstraintGroups: ConstraintGroups{
+			{
+				Operator: models.ConstraintGroupOperatorAND,
+				Groups: ConstraintGroups{
+					{
+						Operator:    models.ConstraintGroupOperatorAND,
+						Constraints: []GroupConstraint{{Property: "user_id", Operator: models.ConstraintOperatorNOTINLIST, Value: `"blocked_users"`}},
+					},
+				},
+			},
+		},
+	})
+	referenced, err = IsIDListReferenced(db, "blocked_users")
+	assert.NoError(t, err)
+	assert.True(t, referenced)
+}
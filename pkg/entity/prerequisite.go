@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+// Prerequisite requires the entity to get one of the VariantKeys
+// when evaluating the flag of FlagKey
+type Prerequisite struct {
+	FlagKey     string
+	VariantKeys []string
+}
+
+// Validate validates the Prerequisite
+func (p *Prerequisite) Validate() error {
+	if ok, reason := util.IsSafeKey(p.FlagKey); !ok {
+		return fmt.Errorf("invalid prerequisite flagKey. reason: %s", reason)
+	}
+	if len(p.VariantKeys) == 0 {
+		return fmt.Errorf("empty variantKeys of prerequisite flagKey %s", p.FlagKey)
+	}
+	return nil
+}
+
+// Prerequisites is an array of Prerequisite that needs to be all satisfied
+type Prerequisites []Prerequisite
+
+// Scan implements scanner interface
+func (ps *Prerequisites) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	s := cast.ToString(value)
+	if s == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(s), ps); err != nil {
+		return fmt.Errorf("cannot scan %v into Prerequisites type. err: %v", value, err)
+	}
+	return nil
+}
+
+// Value implements valuer interface
+func (ps Prerequisites) Value() (driver.Value, error) {
+	bytes, err := json.Marshal(ps)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// FlagKeys returns the flag keys of the prerequisites
+func (ps Prerequisites) FlagKeys() []string {
+	keys := make([]string, 0, len(ps))
+	for _, p := range ps {
+		keys = append(keys, p.FlagKey)
+	}
+	return keys
+}
+
+// FindFlagsByPrerequisite finds the flags having any segment that requires the flag of the key
+func FindFlagsByPrerequisite(db *gorm.DB, flagKey string) ([]Flag, error) {
+	segments := []Segment{}
+	err := db.
+		Select("id, flag_id, prerequisites").
+		Where("prerequisites LIKE ?", "%"+flagKey+"%").
+		Find(&segments).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	flagIDs := []uint{}
+	for _, s := range segments {
+		for _, k := range s.Prerequisites.FlagKeys() {
+			if k == flagKey {
+				flagIDs = append(flagIDs, s.FlagID)
+				break
+			}
+		}
+	}
+
+	fs := []Flag{}
+	if len(flagIDs) == 0 {
+		return fs, nil
+	}
+	err = db.Where("id IN (?)", flagIDs).Order("id").Find(&fs).Error
+	return fs, err
+}
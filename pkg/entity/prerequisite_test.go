@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrerequisiteValidate(t *testing.T) {
+	t.Run("empty case", func(t *testing.T) {
+		p := Prerequisite{}
+		assert.Error(t, p.Validate())
+	})
+	t.Run("empty variantKeys", func(t *testing.T) {
+		p := Prerequisite{FlagKey: "flag_a"}
+		assert.Error(t, p.Validate())
+	})
+	t.Run("happy code path", func(t *testing.T) {
+		p := Prerequisite{FlagKey: "flag_a", VariantKeys: []string{"on"}}
+		assert.NoError(t, p.Validate())
+	})
+}
+
+func TestPrerequisitesScanValue(t *testing.T) {
+	t.Run("happy code path", func(t *testing.T) {
+		ps := Prerequisites{{FlagKey: "flag_a", VariantKeys: []string{"on"}}}
+		v, err := ps.Value()
+		assert.NoError(t, err)
+
+		scanned := Prerequisites{}
+		assert.NoError(t, scanned.Scan(v))
+		assert.Equal(t, ps, scanned)
+		assert.Equal(t, []string{"flag_a"}, scanned.FlagKeys())
+	})
+
+	t.Run("nil value", func(t *testing.T) {
+		ps := Prerequisites{}
+		assert.NoError(t, ps.Scan(nil))
+		assert.Empty(t, ps)
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		ps := Prerequisites{}
+		assert.Error(t, ps.Scan([]byte(`[`)))
+	})
+}
+
+func TestFindFlagsByPrerequisite(t *testing.T) {
+	f := GenFixtureFlag()
+	db := PopulateTestDB(f)
+
+	fs, err := FindFlagsByPrerequisite(db, f.Key)
+	assert.NoError(t, err)
+	assert.Empty(t, fs)
+
+	for _, key := range []string{"flag_b", "flag_c"} {
+		pf := &Flag{Key: key}
+		db.Create(pf)
+		// flag_c requires flag_key_1000, whose key contains flag_key_100
+		db.Create(&Segment{FlagID: pf.ID, Prerequisites: Prerequisites{
+			{FlagKey: map[string]string{"flag_b": f.Key, "flag_c": "flag_key_1000"}[key], VariantKeys: []string{"control"}},
+		}})
+	}
+
+	fs, err = FindFlagsByPrerequisite(db, f.Key)
+	assert.NoError(t, err)
+	assert.Len(t, fs, 1)
+	assert.Equal(t, "flag_b", fs[0].Key)
+}
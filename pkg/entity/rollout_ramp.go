@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+// RolloutRamp advances the rollout percent of the segment through the steps, one
+// step every StepInterval, which is applied by the scheduler
+type RolloutRamp struct {
+	gorm.Model
+
+	FlagID    uint `gorm:"index:idx_rollout_ramp_flagid"`
+	SegmentID uint `gorm:"index:idx_rollout_ramp_segmentid"`
+
+	Steps        RolloutRampSteps `gorm:"type:text"`
+	StepInterval time.Duration
+
+	// CurrentStep is the number of the steps applied
+	CurrentStep int
+	// NextStepAt is when the next step is due, it's only meaningful when the ramp is active
+	NextStepAt time.Time `gorm:"index:idx_rollout_ramp_status_nextstepat,priority:2"`
+	Status     string    `gorm:"type:varchar(16);index:idx_rollout_ramp_status_nextstepat,priority:1"`
+	// PausedRemaining is the time left until the next step when the ramp is paused
+	PausedRemaining time.Duration
+
+	CreatedBy string
+	Error     string `gorm:"type:text"`
+}
+
+// RolloutRampSteps is an array of the rollout percents of the steps
+type RolloutRampSteps []float64
+
+// Scan implements scanner interface
+func (s *RolloutRampSteps) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	str := cast.ToString(value)
+	if str == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(str), s); err != nil {
+		return fmt.Errorf("cannot scan %v into RolloutRampSteps type. err: %v", value, err)
+	}
+	return nil
+}
+
+// Value implements valuer interface
+func (s RolloutRampSteps) Value() (driver.Value, error) {
+	bytes, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// Validate validates the steps are strictly increasing percents that fit the hash algorithm
+func (s RolloutRampSteps) Validate(hashAlgorithm string) error {
+	if len(s) == 0 {
+		return fmt.Errorf("rollout ramp has no steps")
+	}
+	for i, p := range s {
+		if err := ValidatePercent(hashAlgorithm, p); err != nil {
+			return fmt.Errorf("invalid step %d. reason: %s", i+1, err)
+		}
+		if i > 0 && p <= s[i-1] {
+			return fmt.Errorf("invalid step %d. the rollout percent %v is not greater than the previous step %v", i+1, p, s[i-1])
+		}
+	}
+	return nil
+}
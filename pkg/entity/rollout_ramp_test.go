@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloutRampStepsValidate(t *testing.T) {
+	assert.NoError(t, RolloutRampSteps{1, 5, 25, 50, 100}.Validate(""))
+	assert.NoError(t, RolloutRampSteps{0.5, 1}.Validate(""))
+
+	assert.Error(t, RolloutRampSteps{}.Validate(""))
+	assert.Error(t, RolloutRampSteps{5, 5}.Validate(""))
+	assert.Error(t, RolloutRampSteps{50, 25}.Validate(""))
+	assert.Error(t, RolloutRampSteps{1, 101}.Validate(""))
+	assert.Error(t, RolloutRampSteps{1, 5}.Validate("unknown"))
+}
+
+func TestRolloutRampStepsScanValue(t *testing.T) {
+	s := RolloutRampSteps{1, 5, 100}
+	v, err := s.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "[1,5,100]", v)
+
+	scanned := RolloutRampSteps{}
+	assert.NoError(t, scanned.Scan(v))
+	assert.Equal(t, s, scanned)
+
+	assert.NoError(t, scanned.Scan(nil))
+	assert.Error(t, scanned.Scan("{"))
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+// ScheduledChange is a change of the flag applied by the scheduler at ExecuteAt
+type ScheduledChange struct {
+	gorm.Model
+
+	FlagID    uint      `gorm:"index:idx_scheduled_change_flagid"`
+	ExecuteAt time.Time `gorm:"index:idx_scheduled_change_status_executeat,priority:2"`
+	Status    string    `gorm:"type:varchar(16);index:idx_scheduled_change_status_executeat,priority:1"`
+	Action    string    `gorm:"type:varchar(32)"`
+
+	// SegmentID is the segment of the rollout percent and the distributions changes
+	SegmentID      uint
+	RolloutPercent float64
+	Distributions  ScheduledDistributions `gorm:"type:text"`
+
+	CreatedBy string
+	AppliedAt *time.Time
+	Error     string `gorm:"type:text"`
+}
+
+// ScheduledDistribution is a distribution to apply by the scheduled change
+type ScheduledDistribution struct {
+	VariantID  uint
+	VariantKey string
+	Percent    float64
+}
+
+// ScheduledDistributions is an array of ScheduledDistribution
+type ScheduledDistributions []ScheduledDistribution
+
+// Scan implements scanner interface
+func (ds *ScheduledDistributions) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	s := cast.ToString(value)
+	if s == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(s), ds); err != nil {
+		return fmt.Errorf("cannot scan %v into ScheduledDistributions type. err: %v", value, err)
+	}
+	return nil
+}
+
+// Value implements valuer interface
+func (ds ScheduledDistributions) Value() (driver.Value, error) {
+	bytes, err := json.Marshal(ds)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// ToDistributions maps the scheduled distributions to the distributions of the segment
+func (ds ScheduledDistributions) ToDistributions(segmentID uint) []Distribution {
+	ret := make([]Distribution, len(ds))
+	for i, d := range ds {
+		ret[i] = Distribution{
+			SegmentID:  segmentID,
+			VariantID:  d.VariantID,
+			VariantKey: d.VariantKey,
+			Percent:    d.Percent,
+		}
+	}
+	return ret
+}
@@ -1,7 +1,8 @@
 package entity
 
 import (
-	"github.com/zhouzhuojie/conditions"
+	"math"
+
 	"gorm.io/gorm"
 )
 
@@ -14,9 +15,19 @@ type Segment struct {
 	FlagID         uint   `gorm:"index:idx_segment_flagid"`
 	Description    string `gorm:"type:text"`
 	Rank           uint
-	RolloutPercent uint
+	RolloutPercent float64
+	BucketBy       string        // overrides the flag's BucketBy if not empty
+	Prerequisites  Prerequisites `gorm:"type:text"`
+	Audiences      []Audience    `gorm:"many2many:segments_audiences;"`
 	Constraints    ConstraintArray
-	Distributions  []Distribution
+
+	// ConstraintGroups are joined with AND together with the Constraints
+	ConstraintGroups ConstraintGroups `gorm:"type:text"`
+	Distributions    []Distribution
+
+	// DefaultVariantID is the variant returned when the entity matches the segment but
+	// misses the rollout. There's no default variant if it's 0.
+	DefaultVariantID uint
 
 	// Purely for evaluation
 	SegmentEvaluation SegmentEvaluation `gorm:"-" json:"-"`
@@ -31,6 +42,9 @@ func PreloadConstraintsDistribution(db *gorm.DB) *gorm.DB {
 		}).
 		Preload("Constraints", func(db *gorm.DB) *gorm.DB {
 			return db.Order("created_at")
+		}).
+		Preload("Audiences", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
 		})
 }
 
@@ -41,38 +55,83 @@ func (s *Segment) Preload(db *gorm.DB) error {
 
 // SegmentEvaluation is a struct that holds the necessary info for evaluation
 type SegmentEvaluation struct {
-	ConditionsExpr    conditions.Expr
+	ConditionsExpr    Condition
 	DistributionArray DistributionArray
+	BucketBy          []string
+
+	// Audiences are the compiled audiences referenced by the segment, shared across flags.
+	// An audience missing from the evaluation cache is kept as nil and never matches.
+	Audiences []*Audience
+
+	// UsesNow is whether the constraints of the segment, or of its linked audiences, reference
+	// the built-in now property
+	UsesNow bool
 }
 
 // PrepareEvaluation prepares the segment for evaluation by parsing constraints
-// and denormalize distributions
+// and denormalize distributions with the default hash algorithm
 func (s *Segment) PrepareEvaluation() error {
+	return s.prepareEvaluation(DefaultHashAlgorithm)
+}
+
+func (s *Segment) prepareEvaluation(hashAlgorithmName string) error {
+	h, err := getHashAlgorithm(hashAlgorithmName)
+	if err != nil {
+		return err
+	}
+
 	dLen := len(s.Distributions)
 	se := SegmentEvaluation{
 		DistributionArray: DistributionArray{
 			VariantIDs:          make([]uint, dLen),
 			PercentsAccumulated: make([]int, dLen),
+			HashAlgorithm:       hashAlgorithmName,
 		},
 	}
 
-	if len(s.Constraints) != 0 {
-		expr, err := s.Constraints.ToExpr()
-		if err != nil {
-			return err
-		}
-		se.ConditionsExpr = expr
+	bucketBy, err := ParseBucketBy(s.BucketBy)
+	if err != nil {
+		return err
+	}
+	se.BucketBy = bucketBy
+	se.Audiences = make([]*Audience, len(s.Audiences))
+
+	expr, err := NewCondition(s.Constraints, s.ConstraintGroups)
+	if err != nil {
+		return err
 	}
+	se.ConditionsExpr = expr
+	se.UsesNow = usesNow(s.Constraints, s.ConstraintGroups)
 
 	for i, d := range s.Distributions {
+		buckets := int(math.Round(d.Percent * float64(h.totalBucketNum) / 100))
 		se.DistributionArray.VariantIDs[i] = d.VariantID
 		if i == 0 {
-			se.DistributionArray.PercentsAccumulated[i] = int(d.Percent * PercentMultiplier)
+			se.DistributionArray.PercentsAccumulated[i] = buckets
 		} else {
-			se.DistributionArray.PercentsAccumulated[i] = se.DistributionArray.PercentsAccumulated[i-1] + int(d.Percent*PercentMultiplier)
+			se.DistributionArray.PercentsAccumulated[i] = se.DistributionArray.PercentsAccumulated[i-1] + buckets
 		}
 	}
 
 	s.SegmentEvaluation = se
 	return nil
 }
+
+// LinkAudiences links the compiled audiences referenced by the segment for evaluation
+func (s *Segment) LinkAudiences(audiences map[uint]*Audience) {
+	s.SegmentEvaluation.Audiences = make([]*Audience, len(s.Audiences))
+	s.SegmentEvaluation.UsesNow = usesNow(s.Constraints, s.ConstraintGroups)
+	for i, a := range s.Audiences {
+		s.SegmentEvaluation.Audiences[i] = audiences[a.ID]
+		if audiences[a.ID] != nil && audiences[a.ID].AudienceEvaluation.UsesNow {
+			s.SegmentEvaluation.UsesNow = true
+		}
+	}
+}
+
+// LinkIDLists links the ID lists referenced by the constraints of the segment for evaluation
+func (s *Segment) LinkIDLists(lists map[string]*IDList) {
+	if s.SegmentEvaluation.ConditionsExpr != nil {
+		linkIDLists(s.SegmentEvaluation.ConditionsExpr, lists)
+	}
+}
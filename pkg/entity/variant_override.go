@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// VariantOverride pins the entity of the flag to the variant, regardless of the segments
+// and the rollout. It's checked before the segments in the evaluation.
+type VariantOverride struct {
+	gorm.Model
+
+	FlagID   uint   `gorm:"index:idx_variant_override_flagid"`
+	EntityID string `gorm:"type:varchar(255)"`
+	// EntityType limits the override to the entityType of the eval context, it matches
+	// any entityType if empty
+	EntityType string `gorm:"type:varchar(64)"`
+	VariantID  uint
+	ExpiresAt  *time.Time
+	CreatedBy  string
+}
+
+type variantOverrideKey struct {
+	entityType string
+	entityID   string
+}
+
+// Validate validates the VariantOverride
+func (o *VariantOverride) Validate() error {
+	if o.EntityID == "" {
+		return fmt.Errorf("empty entityID")
+	}
+	if len(o.EntityID) > 255 {
+		return fmt.Errorf("entityID %q is longer than 255 characters", o.EntityID)
+	}
+	return nil
+}
+
+// IsExpired returns whether the override has expired at the time
+func (o *VariantOverride) IsExpired(t time.Time) bool {
+	return o.ExpiresAt != nil && !t.Before(*o.ExpiresAt)
+}
+
+// LookupOverride returns the unexpired override of the entity, the one of the entityType
+// takes precedence over the one matching any entityType
+func (f *Flag) LookupOverride(entityType string, entityID string) *VariantOverride {
+	return f.LookupOverrideAt(entityType, entityID, Now())
+}
+
+// LookupOverrideAt returns the override of the entity unexpired at the time, see LookupOverride
+func (f *Flag) LookupOverrideAt(entityType string, entityID string, t time.Time) *VariantOverride {
+	for _, k := range []variantOverrideKey{{entityType, entityID}, {"", entityID}} {
+		if o, ok := f.FlagEvaluation.OverridesMap[k]; ok && !o.IsExpired(t) {
+			return o
+		}
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariantOverrideValidate(t *testing.T) {
+	assert.NoError(t, (&VariantOverride{EntityID: "qa_user"}).Validate())
+	assert.Error(t, (&VariantOverride{}).Validate())
+}
+
+func TestFlagLookupOverride(t *testing.T) {
+	now := time.Date(2025, 11, 28, 0, 0, 0, 0, time.UTC)
+	defer gostub.StubFunc(&Now, now).Reset()
+	expired := now.Add(-time.Second)
+	valid := now.Add(time.Second)
+
+	f := GenFixtureFlag()
+	f.Overrides = []VariantOverride{
+		{EntityID: "a", VariantID: 300},
+		{EntityID: "a", EntityType: "tester", VariantID: 301},
+		{EntityID: "b", VariantID: 300, ExpiresAt: &expired},
+		{EntityID: "c", VariantID: 301, ExpiresAt: &valid},
+	}
+	assert.NoError(t, f.PrepareEvaluation())
+
+	assert.Equal(t, uint(300), f.LookupOverride("", "a").VariantID)
+	assert.Equal(t, uint(300), f.LookupOverride("user", "a").VariantID)
+	assert.Equal(t, uint(301), f.LookupOverride("tester", "a").VariantID)
+	assert.Nil(t, f.LookupOverride("", "b"))
+	assert.Equal(t, uint(301), f.LookupOverride("", "c").VariantID)
+	assert.Nil(t, f.LookupOverride("", "d"))
+
+	assert.Equal(t, uint(300), f.LookupOverrideAt("", "b", now.Add(-2*time.Second)).VariantID)
+	assert.Nil(t, f.LookupOverrideAt("", "c", now.Add(2*time.Second)))
+}
@@ -0,0 +1,45 @@
+package evaluator
+
+import (
+	"sync"
+)
+
+// AssignmentStore keeps the sticky assignments of the flags with StickyAssignments, i.e. the
+// variants of the entities keyed by the flag, its AssignmentSalt and the entityID
+type AssignmentStore interface {
+	GetAssignment(flagID uint, salt string, entityID string) (variantID uint, ok bool)
+	SetAssignment(flagID uint, salt string, entityID string, variantID uint)
+}
+
+type assignmentKey struct {
+	flagID   uint
+	salt     string
+	entityID string
+}
+
+// MemoryAssignmentStore is the AssignmentStore in memory, e.g. for tests and the Client of a
+// single process
+type MemoryAssignmentStore struct {
+	assignments map[assignmentKey]uint
+	mutex       sync.RWMutex
+}
+
+// NewMemoryAssignmentStore creates a MemoryAssignmentStore
+func NewMemoryAssignmentStore() *MemoryAssignmentStore {
+	return &MemoryAssignmentStore{assignments: make(map[assignmentKey]uint)}
+}
+
+// GetAssignment gets the variant assigned to the entity
+func (s *MemoryAssignmentStore) GetAssignment(flagID uint, salt string, entityID string) (uint, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	variantID, ok := s.assignments[assignmentKey{flagID, salt, entityID}]
+	return variantID, ok
+}
+
+// SetAssignment assigns the variant to the entity
+func (s *MemoryAssignmentStore) SetAssignment(flagID uint, salt string, entityID string, variantID uint) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.assignments[assignmentKey{flagID, salt, entityID}] = variantID
+}
@@ -0,0 +1,128 @@
+package evaluator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// Options are the options of the Client
+type Options struct {
+	// RefreshInterval is the interval of reloading the flags from the source in the background,
+	// the flags are only loaded once if it's zero
+	RefreshInterval time.Duration
+	// OnError is called with the errors of the background refreshes, the Client keeps
+	// evaluating the flags loaded last time
+	OnError func(error)
+	// Recorder is called with the results the flagr server would record as data records,
+	// i.e. the results of the enabled flags with segments and data records enabled
+	Recorder func(*models.EvalResult)
+	// DebugEnabled returns the segment debug logs for the evaluation contexts with enableDebug
+	DebugEnabled bool
+	// Assignments keeps the sticky assignments of the flags with StickyAssignments, e.g. a
+	// MemoryAssignmentStore. The flags are not sticky if it's nil.
+	Assignments AssignmentStore
+}
+
+// Client evaluates the flags of an EvalCacheJSON document locally, the same way as the
+// flagr server, and keeps the flags up to date by reloading them from the source
+type Client struct {
+	source  Source
+	options Options
+
+	flags      *Flags
+	flagsMutex sync.RWMutex
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewClient creates a Client and loads the flags from the source. It starts refreshing the
+// flags in the background if the RefreshInterval is set, and Close stops it.
+func NewClient(source Source, options Options) (*Client, error) {
+	c := &Client{
+		source:  source,
+		options: options,
+		done:    make(chan struct{}),
+	}
+	if err := c.Refresh(); err != nil {
+		return nil, err
+	}
+	if options.RefreshInterval > 0 {
+		go c.refreshLoop()
+	}
+	return c, nil
+}
+
+func (c *Client) refreshLoop() {
+	ticker := time.NewTicker(c.options.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			if err := c.Refresh(); err != nil && c.options.OnError != nil {
+				c.options.OnError(err)
+			}
+		}
+	}
+}
+
+// Refresh reloads the flags from the source, the flags loaded last time are kept if it fails
+func (c *Client) Refresh() error {
+	ecj, err := c.source()
+	if err != nil {
+		return err
+	}
+	fs, err := PrepareFlags(ecj)
+	if err != nil {
+		return err
+	}
+
+	c.flagsMutex.Lock()
+	c.flags = fs
+	c.flagsMutex.Unlock()
+	return nil
+}
+
+// Close stops the background refreshes
+func (c *Client) Close() {
+	c.closeOnce.Do(func() { close(c.done) })
+}
+
+// Flags gets the flags loaded last time
+func (c *Client) Flags() *Flags {
+	c.flagsMutex.RLock()
+	defer c.flagsMutex.RUnlock()
+	return c.flags
+}
+
+// Evaluate evaluates the flag of the evaluation context selected by the flagID or the flagKey
+func (c *Client) Evaluate(evalContext models.EvalContext) *models.EvalResult {
+	fs := c.Flags()
+	return c.evaluate(fs, LookupFlag(fs, evalContext), evalContext)
+}
+
+// EvaluateByTags evaluates the flags selected by the flagTags and the flagTagsOperator of the
+// evaluation context, in the order of the flag IDs
+func (c *Client) EvaluateByTags(evalContext models.EvalContext) []*models.EvalResult {
+	fs := c.Flags()
+	results := []*models.EvalResult{}
+	for _, f := range fs.GetByTags(evalContext.FlagTags, evalContext.FlagTagsOperator) {
+		results = append(results, c.evaluate(fs, f, evalContext))
+	}
+	return results
+}
+
+func (c *Client) evaluate(fs *Flags, f *entity.Flag, evalContext models.EvalContext) *models.EvalResult {
+	e := &Evaluator{Flags: fs, DebugEnabled: c.options.DebugEnabled, Assignments: c.options.Assignments}
+	r := e.Evaluate(f, evalContext)
+	if c.options.Recorder != nil && f != nil && f.Enabled && f.DataRecordsEnabled &&
+		r.Reason != models.EvalResultReasonNOSEGMENTS {
+		c.options.Recorder(r)
+	}
+	return r
+}
@@ -0,0 +1,496 @@
+package evaluator
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"gorm.io/gorm"
+
+	"github.com/davecgh/go-spew/spew"
+)
+
+// FlagGetter gets a flag by its key or ID, e.g. the prerequisite flags of a segment
+type FlagGetter interface {
+	GetByFlagKeyOrID(keyOrID interface{}) *entity.Flag
+}
+
+// Evaluator evaluates the flags prepared for evaluation, the same way as the flagr server
+type Evaluator struct {
+	// Flags looks up the prerequisite flags
+	Flags FlagGetter
+	// DebugEnabled returns the segment debug logs for the evaluation contexts with enableDebug
+	DebugEnabled bool
+	// Assignments keeps the sticky assignments of the flags with StickyAssignments, the flags
+	// are not sticky if it's nil
+	Assignments AssignmentStore
+	// Time is the time of the evaluation, i.e. the built-in now property of the constraints and
+	// the expiry of the overrides. It's the current time if it's zero.
+	Time time.Time
+}
+
+// Evaluate evaluates the flag with the evaluation context, the result has the default variant
+// of the flag if it's disabled, has no segments, or no segment matches. The flag is nil if it's
+// not found.
+func (e *Evaluator) Evaluate(flag *entity.Flag, evalContext models.EvalContext) *models.EvalResult {
+	return withDefaultVariant(flag, e.evalFlagWithContext(flag, evalContext, 0, false))
+}
+
+func (e *Evaluator) now() time.Time {
+	if e.Time.IsZero() {
+		return entity.Now()
+	}
+	return e.Time
+}
+
+// LookupFlag looks up the flag of the evaluation context by the flagID, or the flagKey if
+// the flagID is not found
+func LookupFlag(flags FlagGetter, evalContext models.EvalContext) *entity.Flag {
+	f := flags.GetByFlagKeyOrID(util.SafeUint(evalContext.FlagID))
+	if f == nil {
+		f = flags.GetByFlagKeyOrID(util.SafeString(evalContext.FlagKey))
+	}
+	return f
+}
+
+// BlankResult creates a blank result with the reason
+func BlankResult(f *entity.Flag, evalContext models.EvalContext, reason string, msg string) *models.EvalResult {
+	flagID := uint(0)
+	flagKey := ""
+	flagSnapshotID := uint(0)
+	flagTags := []string{}
+	valueType := ""
+	if f != nil {
+		flagID = f.ID
+		valueType = f.ValueType
+		flagSnapshotID = f.SnapshotID
+		flagKey = f.Key
+		if len(f.Tags) > 0 {
+			for _, tag := range f.Tags {
+				flagTags = append(flagTags, tag.Value)
+			}
+		}
+	}
+	return &models.EvalResult{
+		EvalContext: &evalContext,
+		EvalDebugLog: &models.EvalDebugLog{
+			Msg:              msg,
+			SegmentDebugLogs: nil,
+		},
+		FlagID:         int64(flagID),
+		FlagKey:        flagKey,
+		FlagSnapshotID: int64(flagSnapshotID),
+		FlagTags:       flagTags,
+		Reason:         reason,
+		Timestamp:      util.TimeNow(),
+		ValueType:      valueType,
+	}
+}
+
+// maxPrerequisiteDepth guards the evaluation against prerequisite cycles that
+// were not caught at save time, e.g. flags loaded from json_file or json_http
+const maxPrerequisiteDepth = 8
+
+// evalFlagWithContext evaluates the flag, generatedEntityID is whether the entityID of the
+// evaluation context was generated by the evaluator rather than given by the caller, e.g. for
+// the prerequisites of a flag evaluated without an entityID
+func (e *Evaluator) evalFlagWithContext(
+	flag *entity.Flag,
+	evalContext models.EvalContext,
+	depth int,
+	generatedEntityID bool,
+) *models.EvalResult {
+	flagID := util.SafeUint(evalContext.FlagID)
+	flagKey := util.SafeString(evalContext.FlagKey)
+
+	if flag == nil {
+		emptyFlag := &entity.Flag{Model: gorm.Model{ID: flagID}, Key: flagKey}
+		return BlankResult(emptyFlag, evalContext, models.EvalResultReasonFLAGNOTFOUND,
+			fmt.Sprintf("flagID %v not found or deleted", flagID))
+	}
+
+	if !flag.Enabled {
+		return BlankResult(flag, evalContext, models.EvalResultReasonFLAGDISABLED,
+			fmt.Sprintf("flagID %v is not enabled", flag.ID))
+	}
+
+	// the overrides apply regardless of the segments, even if there's none
+	if r := e.evalOverride(flag, evalContext); r != nil {
+		return r
+	}
+
+	if len(flag.Segments) == 0 {
+		return BlankResult(flag, evalContext, models.EvalResultReasonNOSEGMENTS,
+			fmt.Sprintf("flagID %v has no segments", flag.ID))
+	}
+
+	if evalContext.EntityID == "" {
+		evalContext.EntityID = fmt.Sprintf("randomly_generated_%d", rand.Int31())
+		generatedEntityID = true
+	}
+	// the randomly generated entityIDs are not sticky
+	sticky := e.Assignments != nil && flag.StickyAssignments && !generatedEntityID
+
+	if flag.EntityType != "" {
+		evalContext.EntityType = flag.EntityType
+	}
+
+	if sticky {
+		if r := e.evalStickyAssignment(flag, evalContext); r != nil {
+			return r
+		}
+	}
+
+	segmentContext := e.withNow(flag, evalContext)
+	// the debug messages and the constraint traces of the segments are only built if they're returned
+	segmentContext.EnableDebug = e.DebugEnabled && evalContext.EnableDebug
+	logs := []*models.SegmentDebugLog{}
+	var vID int64
+	var sID int64
+	var segmentRank *int64
+	reason := models.EvalResultReasonNOMATCH
+
+	for _, segment := range flag.Segments {
+		sID = int64(segment.ID)
+		met, prerequisiteMsg := e.evalPrerequisites(evalContext, segment, depth, generatedEntityID)
+		if !met {
+			if e.DebugEnabled && evalContext.EnableDebug {
+				logs = append(logs, &models.SegmentDebugLog{
+					Msg:       prerequisiteMsg,
+					SegmentID: int64(segment.ID),
+				})
+			}
+			continue
+		}
+		variantID, log, evalNextSegment, segmentReason := evalSegment(flag.ID, segmentContext, segment)
+		if e.DebugEnabled && evalContext.EnableDebug {
+			log.Msg = prerequisiteMsg + log.Msg
+			logs = append(logs, log)
+		}
+		if variantID != nil {
+			vID = int64(*variantID)
+		}
+		if segmentReason == models.EvalResultReasonERROR {
+			// it's an ERROR if none of the following segments matches
+			reason = segmentReason
+		}
+		if !evalNextSegment {
+			reason = segmentReason
+			segmentRank = util.Int64Ptr(int64(segment.Rank))
+			break
+		}
+	}
+	evalResult := BlankResult(flag, evalContext, reason, "")
+	evalResult.EvalDebugLog.SegmentDebugLogs = logs
+	evalResult.SegmentID = sID
+	evalResult.SegmentRank = segmentRank
+	evalResult.VariantID = vID
+	v := flag.FlagEvaluation.VariantsMap[util.SafeUint(vID)]
+	if v != nil {
+		evalResult.VariantAttachment = v.Attachment
+		evalResult.VariantKey = v.Key
+	}
+	if sticky && reason == models.EvalResultReasonMATCHED && v != nil {
+		e.Assignments.SetAssignment(flag.ID, flag.AssignmentSalt, evalContext.EntityID, v.ID)
+	}
+	return evalResult
+}
+
+// evalOverride returns the result of the override of the entity, or nil if there's none
+func (e *Evaluator) evalOverride(flag *entity.Flag, evalContext models.EvalContext) *models.EvalResult {
+	if evalContext.EntityID == "" {
+		return nil
+	}
+	if flag.EntityType != "" {
+		evalContext.EntityType = flag.EntityType
+	}
+	o := flag.LookupOverrideAt(evalContext.EntityType, evalContext.EntityID, e.now())
+	if o == nil {
+		return nil
+	}
+	evalResult := BlankResult(flag, evalContext, models.EvalResultReasonOVERRIDE, fmt.Sprintf(
+		"variantID %v is from the override %v of entityID %s, segments are not evaluated", o.VariantID, o.ID, o.EntityID))
+	evalResult.VariantID = int64(o.VariantID)
+	if v := flag.FlagEvaluation.VariantsMap[o.VariantID]; v != nil {
+		evalResult.VariantAttachment = v.Attachment
+		evalResult.VariantKey = v.Key
+	}
+	return evalResult
+}
+
+// withNow sets the built-in now property in the entity context of the segments, if any of
+// them references it, see SegmentEvaluation.UsesNow
+func (e *Evaluator) withNow(flag *entity.Flag, evalContext models.EvalContext) models.EvalContext {
+	m, ok := evalContext.EntityContext.(map[string]interface{})
+	if !ok {
+		return evalContext
+	}
+	for _, s := range flag.Segments {
+		if s.SegmentEvaluation.UsesNow {
+			evalContext.EntityContext = entity.WithNow(m, e.now())
+			return evalContext
+		}
+	}
+	return evalContext
+}
+
+// evalStickyAssignment returns the result of the variant assigned to the entity, or nil if the
+// entity has no sticky assignment, or the variant is deleted
+func (e *Evaluator) evalStickyAssignment(flag *entity.Flag, evalContext models.EvalContext) *models.EvalResult {
+	variantID, ok := e.Assignments.GetAssignment(flag.ID, flag.AssignmentSalt, evalContext.EntityID)
+	if !ok {
+		return nil
+	}
+	v := flag.FlagEvaluation.VariantsMap[variantID]
+	if v == nil {
+		return nil
+	}
+	evalResult := BlankResult(flag, evalContext, models.EvalResultReasonSTICKY, fmt.Sprintf(
+		"variantID %v is the sticky assignment of entityID %s, segments are not evaluated", v.ID, evalContext.EntityID))
+	evalResult.VariantID = int64(v.ID)
+	evalResult.VariantAttachment = v.Attachment
+	evalResult.VariantKey = v.Key
+	return evalResult
+}
+
+// evalPrerequisites evaluates the prerequisite flags of the segment looked up from the Flags,
+// and returns whether all of them resolve to one of the required variants. The prerequisites
+// get the same entityID as the flag, and are not sticky if it's generated.
+func (e *Evaluator) evalPrerequisites(
+	evalContext models.EvalContext,
+	segment entity.Segment,
+	depth int,
+	generatedEntityID bool,
+) (bool, string) {
+	if len(segment.Prerequisites) == 0 {
+		return true, ""
+	}
+	if depth >= maxPrerequisiteDepth {
+		return false, fmt.Sprintf("prerequisites not met. exceeded max prerequisite depth %d.", maxPrerequisiteDepth)
+	}
+
+	msg := "prerequisites met:"
+	for _, p := range segment.Prerequisites {
+		f := e.Flags.GetByFlagKeyOrID(p.FlagKey)
+		if f == nil {
+			return false, fmt.Sprintf("prerequisites not met. flagKey %s not found or deleted.", p.FlagKey)
+		}
+
+		ctx := evalContext
+		ctx.EnableDebug = false
+		ctx.FlagID = int64(f.ID)
+		ctx.FlagKey = f.Key
+		r := withDefaultVariant(f, e.evalFlagWithContext(f, ctx, depth+1, generatedEntityID))
+		if !slices.Contains(p.VariantKeys, r.VariantKey) {
+			return false, fmt.Sprintf(
+				"prerequisites not met. flagKey %s got variantKey %q, expected one of %v.",
+				p.FlagKey, r.VariantKey, p.VariantKeys,
+			)
+		}
+		msg += fmt.Sprintf(" %s=%s", p.FlagKey, r.VariantKey)
+	}
+	return true, msg + ". "
+}
+
+// withDefaultVariant returns the default variant of the flag if the result has no variant,
+// i.e. the flag is disabled, has no segments, or no segment matches
+func withDefaultVariant(flag *entity.Flag, r *models.EvalResult) *models.EvalResult {
+	if flag == nil || flag.DefaultVariantID == 0 || r.VariantID != 0 {
+		return r
+	}
+	v := flag.FlagEvaluation.VariantsMap[flag.DefaultVariantID]
+	if v == nil {
+		return r
+	}
+	r.VariantID = int64(v.ID)
+	r.VariantKey = v.Key
+	r.VariantAttachment = v.Attachment
+	msg := fmt.Sprintf("default variantID %v of the flag", v.ID)
+	if r.EvalDebugLog.Msg != "" {
+		msg = r.EvalDebugLog.Msg + ", " + msg
+	}
+	r.EvalDebugLog.Msg = msg
+	return r
+}
+
+var evalSegment = func(
+	flagID uint,
+	evalContext models.EvalContext,
+	segment entity.Segment,
+) (
+	vID *uint, // returns VariantID
+	log *models.SegmentDebugLog,
+	evalNextSegment bool,
+	reason string, // one of MATCHED, ROLLOUT_EXCLUDED, NO_MATCH and ERROR
+) {
+	defer func() {
+		if evalContext.EnableDebug && log != nil {
+			log.ConstraintTraces = traceConstraints(segment, evalContext.EntityContext)
+		}
+	}()
+
+	hasConstraints := len(segment.Constraints) != 0 || len(segment.ConstraintGroups) != 0
+	if hasConstraints || len(segment.SegmentEvaluation.Audiences) != 0 {
+		m, ok := evalContext.EntityContext.(map[string]interface{})
+		if !ok {
+			log = &models.SegmentDebugLog{
+				Msg:       fmt.Sprintf("constraints are present in the segment_id %v, but got invalid entity_context: %s.", segment.ID, spew.Sdump(evalContext.EntityContext)),
+				SegmentID: int64(segment.ID),
+			}
+			return nil, log, true, models.EvalResultReasonERROR
+		}
+
+		for i, a := range segment.SegmentEvaluation.Audiences {
+			if a == nil {
+				log = &models.SegmentDebugLog{
+					Msg:       fmt.Sprintf("audience_id %v not found or deleted.", segment.Audiences[i].ID),
+					SegmentID: int64(segment.ID),
+				}
+				return nil, log, true, models.EvalResultReasonERROR
+			}
+			expr := a.AudienceEvaluation.ConditionsExpr
+			if expr == nil {
+				continue
+			}
+			match, err := expr.Evaluate(m)
+			if err != nil {
+				log = &models.SegmentDebugLog{
+					Msg:       err.Error(),
+					SegmentID: int64(segment.ID),
+				}
+				return nil, log, true, models.EvalResultReasonNOMATCH
+			}
+			if !match {
+				log = &models.SegmentDebugLog{
+					Msg:       debugAudienceMsg(evalContext.EnableDebug, a.Key, expr, m),
+					SegmentID: int64(segment.ID),
+				}
+				return nil, log, true, models.EvalResultReasonNOMATCH
+			}
+		}
+
+		if hasConstraints {
+			expr := segment.SegmentEvaluation.ConditionsExpr
+			if expr == nil {
+				log = &models.SegmentDebugLog{
+					Msg:       fmt.Sprintf("constraints of the segment_id %v are not prepared for evaluation.", segment.ID),
+					SegmentID: int64(segment.ID),
+				}
+				return nil, log, true, models.EvalResultReasonERROR
+			}
+			match, err := expr.Evaluate(m)
+			if err != nil {
+				log = &models.SegmentDebugLog{
+					Msg:       err.Error(),
+					SegmentID: int64(segment.ID),
+				}
+				return nil, log, true, models.EvalResultReasonNOMATCH
+			}
+			if !match {
+				log = &models.SegmentDebugLog{
+					Msg:       debugConstraintMsg(evalContext.EnableDebug, expr, m),
+					SegmentID: int64(segment.ID),
+				}
+				return nil, log, true, models.EvalResultReasonNOMATCH
+			}
+		}
+	}
+
+	bucketKey, err := entity.BucketKey(
+		evalContext.EntityID,
+		evalContext.EntityContext,
+		segment.SegmentEvaluation.BucketBy,
+	)
+	if err != nil {
+		vID, debugMsg := rolloutMiss(segment, "rollout no. "+err.Error())
+		log = &models.SegmentDebugLog{
+			Msg:       "matched all constraints. " + debugMsg,
+			SegmentID: int64(segment.ID),
+		}
+		return vID, log, false, models.EvalResultReasonROLLOUTEXCLUDED
+	}
+
+	vID, debugMsg := segment.SegmentEvaluation.DistributionArray.Rollout(
+		bucketKey,
+		fmt.Sprint(flagID), // default use the flagID as salt
+		segment.RolloutPercent,
+	)
+	reason = models.EvalResultReasonMATCHED
+	if vID == nil {
+		vID, debugMsg = rolloutMiss(segment, debugMsg)
+		reason = models.EvalResultReasonROLLOUTEXCLUDED
+	}
+
+	log = &models.SegmentDebugLog{
+		Msg:       "matched all constraints. " + debugMsg,
+		SegmentID: int64(segment.ID),
+	}
+
+	// at this point, all constraints are matched, so we shouldn't go to next segment
+	// thus setting evalNextSegment = false
+	return vID, log, false, reason
+}
+
+// rolloutMiss returns the default variant of the segment for the entity matching the
+// segment but missing the rollout
+func rolloutMiss(segment entity.Segment, debugMsg string) (*uint, string) {
+	if segment.DefaultVariantID == 0 {
+		return nil, debugMsg
+	}
+	vID := segment.DefaultVariantID
+	return &vID, fmt.Sprintf("%s. default variantID %v of the segment", debugMsg, vID)
+}
+
+// traceConstraints evaluates every constraint of the audiences and the segment for the debug log
+func traceConstraints(segment entity.Segment, entityContext interface{}) []*models.ConstraintTrace {
+	m, ok := entityContext.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	traces := []*models.ConstraintTrace{}
+	for _, a := range segment.SegmentEvaluation.Audiences {
+		if a == nil || a.AudienceEvaluation.ConditionsExpr == nil {
+			continue
+		}
+		for _, t := range entity.TraceCondition(a.AudienceEvaluation.ConditionsExpr, m) {
+			traces = append(traces, mapConstraintTrace(a.Key, t))
+		}
+	}
+	if segment.SegmentEvaluation.ConditionsExpr != nil {
+		for _, t := range entity.TraceCondition(segment.SegmentEvaluation.ConditionsExpr, m) {
+			traces = append(traces, mapConstraintTrace("", t))
+		}
+	}
+	return traces
+}
+
+func mapConstraintTrace(audienceKey string, t entity.ConstraintTrace) *models.ConstraintTrace {
+	return &models.ConstraintTrace{
+		AudienceKey: audienceKey,
+		Group:       t.Group,
+		Property:    t.Property,
+		Operator:    t.Operator,
+		Value:       t.Value,
+		Actual:      t.Actual,
+		Result:      t.Result,
+		Msg:         t.Msg,
+	}
+}
+
+func debugConstraintMsg(enableDebug bool, expr entity.Condition, m map[string]interface{}) string {
+	if !enableDebug {
+		return ""
+	}
+	return fmt.Sprintf("constraint not match. constraint: %s, entity_context: %+v.", expr, m)
+}
+
+func debugAudienceMsg(enableDebug bool, audienceKey string, expr entity.Condition, m map[string]interface{}) string {
+	if !enableDebug {
+		return ""
+	}
+	return fmt.Sprintf("audience not match. audience: %s, constraint: %s, entity_context: %+v.", audienceKey, expr, m)
+}
@@ -0,0 +1,601 @@
+package evaluator
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/swagger_gen/models"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestEvalSegment(t *testing.T) {
+	t.Run("test empty evalContext", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		vID, log, evalNextSegment, _ := evalSegment(100, models.EvalContext{}, s)
+
+		assert.Nil(t, vID)
+		assert.NotEmpty(t, log)
+		assert.True(t, evalNextSegment)
+	})
+
+	t.Run("test happy code path", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		s.RolloutPercent = float64(100)
+		vID, log, evalNextSegment, _ := evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"dl_state": "CA"},
+			EntityID:      "entityID1",
+			EntityType:    "entityType1",
+			FlagID:        int64(100),
+		}, s)
+
+		assert.NotNil(t, vID)
+		assert.NotEmpty(t, log)
+		assert.False(t, evalNextSegment)
+	})
+
+	t.Run("test constraint evaluation error", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		s.RolloutPercent = float64(100)
+		vID, log, evalNextSegment, _ := evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{},
+			EntityID:      "entityID1",
+			EntityType:    "entityType1",
+			FlagID:        int64(100),
+		}, s)
+
+		assert.Nil(t, vID)
+		assert.NotEmpty(t, log)
+		assert.True(t, evalNextSegment)
+	})
+
+	t.Run("test constraint not match", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		s.RolloutPercent = float64(100)
+		vID, log, evalNextSegment, _ := evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"dl_state": "NY"},
+			EntityID:      "entityID1",
+			EntityType:    "entityType1",
+			FlagID:        int64(100),
+		}, s)
+
+		assert.Nil(t, vID)
+		assert.NotEmpty(t, log)
+		assert.True(t, evalNextSegment)
+	})
+
+	t.Run("test constraint traces in debug mode", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		s.RolloutPercent = float64(100)
+		_, log, _, _ := evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"dl_state": "NY"},
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}, s)
+		assert.Equal(t, []*models.ConstraintTrace{
+			{
+				Property: "dl_state",
+				Operator: models.ConstraintOperatorEQ,
+				Value:    `"CA"`,
+				Actual:   "NY",
+				Result:   models.ConstraintTraceResultNOTMATCH,
+			},
+		}, log.ConstraintTraces)
+
+		_, log, _, _ = evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{},
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}, s)
+		assert.Len(t, log.ConstraintTraces, 1)
+		assert.Equal(t, models.ConstraintTraceResultMISSINGPROPERTY, log.ConstraintTraces[0].Result)
+
+		_, log, _, _ = evalSegment(100, models.EvalContext{
+			EntityContext: map[string]interface{}{"dl_state": "NY"},
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}, s)
+		assert.Nil(t, log.ConstraintTraces)
+	})
+
+	t.Run("test evalContext wrong format", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		s.RolloutPercent = float64(100)
+		vID, log, evalNextSegment, _ := evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: nil,
+			EntityID:      "entityID1",
+			EntityType:    "entityType1",
+			FlagID:        int64(100),
+		}, s)
+
+		assert.Nil(t, vID)
+		assert.NotEmpty(t, log)
+		assert.True(t, evalNextSegment)
+	})
+
+	t.Run("test float comparison - 9990403>=9990404 evals to be false", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		s.RolloutPercent = float64(100)
+		s.Constraints = []entity.Constraint{
+			{
+				Model:     gorm.Model{ID: 500},
+				SegmentID: 200,
+				Property:  "foo",
+				Operator:  models.ConstraintOperatorGTE,
+				Value:     `9990404`,
+			},
+		}
+		s.PrepareEvaluation()
+
+		vID, log, evalNextSegment, _ := evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"foo": float64(9990403)},
+			EntityID:      "entityID1",
+			EntityType:    "entityType1",
+			FlagID:        int64(100),
+		}, s)
+
+		assert.Nil(t, vID)
+		assert.NotZero(t, log)
+		assert.True(t, evalNextSegment)
+	})
+
+	t.Run("test float comparison - 9990404>=9990403 evals to be true", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		s.RolloutPercent = float64(100)
+		s.Constraints = []entity.Constraint{
+			{
+				Model:     gorm.Model{ID: 500},
+				SegmentID: 200,
+				Property:  "foo",
+				Operator:  models.ConstraintOperatorGTE,
+				Value:     `9990403`,
+			},
+		}
+		s.PrepareEvaluation()
+
+		vID, log, evalNextSegment, _ := evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"foo": float64(9990404)},
+			EntityID:      "entityID1",
+			EntityType:    "entityType1",
+			FlagID:        int64(100),
+		}, s)
+
+		assert.NotZero(t, vID)
+		assert.NotZero(t, log)
+		assert.False(t, evalNextSegment)
+	})
+	t.Run("test bucketBy entityContext property", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		s.BucketBy = "org_id"
+		s.PrepareEvaluation()
+
+		vID1, log, evalNextSegment, _ := evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"dl_state": "CA", "org_id": "org1"},
+			EntityID:      "entityID1",
+		}, s)
+		assert.NotNil(t, vID1)
+		assert.Contains(t, log.Msg, "BucketKey:org1")
+		assert.False(t, evalNextSegment)
+
+		for i := 0; i < 100; i++ {
+			vID2, _, _, _ := evalSegment(100, models.EvalContext{
+				EntityContext: map[string]interface{}{"dl_state": "CA", "org_id": "org1"},
+				EntityID:      fmt.Sprintf("entityID%d", i),
+			}, s)
+			assert.Equal(t, *vID1, *vID2)
+		}
+	})
+
+	t.Run("test bucketBy property missing", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		s.BucketBy = "org_id"
+		s.PrepareEvaluation()
+
+		vID, log, evalNextSegment, _ := evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"dl_state": "CA"},
+			EntityID:      "entityID1",
+		}, s)
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, "org_id")
+		assert.False(t, evalNextSegment)
+	})
+}
+
+func TestEvalSegmentWithConstraintGroups(t *testing.T) {
+	s := entity.GenFixtureSegment()
+	s.Constraints = nil
+	s.ConstraintGroups = entity.ConstraintGroups{
+		{
+			Operator: models.ConstraintGroupOperatorOR,
+			Constraints: []entity.GroupConstraint{
+				{Property: "country", Operator: models.ConstraintOperatorEQ, Value: `"US"`},
+				{Property: "plan", Operator: models.ConstraintOperatorEQ, Value: `"enterprise"`},
+			},
+		},
+	}
+	assert.NoError(t, s.PrepareEvaluation())
+
+	for _, tc := range []struct {
+		entityContext map[string]interface{}
+		matched       bool
+	}{
+		{entityContext: map[string]interface{}{"country": "US"}, matched: true},
+		{entityContext: map[string]interface{}{"country": "CA", "plan": "enterprise"}, matched: true},
+		{entityContext: map[string]interface{}{"country": "CA", "plan": "free"}, matched: false},
+	} {
+		vID, log, evalNextSegment, _ := evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: tc.entityContext,
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}, s)
+		assert.Equal(t, tc.matched, vID != nil, tc.entityContext)
+		assert.Equal(t, !tc.matched, evalNextSegment)
+		if !tc.matched {
+			assert.Contains(t, log.Msg, "constraint not match")
+		}
+	}
+}
+
+func TestEvalSegmentWithSemverConstraints(t *testing.T) {
+	s := entity.GenFixtureSegment()
+	s.Constraints = entity.ConstraintArray{
+		{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: `"4.10.0"`},
+	}
+	assert.NoError(t, s.PrepareEvaluation())
+
+	evalAppVersion := func(appVersion string) (*uint, *models.SegmentDebugLog) {
+		vID, log, _, _ := evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"app_version": appVersion},
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}, s)
+		return vID, log
+	}
+
+	t.Run("test newer version", func(t *testing.T) {
+		vID, _ := evalAppVersion("4.10.0")
+		assert.NotNil(t, vID)
+	})
+
+	t.Run("test older version", func(t *testing.T) {
+		vID, log := evalAppVersion("4.9.9")
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, "constraint not match")
+	})
+
+	t.Run("test invalid version", func(t *testing.T) {
+		vID, log := evalAppVersion("nightly")
+		assert.Nil(t, vID)
+		assert.Equal(t, `property app_version is not a valid semantic version: "nightly"`, log.Msg)
+	})
+}
+
+func TestEvalSegmentWithTimeConstraints(t *testing.T) {
+	s := entity.GenFixtureSegment()
+	s.Constraints = entity.ConstraintArray{
+		{Property: "created_at", Operator: models.ConstraintOperatorBEFORE, Value: `"2025-01-01T00:00:00Z"`},
+		{Property: entity.NowProperty, Operator: models.ConstraintOperatorBETWEEN, Value: `["2025-11-28T00:00:00Z", "2025-12-02T00:00:00Z"]`},
+	}
+	assert.NoError(t, s.PrepareEvaluation())
+
+	evalAt := func(now time.Time, createdAt interface{}) (*uint, *models.SegmentDebugLog) {
+		defer gostub.StubFunc(&entity.Now, now).Reset()
+		vID, log, _, _ := evalSegment(100, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]interface{}{"created_at": createdAt},
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}, s)
+		return vID, log
+	}
+	blackFriday := time.Date(2025, 11, 28, 12, 0, 0, 0, time.UTC)
+
+	t.Run("test old account during the sale", func(t *testing.T) {
+		vID, _ := evalAt(blackFriday, "2024-06-01T00:00:00Z")
+		assert.NotNil(t, vID)
+	})
+
+	t.Run("test old account with epoch seconds during the sale", func(t *testing.T) {
+		vID, _ := evalAt(blackFriday, float64(1717200000))
+		assert.NotNil(t, vID)
+	})
+
+	t.Run("test new account during the sale", func(t *testing.T) {
+		vID, log := evalAt(blackFriday, "2025-06-01T00:00:00Z")
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, "constraint not match")
+	})
+
+	t.Run("test old account after the sale", func(t *testing.T) {
+		vID, log := evalAt(blackFriday.AddDate(0, 0, 7), "2024-06-01T00:00:00Z")
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, "constraint not match")
+	})
+
+	t.Run("test invalid timestamp", func(t *testing.T) {
+		vID, log := evalAt(blackFriday, "last year")
+		assert.Nil(t, vID)
+		assert.Equal(t, "property created_at is not a RFC3339 or unix epoch timestamp: last year", log.Msg)
+	})
+}
+
+func TestEvaluateWithDebug(t *testing.T) {
+	f := entity.GenFixtureFlag()
+	evalContext := models.EvalContext{
+		EnableDebug:   true,
+		EntityID:      "entityID1",
+		EntityContext: map[string]interface{}{"dl_state": "CA"},
+		FlagID:        100,
+	}
+
+	t.Run("debug enabled", func(t *testing.T) {
+		r := (&Evaluator{DebugEnabled: true}).Evaluate(&f, evalContext)
+		assert.Len(t, r.EvalDebugLog.SegmentDebugLogs, 1)
+		assert.NotEmpty(t, r.EvalDebugLog.SegmentDebugLogs[0].ConstraintTraces)
+	})
+
+	t.Run("debug disabled by the server", func(t *testing.T) {
+		debugged := false
+		defer gostub.Stub(&evalSegment, func(flagID uint, evalContext models.EvalContext, segment entity.Segment) (
+			*uint, *models.SegmentDebugLog, bool, string) {
+			debugged = debugged || evalContext.EnableDebug
+			return nil, &models.SegmentDebugLog{}, true, models.EvalResultReasonNOMATCH
+		}).Reset()
+
+		r := (&Evaluator{DebugEnabled: false}).Evaluate(&f, evalContext)
+		assert.Empty(t, r.EvalDebugLog.SegmentDebugLogs)
+		assert.False(t, debugged)
+	})
+}
+
+func TestEvaluateWithNow(t *testing.T) {
+	f := entity.GenFixtureFlag()
+	f.Segments[0].Constraints = entity.ConstraintArray{
+		{Property: entity.NowProperty, Operator: models.ConstraintOperatorGTE, Value: "1764288000"}, // 2025-11-28
+	}
+	assert.NoError(t, f.PrepareEvaluation())
+	evalContext := models.EvalContext{
+		EntityID:      "entityID1",
+		EntityContext: map[string]interface{}{"now": float64(1893456000)}, // 2030-01-01
+		FlagID:        100,
+	}
+	e := &Evaluator{}
+
+	t.Run("the entity context cannot set now", func(t *testing.T) {
+		defer gostub.StubFunc(&entity.Now, time.Date(2025, 11, 27, 0, 0, 0, 0, time.UTC)).Reset()
+		r := e.Evaluate(&f, evalContext)
+		assert.Equal(t, models.EvalResultReasonNOMATCH, r.Reason)
+		assert.Equal(t, map[string]interface{}{"now": float64(1893456000)}, r.EvalContext.EntityContext)
+	})
+
+	t.Run("now works with the numeric operators", func(t *testing.T) {
+		defer gostub.StubFunc(&entity.Now, time.Date(2025, 11, 29, 0, 0, 0, 0, time.UTC)).Reset()
+		assert.Equal(t, models.EvalResultReasonMATCHED, e.Evaluate(&f, evalContext).Reason)
+	})
+
+	t.Run("now of an audience", func(t *testing.T) {
+		defer gostub.StubFunc(&entity.Now, time.Date(2025, 11, 27, 0, 0, 0, 0, time.UTC)).Reset()
+		a := &entity.Audience{Model: gorm.Model{ID: 1}, Constraints: f.Segments[0].Constraints}
+		assert.NoError(t, a.PrepareEvaluation())
+		af := entity.GenFixtureFlag()
+		af.Segments[0].Constraints = nil
+		af.Segments[0].Audiences = []entity.Audience{{Model: gorm.Model{ID: 1}}}
+		assert.NoError(t, af.PrepareEvaluation())
+		af.LinkAudiences(map[uint]*entity.Audience{1: a})
+		assert.Equal(t, models.EvalResultReasonNOMATCH, e.Evaluate(&af, evalContext).Reason)
+	})
+}
+
+func TestEvalSegmentWithAudiences(t *testing.T) {
+	genAudiences := func() map[uint]*entity.Audience {
+		a := &entity.Audience{
+			Model: gorm.Model{ID: 1},
+			Key:   "ca_drivers",
+			Constraints: []entity.Constraint{
+				{
+					Property: "dl_state",
+					Operator: models.ConstraintOperatorEQ,
+					Value:    `"CA"`,
+				},
+			},
+		}
+		a.PrepareEvaluation()
+		return map[uint]*entity.Audience{a.ID: a}
+	}
+	genSegment := func(constraints entity.ConstraintArray) entity.Segment {
+		s := entity.GenFixtureSegment()
+		s.Constraints = constraints
+		s.Audiences = []entity.Audience{{Model: gorm.Model{ID: 1}}}
+		s.PrepareEvaluation()
+		s.LinkAudiences(genAudiences())
+		return s
+	}
+	evalContext := func(entityContext map[string]interface{}) models.EvalContext {
+		return models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: entityContext,
+			EntityID:      "entityID1",
+			EntityType:    "entityType1",
+			FlagID:        int64(100),
+		}
+	}
+
+	t.Run("audience matched", func(t *testing.T) {
+		vID, log, evalNextSegment, _ := evalSegment(100, evalContext(map[string]interface{}{"dl_state": "CA"}), genSegment(nil))
+		assert.NotNil(t, vID)
+		assert.Contains(t, log.Msg, "matched all constraints")
+		assert.False(t, evalNextSegment)
+	})
+
+	t.Run("audience not matched", func(t *testing.T) {
+		vID, log, evalNextSegment, _ := evalSegment(100, evalContext(map[string]interface{}{"dl_state": "NY"}), genSegment(nil))
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, "audience not match. audience: ca_drivers")
+		assert.True(t, evalNextSegment)
+	})
+
+	t.Run("audience matched but segment constraints not matched", func(t *testing.T) {
+		s := genSegment(entity.ConstraintArray{
+			{
+				Property: "age",
+				Operator: models.ConstraintOperatorGT,
+				Value:    `18`,
+			},
+		})
+		vID, log, evalNextSegment, _ := evalSegment(100, evalContext(map[string]interface{}{"dl_state": "CA", "age": 16}), s)
+		assert.Nil(t, vID)
+		assert.True(t, evalNextSegment)
+		assert.Len(t, log.ConstraintTraces, 2)
+		assert.Equal(t, "ca_drivers", log.ConstraintTraces[0].AudienceKey)
+		assert.Equal(t, models.ConstraintTraceResultMATCH, log.ConstraintTraces[0].Result)
+		assert.Empty(t, log.ConstraintTraces[1].AudienceKey)
+		assert.Equal(t, models.ConstraintTraceResultNOTMATCH, log.ConstraintTraces[1].Result)
+	})
+
+	t.Run("audience missing from the cache", func(t *testing.T) {
+		s := genSegment(nil)
+		s.LinkAudiences(map[uint]*entity.Audience{})
+		vID, log, evalNextSegment, _ := evalSegment(100, evalContext(map[string]interface{}{"dl_state": "CA"}), s)
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, "audience_id 1 not found")
+		assert.True(t, evalNextSegment)
+	})
+}
+
+func TestEvaluateWithStickyAssignments(t *testing.T) {
+	genFlag := func() *entity.Flag {
+		f := entity.GenFixtureFlag()
+		f.StickyAssignments = true
+		f.AssignmentSalt = "salt"
+		return &f
+	}
+	// moveAll moves all the entities of the segment to the other variant
+	moveAll := func(t *testing.T, f *entity.Flag, fromVariantID int64) {
+		ds := f.Segments[0].Distributions
+		for i := range ds {
+			if int64(ds[i].VariantID) == fromVariantID {
+				ds[i].Percent = 0
+			} else {
+				ds[i].Percent = 100
+			}
+		}
+		assert.NoError(t, f.PrepareEvaluation())
+	}
+	evalContext := models.EvalContext{
+		EntityID:      "entityID1",
+		EntityContext: map[string]interface{}{"dl_state": "CA"},
+		FlagID:        100,
+	}
+
+	t.Run("the entity keeps its variant", func(t *testing.T) {
+		e := &Evaluator{Assignments: NewMemoryAssignmentStore()}
+		f := genFlag()
+		r := e.Evaluate(f, evalContext)
+		assert.Equal(t, models.EvalResultReasonMATCHED, r.Reason)
+
+		moveAll(t, f, r.VariantID)
+		sticky := e.Evaluate(f, evalContext)
+		assert.Equal(t, models.EvalResultReasonSTICKY, sticky.Reason)
+		assert.Equal(t, r.VariantID, sticky.VariantID)
+		assert.Equal(t, r.VariantKey, sticky.VariantKey)
+		assert.Equal(t, r.VariantAttachment, sticky.VariantAttachment)
+
+		f.AssignmentSalt = "new_salt"
+		moved := e.Evaluate(f, evalContext)
+		assert.Equal(t, models.EvalResultReasonMATCHED, moved.Reason)
+		assert.NotEqual(t, r.VariantID, moved.VariantID)
+	})
+
+	t.Run("not sticky without the store or the flag setting", func(t *testing.T) {
+		f := genFlag()
+		r := (&Evaluator{}).Evaluate(f, evalContext)
+		moveAll(t, f, r.VariantID)
+		assert.NotEqual(t, r.VariantID, (&Evaluator{}).Evaluate(f, evalContext).VariantID)
+
+		e := &Evaluator{Assignments: NewMemoryAssignmentStore()}
+		f = genFlag()
+		f.StickyAssignments = false
+		r = e.Evaluate(f, evalContext)
+		moveAll(t, f, r.VariantID)
+		assert.NotEqual(t, r.VariantID, e.Evaluate(f, evalContext).VariantID)
+	})
+
+	t.Run("the random entityIDs are not sticky", func(t *testing.T) {
+		store := NewMemoryAssignmentStore()
+		e := &Evaluator{Assignments: store}
+		c := evalContext
+		c.EntityID = ""
+		e.Evaluate(genFlag(), c)
+		assert.Empty(t, store.assignments)
+	})
+
+	t.Run("sticky prerequisites of a non-sticky flag", func(t *testing.T) {
+		parent := entity.GenFixtureFlag()
+		parent.ID, parent.Key = 101, "flag_key_101"
+		parent.Segments[0].Prerequisites = entity.Prerequisites{
+			{FlagKey: "flag_key_100", VariantKeys: []string{"control", "treatment"}},
+		}
+		fs, err := PrepareFlags(&EvalCacheJSON{Flags: []entity.Flag{*genFlag(), parent}})
+		assert.NoError(t, err)
+		store := NewMemoryAssignmentStore()
+		e := &Evaluator{Flags: fs, Assignments: store}
+
+		c := evalContext
+		c.FlagID = 101
+		assert.Equal(t, models.EvalResultReasonMATCHED, e.Evaluate(fs.GetByFlagKeyOrID(uint(101)), c).Reason)
+		assert.Len(t, store.assignments, 1)
+		_, ok := store.GetAssignment(100, "salt", "entityID1")
+		assert.True(t, ok)
+
+		// the entityID generated for the parent is not kept for the prerequisite either
+		store = NewMemoryAssignmentStore()
+		e.Assignments = store
+		c.EntityID = ""
+		e.Evaluate(fs.GetByFlagKeyOrID(uint(101)), c)
+		assert.Empty(t, store.assignments)
+	})
+
+	t.Run("disabled flags and overrides win over the assignments", func(t *testing.T) {
+		store := NewMemoryAssignmentStore()
+		store.SetAssignment(100, "salt", "entityID1", 300)
+		e := &Evaluator{Assignments: store}
+
+		f := genFlag()
+		f.Overrides = []entity.VariantOverride{{FlagID: 100, EntityID: "entityID1", VariantID: 301}}
+		assert.NoError(t, f.PrepareEvaluation())
+		r := e.Evaluate(f, evalContext)
+		assert.Equal(t, models.EvalResultReasonOVERRIDE, r.Reason)
+		assert.Equal(t, int64(301), r.VariantID)
+
+		f = genFlag()
+		f.Enabled = false
+		assert.Equal(t, models.EvalResultReasonFLAGDISABLED, e.Evaluate(f, evalContext).Reason)
+	})
+
+	t.Run("the assignment of a deleted variant is evaluated again", func(t *testing.T) {
+		store := NewMemoryAssignmentStore()
+		store.SetAssignment(100, "salt", "entityID1", 999)
+		e := &Evaluator{Assignments: store}
+
+		r := e.Evaluate(genFlag(), evalContext)
+		assert.Equal(t, models.EvalResultReasonMATCHED, r.Reason)
+		vID, _ := store.GetAssignment(100, "salt", "entityID1")
+		assert.Equal(t, uint(r.VariantID), vID)
+	})
+}
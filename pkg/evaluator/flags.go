@@ -0,0 +1,137 @@
+package evaluator
+
+import (
+	"sort"
+
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// EvalCacheJSON is the JSON serialization format of EvalCache's flags, audiences and ID lists
+type EvalCacheJSON struct {
+	Flags     []entity.Flag
+	Audiences []entity.Audience
+	IDLists   []entity.IDList
+}
+
+// Flags is the index of the flags prepared for evaluation
+type Flags struct {
+	ByID      map[string]*entity.Flag
+	ByKey     map[string]*entity.Flag
+	ByTag     map[string]map[uint]*entity.Flag
+	Audiences map[uint]*entity.Audience
+	IDLists   map[string]*entity.IDList
+}
+
+// PrepareFlags prepares the flags of the EvalCacheJSON for evaluation, linking the audiences
+// and the ID lists referenced by their segments, and indexes them by ID, key and tag
+func PrepareFlags(ecj *EvalCacheJSON) (*Flags, error) {
+	// ID lists are loaded into hash sets once and shared by all the constraints referencing them
+	idLists := make(map[string]*entity.IDList)
+	for i := range ecj.IDLists {
+		l := &ecj.IDLists[i]
+		if err := l.PrepareEvaluation(); err != nil {
+			return nil, err
+		}
+		idLists[l.Key] = l
+	}
+
+	// audiences are compiled once and shared by all the segments referencing them
+	audiences := make(map[uint]*entity.Audience)
+	for i := range ecj.Audiences {
+		a := &ecj.Audiences[i]
+		if err := a.PrepareEvaluation(); err != nil {
+			return nil, err
+		}
+		a.LinkIDLists(idLists)
+		audiences[a.ID] = a
+	}
+
+	fs := &Flags{
+		ByID:      make(map[string]*entity.Flag),
+		ByKey:     make(map[string]*entity.Flag),
+		ByTag:     make(map[string]map[uint]*entity.Flag),
+		Audiences: audiences,
+		IDLists:   idLists,
+	}
+	for i := range ecj.Flags {
+		f := &ecj.Flags[i]
+		if err := f.PrepareEvaluation(); err != nil {
+			return nil, err
+		}
+		f.LinkAudiences(audiences)
+		f.LinkIDLists(idLists)
+
+		if f.ID != 0 {
+			fs.ByID[util.SafeString(f.ID)] = f
+		}
+		if f.Key != "" {
+			fs.ByKey[f.Key] = f
+		}
+		for _, s := range f.Tags {
+			if fs.ByTag[s.Value] == nil {
+				fs.ByTag[s.Value] = make(map[uint]*entity.Flag)
+			}
+			fs.ByTag[s.Value][f.ID] = f
+		}
+	}
+	return fs, nil
+}
+
+// GetByFlagKeyOrID gets the flag by Key or ID
+func (fs *Flags) GetByFlagKeyOrID(keyOrID interface{}) *entity.Flag {
+	s := util.SafeString(keyOrID)
+	f, ok := fs.ByID[s]
+	if !ok {
+		f = fs.ByKey[s]
+	}
+	return f
+}
+
+// GetByTags gets the flags with any of the tags, or all of them if the operator is ALL,
+// in the order of their IDs
+func (fs *Flags) GetByTags(tags []string, operator *string) []*entity.Flag {
+	results := map[uint]*entity.Flag{}
+	if util.SafeString(operator) == models.EvalContextFlagTagsOperatorALL {
+		for i, t := range tags {
+			fSet := fs.ByTag[t]
+			if i == 0 {
+				for fID, f := range fSet {
+					results[fID] = f
+				}
+				continue
+			}
+			for fID := range results {
+				if _, ok := fSet[fID]; !ok {
+					delete(results, fID)
+				}
+			}
+		}
+	} else {
+		for _, t := range tags {
+			for fID, f := range fs.ByTag[t] {
+				results[fID] = f
+			}
+		}
+	}
+	return sortedFlags(results)
+}
+
+// GetAll gets all the flags in the order of their IDs
+func (fs *Flags) GetAll() []*entity.Flag {
+	results := make(map[uint]*entity.Flag, len(fs.ByID))
+	for _, f := range fs.ByID {
+		results[f.ID] = f
+	}
+	return sortedFlags(results)
+}
+
+func sortedFlags(m map[uint]*entity.Flag) []*entity.Flag {
+	fs := make([]*entity.Flag, 0, len(m))
+	for _, f := range m {
+		fs = append(fs, f)
+	}
+	sort.Slice(fs, func(i, j int) bool { return fs[i].ID < fs[j].ID })
+	return fs
+}
@@ -0,0 +1,115 @@
+package evaluator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Source loads the EvalCacheJSON document of the flags to evaluate
+type Source func() (*EvalCacheJSON, error)
+
+// FileSource loads the EvalCacheJSON document from the file
+func FileSource(filePath string) Source {
+	return func() (*EvalCacheJSON, error) {
+		b, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return decodeEvalCacheJSON(b)
+	}
+}
+
+// SourceOption configures the HTTP requests of URLSource and ServerSource
+type SourceOption func(*httpSource)
+
+// WithHTTPClient sends the requests with the client, e.g. one with a custom transport or TLS
+// config. The timeout of the source is ignored, set the client's Timeout instead.
+func WithHTTPClient(client *http.Client) SourceOption {
+	return func(s *httpSource) { s.client = client }
+}
+
+// WithRequestDecorator calls decorate with every request before it's sent, e.g. to add the
+// Authorization header of a fresh JWT
+func WithRequestDecorator(decorate func(*http.Request) error) SourceOption {
+	return func(s *httpSource) { s.decorators = append(s.decorators, decorate) }
+}
+
+// WithBasicAuth authenticates the requests with the username and password of the flagr
+// server's basic auth
+func WithBasicAuth(username, password string) SourceOption {
+	return WithRequestDecorator(func(r *http.Request) error {
+		r.SetBasicAuth(username, password)
+		return nil
+	})
+}
+
+// WithBearerToken authenticates the requests with the JWT of the flagr server's JWT auth
+func WithBearerToken(token string) SourceOption {
+	return WithRequestDecorator(func(r *http.Request) error {
+		r.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+type httpSource struct {
+	url        string
+	client     *http.Client
+	decorators []func(*http.Request) error
+}
+
+// URLSource loads the EvalCacheJSON document from the URL
+func URLSource(url string, timeout time.Duration, opts ...SourceOption) Source {
+	s := &httpSource{url: url, client: &http.Client{Timeout: timeout}}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s.load
+}
+
+// ServerSource loads the EvalCacheJSON document exported by the flagr server, e.g. http://localhost:18000.
+// The export is not whitelisted by the server's JWT and basic auth, so pass WithBearerToken or
+// WithBasicAuth if they are enabled.
+func ServerSource(serverURL string, timeout time.Duration, opts ...SourceOption) Source {
+	return URLSource(strings.TrimSuffix(serverURL, "/")+"/api/v1/export/eval_cache/json", timeout, opts...)
+}
+
+func (s *httpSource) load() (*EvalCacheJSON, error) {
+	req, err := http.NewRequest(http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, decorate := range s.decorators {
+		if err := decorate(req); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("failed to load the eval cache from %s. status: %s", s.url, res.Status)
+	}
+
+	b, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	return decodeEvalCacheJSON(b)
+}
+
+func decodeEvalCacheJSON(b []byte) (*EvalCacheJSON, error) {
+	ecj := &EvalCacheJSON{}
+	if err := json.Unmarshal(b, ecj); err != nil {
+		return nil, err
+	}
+	return ecj, nil
+}
@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/openflagr/flagr/pkg/config"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/evaluator"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	singletonAssignmentStore     evaluator.AssignmentStore
+	singletonAssignmentStoreOnce sync.Once
+)
+
+// flagAssignmentEntityIDMaxLen is the size of the entity_id column of the flag assignments
+const flagAssignmentEntityIDMaxLen = 255
+
+// flagAssignmentWriteBatchSize is the max number of the queued assignments inserted at once
+const flagAssignmentWriteBatchSize = 100
+
+// GetAssignmentStore gets the store of the sticky assignments. It's nil if there's no DB to
+// keep the assignments, e.g. the flags are read from a json file.
+var GetAssignmentStore = func() evaluator.AssignmentStore {
+	singletonAssignmentStoreOnce.Do(func() {
+		storeType := config.Config.EvalStickyAssignmentStore
+		switch storeType {
+		case "sql":
+			if _, ok := config.EvalOnlyModeDBDrivers[config.Config.DBDriver]; ok {
+				logrus.Warnf("sticky assignments are disabled, there's no sql store for the DBDriver %s", config.Config.DBDriver)
+				return
+			}
+			s := newSQLAssignmentStore()
+			s.Start()
+			singletonAssignmentStore = s
+		case "memory":
+			singletonAssignmentStore = evaluator.NewMemoryAssignmentStore()
+		default:
+			panic("sticky assignment store not supported")
+		}
+	})
+
+	return singletonAssignmentStore
+}
+
+// readOnlyAssignmentStore looks up the sticky assignments of the store without saving the new
+// ones, e.g. for the simulations
+type readOnlyAssignmentStore struct {
+	evaluator.AssignmentStore
+}
+
+func (s readOnlyAssignmentStore) SetAssignment(flagID uint, salt string, entityID string, variantID uint) {
+	// the new assignments are not saved
+}
+
+type flagAssignmentKey struct {
+	flagID   uint
+	salt     string
+	entityID string
+}
+
+// sqlAssignmentStore keeps the sticky assignments in the flag_assignments table. The lookups
+// are read through a bounded in-memory cache, and the new assignments are inserted in batches
+// in the background, so that the evaluation doesn't wait for the DB writes.
+type sqlAssignmentStore struct {
+	timeout         time.Duration
+	cleanupInterval time.Duration
+	writes          chan entity.FlagAssignment
+
+	cache      map[flagAssignmentKey]uint
+	cacheSize  int
+	cacheMutex sync.Mutex
+}
+
+func newSQLAssignmentStore() *sqlAssignmentStore {
+	return &sqlAssignmentStore{
+		timeout:         config.Config.EvalStickyAssignmentTimeout,
+		cleanupInterval: config.Config.EvalStickyAssignmentCleanupInterval,
+		writes:          make(chan entity.FlagAssignment, config.Config.EvalStickyAssignmentWriteQueueSize),
+		cache:           map[flagAssignmentKey]uint{},
+		cacheSize:       config.Config.EvalStickyAssignmentCacheSize,
+	}
+}
+
+// Start starts writing the queued assignments, and the periodic cleanup of the assignments
+// of the old salts and the deleted flags
+func (s *sqlAssignmentStore) Start() {
+	go func() {
+		for a := range s.writes {
+			s.write(s.drainWrites(a))
+		}
+	}()
+
+	if s.cleanupInterval <= 0 {
+		return
+	}
+	go func() {
+		for range time.Tick(s.cleanupInterval) {
+			n, err := s.cleanup(entity.Now().Add(-s.cleanupInterval))
+			if err != nil {
+				logrus.WithField("err", err).Error("failed to clean up the sticky assignments")
+				continue
+			}
+			logrus.WithField("count", n).Info("cleaned up the sticky assignments of the old salts and the deleted flags")
+		}
+	}()
+}
+
+// GetAssignment looks up the assignment in the cache, or in the DB within the timeout. If the
+// DB lookup fails, the following SetAssignment of the entity is skipped, so that an assignment
+// that couldn't be read is not overwritten.
+func (s *sqlAssignmentStore) GetAssignment(flagID uint, salt string, entityID string) (uint, bool) {
+	key := flagAssignmentKey{flagID: flagID, salt: salt, entityID: entityID}
+	if variantID := s.getCached(key); variantID != 0 {
+		return variantID, true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	a := &entity.FlagAssignment{}
+	err := getDB().WithContext(ctx).Where("flag_id = ? AND salt = ? AND entity_id = ?", flagID, salt, entityID).First(a).Error
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			logrus.WithField("err", err).Errorf("failed to get the sticky assignment of flag %d", flagID)
+			// 0 marks the lookup as failed
+			s.setCached(key, 0)
+			return 0, false
+		}
+		// clears the mark of an earlier failed lookup
+		s.lookupFailed(key)
+		return 0, false
+	}
+	s.setCached(key, a.VariantID)
+	return a.VariantID, true
+}
+
+// SetAssignment caches the assignment and queues it to be inserted. It's dropped if the queue
+// is full, the entity is then assigned again by its next evaluation on the other replicas.
+func (s *sqlAssignmentStore) SetAssignment(flagID uint, salt string, entityID string, variantID uint) {
+	if len(entityID) > flagAssignmentEntityIDMaxLen {
+		return
+	}
+	key := flagAssignmentKey{flagID: flagID, salt: salt, entityID: entityID}
+	if s.lookupFailed(key) {
+		return
+	}
+	s.setCached(key, variantID)
+
+	select {
+	case s.writes <- entity.FlagAssignment{FlagID: flagID, Salt: salt, EntityID: entityID, VariantID: variantID}:
+	default:
+		logrus.Errorf("failed to save the sticky assignment of flag %d, the write queue is full", flagID)
+	}
+}
+
+func (s *sqlAssignmentStore) getCached(key flagAssignmentKey) uint {
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+	return s.cache[key]
+}
+
+func (s *sqlAssignmentStore) setCached(key flagAssignmentKey, variantID uint) {
+	if s.cacheSize <= 0 {
+		return
+	}
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+	if _, ok := s.cache[key]; !ok && len(s.cache) >= s.cacheSize {
+		// evict an arbitrary entry, the map iteration order is random
+		for k := range s.cache {
+			delete(s.cache, k)
+			break
+		}
+	}
+	s.cache[key] = variantID
+}
+
+// lookupFailed returns whether the last lookup of the key failed, and clears the mark
+func (s *sqlAssignmentStore) lookupFailed(key flagAssignmentKey) bool {
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+	variantID, ok := s.cache[key]
+	if ok && variantID == 0 {
+		delete(s.cache, key)
+		return true
+	}
+	return false
+}
+
+// drainWrites returns the assignment and the ones queued after it, up to the batch size
+func (s *sqlAssignmentStore) drainWrites(a entity.FlagAssignment) []entity.FlagAssignment {
+	batch := []entity.FlagAssignment{a}
+	for len(batch) < flagAssignmentWriteBatchSize {
+		select {
+		case a := <-s.writes:
+			batch = append(batch, a)
+		default:
+			return batch
+		}
+	}
+	return batch
+}
+
+// write inserts the assignments, the first one of an entity wins. An existing assignment is
+// kept, e.g. one written by another replica at the same time, or by a replica whose EvalCache
+// still has the old distributions.
+func (s *sqlAssignmentStore) write(batch []entity.FlagAssignment) {
+	seen := map[flagAssignmentKey]bool{}
+	as := []entity.FlagAssignment{}
+	for _, a := range batch {
+		key := flagAssignmentKey{flagID: a.FlagID, salt: a.Salt, entityID: a.EntityID}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		as = append(as, a)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	res := getDB().WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "flag_id"}, {Name: "salt"}, {Name: "entity_id"}},
+		DoNothing: true,
+	}).Create(&as)
+	if res.Error != nil {
+		logrus.WithField("err", res.Error).Errorf("failed to save %d sticky assignments", len(as))
+		return
+	}
+	if res.RowsAffected != int64(len(as)) {
+		// some entities were assigned already, their cached variants may not be the kept ones
+		s.cacheMutex.Lock()
+		defer s.cacheMutex.Unlock()
+		for key := range seen {
+			delete(s.cache, key)
+		}
+	}
+}
+
+// cleanup deletes the assignments written before, whose salt is not the current AssignmentSalt
+// of the flag, whose flag is deleted, or whose variant is deleted, so that the entity can be
+// assigned again. The delay lets the evaluations of the old salt, which continue until the
+// EvalCache is refreshed, finish writing.
+func (s *sqlAssignmentStore) cleanup(before time.Time) (int64, error) {
+	currentFlag := getDB().Model(&entity.Flag{}).Select("1").
+		Where("flags.id = flag_assignments.flag_id AND flags.assignment_salt = flag_assignments.salt")
+	currentVariant := getDB().Model(&entity.Variant{}).Select("1").
+		Where("variants.id = flag_assignments.variant_id")
+	res := getDB().
+		Where("updated_at < ? AND (NOT EXISTS (?) OR NOT EXISTS (?))", before, currentFlag, currentVariant).
+		Delete(&entity.FlagAssignment{})
+	return res.RowsAffected, res.Error
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+// writePending writes the queued assignments of the store
+func writePending(s *sqlAssignmentStore) {
+	for {
+		select {
+		case a := <-s.writes:
+			s.write(s.drainWrites(a))
+		default:
+			return
+		}
+	}
+}
+
+func TestSQLAssignmentStore(t *testing.T) {
+	db := entity.NewTestDB()
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	t.Run("read through the cache and write in the background", func(t *testing.T) {
+		s := newSQLAssignmentStore()
+		_, ok := s.GetAssignment(100, "salt", "entityID1")
+		assert.False(t, ok)
+
+		s.SetAssignment(100, "salt", "entityID1", 300)
+		vID, ok := s.GetAssignment(100, "salt", "entityID1")
+		assert.True(t, ok)
+		assert.Equal(t, uint(300), vID)
+		_, ok = newSQLAssignmentStore().GetAssignment(100, "salt", "entityID1")
+		assert.False(t, ok)
+
+		s.SetAssignment(100, "salt", "entityID1", 301)
+		s.SetAssignment(100, "salt", "entityID2", 300)
+		writePending(s)
+		vID, ok = newSQLAssignmentStore().GetAssignment(100, "salt", "entityID1")
+		assert.True(t, ok)
+		assert.Equal(t, uint(300), vID)
+
+		_, ok = s.GetAssignment(100, "another_salt", "entityID1")
+		assert.False(t, ok)
+
+		longEntityID := strings.Repeat("a", flagAssignmentEntityIDMaxLen+1)
+		s.SetAssignment(100, "salt", longEntityID, 300)
+		_, ok = s.GetAssignment(100, "salt", longEntityID)
+		assert.False(t, ok)
+	})
+
+	t.Run("the first assignment of an entity is kept", func(t *testing.T) {
+		s := newSQLAssignmentStore()
+		s.SetAssignment(100, "salt", "entityID3", 300)
+		writePending(s)
+
+		// e.g. another replica assigning the entity at the same time
+		other := newSQLAssignmentStore()
+		other.SetAssignment(100, "salt", "entityID3", 301)
+		writePending(other)
+		vID, ok := other.GetAssignment(100, "salt", "entityID3")
+		assert.True(t, ok)
+		assert.Equal(t, uint(300), vID)
+	})
+
+	t.Run("the cache is bounded", func(t *testing.T) {
+		s := newSQLAssignmentStore()
+		s.cacheSize = 2
+		s.SetAssignment(100, "salt", "entityID1", 300)
+		s.SetAssignment(100, "salt", "entityID2", 300)
+		s.SetAssignment(100, "salt", "entityID3", 300)
+		assert.Len(t, s.cache, 2)
+	})
+
+	t.Run("the new assignments are dropped if the write queue is full", func(t *testing.T) {
+		s := newSQLAssignmentStore()
+		s.writes = make(chan entity.FlagAssignment, 1)
+		s.SetAssignment(100, "salt", "entityID1", 300)
+		s.SetAssignment(100, "salt", "entityID2", 300)
+		assert.Len(t, s.writes, 1)
+	})
+
+	t.Run("an assignment failed to be read is not overwritten", func(t *testing.T) {
+		closedDB := entity.NewTestDB()
+		sqlDB, _ := closedDB.DB()
+		sqlDB.Close()
+
+		s := newSQLAssignmentStore()
+		stubs := gostub.StubFunc(&getDB, closedDB)
+		_, ok := s.GetAssignment(100, "salt", "entityID1")
+		stubs.Reset()
+		assert.False(t, ok)
+
+		s.SetAssignment(100, "salt", "entityID1", 301)
+		assert.Len(t, s.writes, 0)
+		vID, _ := s.GetAssignment(100, "salt", "entityID1")
+		assert.Equal(t, uint(300), vID)
+	})
+}
+
+func TestSQLAssignmentStoreCleanup(t *testing.T) {
+	f := entity.GenFixtureFlag()
+	f.AssignmentSalt = "salt"
+	deleted := entity.GenFixtureFlag()
+	deleted.ID, deleted.Key = 101, "flag_key_101"
+	deleted.AssignmentSalt = "salt"
+	db := entity.PopulateTestDB(f)
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+	db.Create(&deleted)
+	db.Delete(&deleted)
+
+	now := time.Now()
+	old := now.Add(-2 * time.Hour)
+	assert.NoError(t, db.Create(&[]entity.FlagAssignment{
+		{FlagID: 100, Salt: "salt", EntityID: "current", VariantID: 300, UpdatedAt: old},
+		{FlagID: 100, Salt: "old_salt", EntityID: "old_salt", VariantID: 300, UpdatedAt: old},
+		{FlagID: 100, Salt: "old_salt", EntityID: "recently_written", VariantID: 300, UpdatedAt: now},
+		{FlagID: 101, Salt: "salt", EntityID: "deleted_flag", VariantID: 300, UpdatedAt: old},
+		{FlagID: 999, Salt: "salt", EntityID: "missing_flag", VariantID: 300, UpdatedAt: old},
+		{FlagID: 100, Salt: "salt", EntityID: "deleted_variant", VariantID: 999, UpdatedAt: old},
+	}).Error)
+
+	n, err := newSQLAssignmentStore().cleanup(now.Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), n)
+
+	entityIDs := []string{}
+	db.Model(&entity.FlagAssignment{}).Order("entity_id").Pluck("entity_id", &entityIDs)
+	assert.Equal(t, []string{"current", "recently_written"}, entityIDs)
+}
+
+func TestEvalFlagWithStickyAssignments(t *testing.T) {
+	db := entity.NewTestDB()
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+	defer gostub.StubFunc(&GetAssignmentStore, newSQLAssignmentStore()).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	f := entity.GenFixtureFlag()
+	f.StickyAssignments = true
+	f.AssignmentSalt = "salt"
+	evalContext := models.EvalContext{
+		EntityID:      "entityID1",
+		EntityContext: map[string]interface{}{"dl_state": "CA"},
+		FlagID:        100,
+	}
+
+	r := EvalFlagWithContext(&f, evalContext)
+	assert.Equal(t, models.EvalResultReasonMATCHED, r.Reason)
+
+	// move everyone to the other variant, the entity keeps its variant
+	ds := f.Segments[0].Distributions
+	for i := range ds {
+		if int64(ds[i].VariantID) == r.VariantID {
+			ds[i].Percent = 0
+		} else {
+			ds[i].Percent = 100
+		}
+	}
+	assert.NoError(t, f.PrepareEvaluation())
+	sticky := EvalFlagWithContext(&f, evalContext)
+	assert.Equal(t, models.EvalResultReasonSTICKY, sticky.Reason)
+	assert.Equal(t, r.VariantKey, sticky.VariantKey)
+
+	f.AssignmentSalt = "new_salt"
+	assert.NotEqual(t, r.VariantKey, EvalFlagWithContext(&f, evalContext).VariantKey)
+}
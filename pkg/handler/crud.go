@@ -9,12 +9,17 @@ import (
 	"github.com/openflagr/flagr/pkg/mapper/entity_restapi/e2r"
 	"github.com/openflagr/flagr/pkg/mapper/entity_restapi/r2e"
 	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/audience"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/constraint"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/distribution"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/id_list"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/rollout_ramp"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/scheduled_change"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/segment"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/tag"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/variant"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/variant_override"
 
 	"github.com/go-openapi/runtime/middleware"
 	"gorm.io/gorm"
@@ -30,6 +35,7 @@ type CRUD interface {
 	PutFlag(flag.PutFlagParams) middleware.Responder
 	DeleteFlag(flag.DeleteFlagParams) middleware.Responder
 	RestoreFlag(flag.RestoreFlagParams) middleware.Responder
+	ResetFlagAssignmentSalt(flag.ResetFlagAssignmentSaltParams) middleware.Responder
 	SetFlagEnabledState(flag.SetFlagEnabledParams) middleware.Responder
 	GetFlagSnapshots(params flag.GetFlagSnapshotsParams) middleware.Responder
 	GetFlagEntityTypes(params flag.GetFlagEntityTypesParams) middleware.Responder
@@ -47,6 +53,40 @@ type CRUD interface {
 	DeleteSegment(segment.DeleteSegmentParams) middleware.Responder
 	PutSegmentsReorder(segment.PutSegmentsReorderParams) middleware.Responder
 
+	// Audiences
+	CreateAudience(audience.CreateAudienceParams) middleware.Responder
+	FindAudiences(audience.FindAudiencesParams) middleware.Responder
+	GetAudience(audience.GetAudienceParams) middleware.Responder
+	PutAudience(audience.PutAudienceParams) middleware.Responder
+	DeleteAudience(audience.DeleteAudienceParams) middleware.Responder
+	FindAudienceFlags(audience.FindAudienceFlagsParams) middleware.Responder
+
+	// ID Lists
+	CreateIDList(id_list.CreateIDListParams) middleware.Responder
+	FindIDLists(id_list.FindIDListsParams) middleware.Responder
+	GetIDList(id_list.GetIDListParams) middleware.Responder
+	DeleteIDList(id_list.DeleteIDListParams) middleware.Responder
+	ReplaceIDListEntries(id_list.ReplaceIDListEntriesParams) middleware.Responder
+	AppendIDListEntries(id_list.AppendIDListEntriesParams) middleware.Responder
+	RemoveIDListEntries(id_list.RemoveIDListEntriesParams) middleware.Responder
+
+	// Variant Overrides
+	CreateVariantOverride(variant_override.CreateVariantOverrideParams) middleware.Responder
+	FindVariantOverrides(variant_override.FindVariantOverridesParams) middleware.Responder
+	DeleteVariantOverride(variant_override.DeleteVariantOverrideParams) middleware.Responder
+
+	// Scheduled Changes
+	CreateScheduledChange(scheduled_change.CreateScheduledChangeParams) middleware.Responder
+	FindScheduledChanges(scheduled_change.FindScheduledChangesParams) middleware.Responder
+	CancelScheduledChange(scheduled_change.CancelScheduledChangeParams) middleware.Responder
+
+	// Rollout Ramps
+	CreateRolloutRamp(rollout_ramp.CreateRolloutRampParams) middleware.Responder
+	FindRolloutRamps(rollout_ramp.FindRolloutRampsParams) middleware.Responder
+	PauseRolloutRamp(rollout_ramp.PauseRolloutRampParams) middleware.Responder
+	ResumeRolloutRamp(rollout_ramp.ResumeRolloutRampParams) middleware.Responder
+	AbortRolloutRamp(rollout_ramp.AbortRolloutRampParams) middleware.Responder
+
 	// Constraints
 	CreateConstraint(constraint.CreateConstraintParams) middleware.Responder
 	FindConstraints(constraint.FindConstraintsParams) middleware.Responder
@@ -78,6 +118,12 @@ var (
 
 	r2eMapAttachment    = r2e.MapAttachment
 	r2eMapDistributions = r2e.MapDistributions
+	r2eMapPrerequisites = r2e.MapPrerequisites
+	r2eMapAudienceIDs   = r2e.MapAudienceIDs
+	r2eMapConstraints   = r2e.MapConstraints
+
+	r2eMapConstraintGroups = r2e.MapConstraintGroups
+	r2eMapValueSchema      = r2e.MapValueSchema
 )
 
 func (c *crud) FindFlags(params flag.FindFlagsParams) middleware.Responder {
@@ -244,6 +290,11 @@ func (c *crud) PutFlag(params flag.PutFlagParams) middleware.Responder {
 		if err != nil {
 			return flag.NewPutFlagDefault(400).WithPayload(ErrorMessage("%s", err))
 		}
+		if key != f.Key {
+			if err := validateFlagNotPrerequisite(f); err != nil {
+				return flag.NewPutFlagDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+			}
+		}
 		f.Key = key
 	}
 	if params.Body.EntityType != nil {
@@ -253,6 +304,54 @@ func (c *crud) PutFlag(params flag.PutFlagParams) middleware.Responder {
 		}
 		f.EntityType = et
 	}
+	if params.Body.BucketBy != nil {
+		if _, err := entity.ParseBucketBy(*params.Body.BucketBy); err != nil {
+			return flag.NewPutFlagDefault(400).WithPayload(ErrorMessage("%s", err))
+		}
+		f.BucketBy = *params.Body.BucketBy
+	}
+	if params.Body.HashAlgorithm != nil {
+		if err := validatePutFlagHashAlgorithm(f, *params.Body.HashAlgorithm); err != nil {
+			return flag.NewPutFlagDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+		f.HashAlgorithm = *params.Body.HashAlgorithm
+	}
+
+	if params.Body.DefaultVariantID != nil {
+		if err := validateDefaultVariant(params.FlagID, *params.Body.DefaultVariantID); err != nil {
+			return flag.NewPutFlagDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+		f.DefaultVariantID = uint(*params.Body.DefaultVariantID)
+	}
+
+	if params.Body.ValueType != nil || params.Body.ValueSchema != nil {
+		valueType, valueSchema := f.ValueType, f.ValueSchema
+		if params.Body.ValueType != nil {
+			valueType = *params.Body.ValueType
+		}
+		if params.Body.ValueSchema != nil {
+			s, err := r2eMapValueSchema(params.Body.ValueSchema)
+			if err != nil {
+				return flag.NewPutFlagDefault(400).WithPayload(ErrorMessage("%s", err))
+			}
+			valueSchema = s
+		}
+		if err := validatePutFlagValueType(f, valueType, valueSchema); err != nil {
+			return flag.NewPutFlagDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+		f.ValueType, f.ValueSchema = valueType, valueSchema
+	}
+
+	if params.Body.ClientVisible != nil {
+		f.ClientVisible = *params.Body.ClientVisible
+	}
+
+	if params.Body.StickyAssignments != nil {
+		f.StickyAssignments = *params.Body.StickyAssignments
+		if f.StickyAssignments && f.AssignmentSalt == "" {
+			f.AssignmentSalt = entity.NewAssignmentSalt()
+		}
+	}
 
 	if params.Body.Notes != nil {
 		f.Notes = *params.Body.Notes
@@ -323,8 +422,45 @@ func (c *crud) RestoreFlag(params flag.RestoreFlagParams) middleware.Responder {
 	return resp
 }
 
+// ResetFlagAssignmentSalt drops the sticky assignments of the flag by resetting its AssignmentSalt
+func (c *crud) ResetFlagAssignmentSalt(params flag.ResetFlagAssignmentSaltParams) middleware.Responder {
+	tx := getDB()
+	f := &entity.Flag{}
+	if err := tx.First(f, params.FlagID).Error; err != nil {
+		return flag.NewResetFlagAssignmentSaltDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	// the assignments of the old salt are deleted later by the cleanup of the sql store
+	f.AssignmentSalt = entity.NewAssignmentSalt()
+	if err := tx.Save(f).Error; err != nil {
+		return flag.NewResetFlagAssignmentSaltDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if err := entity.PreloadSegmentsVariantsTags(tx).First(f, params.FlagID).Error; err != nil {
+		return flag.NewResetFlagAssignmentSaltDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := flag.NewResetFlagAssignmentSaltOK()
+	payload, err := e2rMapFlag(f)
+	if err != nil {
+		return flag.NewResetFlagAssignmentSaltDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	resp.SetPayload(payload)
+
+	entity.SaveFlagSnapshot(getDB(), util.SafeUint(params.FlagID), getSubjectFromRequest(params.HTTPRequest))
+	return resp
+}
+
 func (c *crud) DeleteFlag(params flag.DeleteFlagParams) middleware.Responder {
-	if err := getDB().Delete(&entity.Flag{}, params.FlagID).Error; err != nil {
+	f := &entity.Flag{}
+	if err := getDB().First(f, params.FlagID).Error; err != nil {
+		return flag.NewDeleteFlagDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := validateFlagNotPrerequisite(f); err != nil {
+		return flag.NewDeleteFlagDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if err := getDB().Delete(f).Error; err != nil {
 		return flag.NewDeleteFlagDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
 	return flag.NewDeleteFlagOK()
@@ -409,11 +545,37 @@ func (c *crud) CreateTag(params tag.CreateTagParams) middleware.Responder {
 func (c *crud) CreateSegment(params segment.CreateSegmentParams) middleware.Responder {
 	s := &entity.Segment{}
 	s.FlagID = uint(params.FlagID)
-	s.RolloutPercent = uint(*params.Body.RolloutPercent)
+	s.RolloutPercent = *params.Body.RolloutPercent
+	if err := validateRolloutPercent(params.FlagID, s.RolloutPercent); err != nil {
+		return segment.NewCreateSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+	s.Prerequisites = r2eMapPrerequisites(params.Body.Prerequisites)
+	if err := validateSegmentPrerequisites(params.FlagID, s.Prerequisites); err != nil {
+		return segment.NewCreateSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 	s.Description = util.SafeString(params.Body.Description)
 	s.Rank = entity.SegmentDefaultRank
+	s.BucketBy = params.Body.BucketBy
+	if _, err := entity.ParseBucketBy(s.BucketBy); err != nil {
+		return segment.NewCreateSegmentDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := validateSegmentAudiences(params.Body.AudienceIDs); err != nil {
+		return segment.NewCreateSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+	s.Audiences = r2eMapAudienceIDs(params.Body.AudienceIDs)
+	s.ConstraintGroups = r2eMapConstraintGroups(params.Body.ConstraintGroups)
+	if err := s.ConstraintGroups.Validate(); err != nil {
+		return segment.NewCreateSegmentDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+	if params.Body.DefaultVariantID != nil {
+		if err := validateDefaultVariant(params.FlagID, *params.Body.DefaultVariantID); err != nil {
+			return segment.NewCreateSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+		s.DefaultVariantID = uint(*params.Body.DefaultVariantID)
+	}
 
-	err := getDB().Create(s).Error
+	// only reference the existing audiences, never upsert them
+	err := getDB().Omit("Audiences.*").Create(s).Error
 	if err != nil {
 		return segment.NewCreateSegmentDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
@@ -454,10 +616,56 @@ func (c *crud) PutSegment(params segment.PutSegmentParams) middleware.Responder
 		return segment.NewPutSegmentDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
 
-	s.RolloutPercent = util.SafeUint(params.Body.RolloutPercent)
+	s.RolloutPercent = *params.Body.RolloutPercent
+	if err := validateRolloutPercent(params.FlagID, s.RolloutPercent); err != nil {
+		return segment.NewPutSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 	s.Description = util.SafeString(params.Body.Description)
+	if params.Body.BucketBy != nil {
+		if _, err := entity.ParseBucketBy(*params.Body.BucketBy); err != nil {
+			return segment.NewPutSegmentDefault(400).WithPayload(ErrorMessage("%s", err))
+		}
+		s.BucketBy = *params.Body.BucketBy
+	}
+	if params.Body.Prerequisites != nil {
+		s.Prerequisites = r2eMapPrerequisites(params.Body.Prerequisites)
+		if err := validateSegmentPrerequisites(params.FlagID, s.Prerequisites); err != nil {
+			return segment.NewPutSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+	}
+
+	if params.Body.AudienceIDs != nil {
+		if err := validateSegmentAudiences(params.Body.AudienceIDs); err != nil {
+			return segment.NewPutSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+	}
+	if params.Body.ConstraintGroups != nil {
+		s.ConstraintGroups = r2eMapConstraintGroups(params.Body.ConstraintGroups)
+		if err := s.ConstraintGroups.Validate(); err != nil {
+			return segment.NewPutSegmentDefault(400).WithPayload(ErrorMessage("%s", err))
+		}
+	}
+	if params.Body.DefaultVariantID != nil {
+		if err := validateDefaultVariant(params.FlagID, *params.Body.DefaultVariantID); err != nil {
+			return segment.NewPutSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+		s.DefaultVariantID = uint(*params.Body.DefaultVariantID)
+	}
 
-	if err := getDB().Save(s).Error; err != nil {
+	tx := getDB().Begin()
+	if err := tx.Omit("Audiences").Save(s).Error; err != nil {
+		tx.Rollback()
+		return segment.NewPutSegmentDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if params.Body.AudienceIDs != nil {
+		s.Audiences = r2eMapAudienceIDs(params.Body.AudienceIDs)
+		if err := tx.Model(s).Omit("Audiences.*").Association("Audiences").Replace(s.Audiences); err != nil {
+			tx.Rollback()
+			return segment.NewPutSegmentDefault(500).WithPayload(ErrorMessage("%s", err))
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
 		return segment.NewPutSegmentDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
 
@@ -639,6 +847,9 @@ func (c *crud) CreateVariant(params variant.CreateVariantParams) middleware.Resp
 	if err := v.Validate(); err != nil {
 		return variant.NewCreateVariantDefault(400).WithPayload(ErrorMessage("%s", err))
 	}
+	if err := validateVariantAttachment(params.FlagID, v.Attachment); err != nil {
+		return variant.NewCreateVariantDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 
 	if err := getDB().Create(v).Error; err != nil {
 		return variant.NewCreateVariantDefault(500).WithPayload(ErrorMessage("%s", err))
@@ -686,6 +897,9 @@ func (c *crud) PutVariant(params variant.PutVariantParams) middleware.Responder
 	if err := v.Validate(); err != nil {
 		return variant.NewPutVariantDefault(400).WithPayload(ErrorMessage("%s", err))
 	}
+	if err := validateVariantAttachment(params.FlagID, v.Attachment); err != nil {
+		return variant.NewPutVariantDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 
 	if err := getDB().Save(&v).Error; err != nil {
 		return variant.NewPutVariantDefault(500).WithPayload(ErrorMessage("%s", err))
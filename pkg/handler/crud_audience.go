@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/audience"
+	"gorm.io/gorm"
+)
+
+func (c *crud) CreateAudience(params audience.CreateAudienceParams) middleware.Responder {
+	a := &entity.Audience{}
+	a.Key = util.SafeString(params.Body.Key)
+	a.Description = params.Body.Description
+	a.Constraints = r2eMapConstraints(params.Body.Constraints)
+	a.ConstraintGroups = r2eMapConstraintGroups(params.Body.ConstraintGroups)
+	if err := a.Validate(); err != nil {
+		return audience.NewCreateAudienceDefault(400).WithPayload(
+			ErrorMessage("cannot create audience. %s", err))
+	}
+
+	if err := getDB().Create(a).Error; err != nil {
+		return audience.NewCreateAudienceDefault(500).WithPayload(
+			ErrorMessage("cannot create audience. %s", err))
+	}
+
+	resp := audience.NewCreateAudienceOK()
+	resp.SetPayload(e2r.MapAudience(a))
+	return resp
+}
+
+func (c *crud) FindAudiences(params audience.FindAudiencesParams) middleware.Responder {
+	tx := entity.PreloadAudienceConstraints(getDB())
+	as := []entity.Audience{}
+	q := entity.Audience{}
+
+	if params.Key != nil {
+		q.Key = *params.Key
+	}
+	if params.Offset != nil {
+		tx = tx.Offset(int(*params.Offset))
+	}
+	if params.Limit != nil {
+		tx = tx.Limit(int(*params.Limit))
+	}
+	if params.DescriptionLike != nil {
+		tx = tx.Where(
+			"lower(description) like ?",
+			fmt.Sprintf("%%%s%%", strings.ToLower(*params.DescriptionLike)),
+		)
+	}
+
+	if err := tx.Order("id").Where(q).Find(&as).Error; err != nil {
+		return audience.NewFindAudiencesDefault(500).WithPayload(
+			ErrorMessage("cannot query all audiences. %s", err))
+	}
+
+	resp := audience.NewFindAudiencesOK()
+	resp.SetPayload(e2r.MapAudiences(as))
+	return resp
+}
+
+func (c *crud) GetAudience(params audience.GetAudienceParams) middleware.Responder {
+	a := &entity.Audience{}
+	a.ID = util.SafeUint(params.AudienceID)
+	err := a.Preload(getDB())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return audience.NewGetAudienceDefault(404).WithPayload(
+			ErrorMessage("unable to find audience %v in the database", params.AudienceID))
+	}
+	if err != nil {
+		return audience.NewGetAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := audience.NewGetAudienceOK()
+	resp.SetPayload(e2r.MapAudience(a))
+	return resp
+}
+
+func (c *crud) PutAudience(params audience.PutAudienceParams) middleware.Responder {
+	a := &entity.Audience{}
+	a.ID = util.SafeUint(params.AudienceID)
+	if err := a.Preload(getDB()); err != nil {
+		return audience.NewPutAudienceDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	oldConstraints := a.Constraints
+	if params.Body.Key != nil {
+		a.Key = *params.Body.Key
+	}
+	if params.Body.Description != nil {
+		a.Description = *params.Body.Description
+	}
+	if params.Body.Constraints != nil {
+		a.Constraints = r2eMapConstraints(params.Body.Constraints)
+	}
+	if params.Body.ConstraintGroups != nil {
+		a.ConstraintGroups = r2eMapConstraintGroups(params.Body.ConstraintGroups)
+	}
+	if err := a.Validate(); err != nil {
+		return audience.NewPutAudienceDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+
+	tx := getDB().Begin()
+	if err := tx.Omit("Constraints").Save(a).Error; err != nil {
+		tx.Rollback()
+		return audience.NewPutAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if params.Body.Constraints != nil {
+		// the constraints are replaced as a whole
+		if len(oldConstraints) != 0 {
+			if err := tx.Delete(&oldConstraints).Error; err != nil {
+				tx.Rollback()
+				return audience.NewPutAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+			}
+		}
+		for i := range a.Constraints {
+			a.Constraints[i].AudienceID = a.ID
+			if err := tx.Create(&a.Constraints[i]).Error; err != nil {
+				tx.Rollback()
+				return audience.NewPutAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+			}
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return audience.NewPutAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	// the audience changes the evaluation of all the flags using it
+	fs, err := entity.FindFlagsByAudience(getDB(), a.ID)
+	if err != nil {
+		return audience.NewPutAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	for _, f := range fs {
+		entity.SaveFlagSnapshot(getDB(), f.ID, getSubjectFromRequest(params.HTTPRequest))
+	}
+
+	resp := audience.NewPutAudienceOK()
+	resp.SetPayload(e2r.MapAudience(a))
+	return resp
+}
+
+func (c *crud) DeleteAudience(params audience.DeleteAudienceParams) middleware.Responder {
+	audienceID := util.SafeUint(params.AudienceID)
+	fs, err := entity.FindFlagsByAudience(getDB(), audienceID)
+	if err != nil {
+		return audience.NewDeleteAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if len(fs) != 0 {
+		keys := make([]string, len(fs))
+		for i, f := range fs {
+			keys[i] = f.Key
+		}
+		return audience.NewDeleteAudienceDefault(400).WithPayload(
+			ErrorMessage("audience %v is used by flags %v", params.AudienceID, keys))
+	}
+
+	// hard deleted, so that the key can be used by a new audience. The references left are
+	// from the deleted segments.
+	tx := getDB().Begin()
+	if err := tx.Unscoped().Where(entity.Constraint{AudienceID: audienceID}).Delete(&entity.Constraint{}).Error; err != nil {
+		tx.Rollback()
+		return audience.NewDeleteAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := tx.Exec("DELETE FROM segments_audiences WHERE audience_id = ?", audienceID).Error; err != nil {
+		tx.Rollback()
+		return audience.NewDeleteAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := tx.Unscoped().Delete(&entity.Audience{}, audienceID).Error; err != nil {
+		tx.Rollback()
+		return audience.NewDeleteAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return audience.NewDeleteAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	return audience.NewDeleteAudienceOK()
+}
+
+func (c *crud) FindAudienceFlags(params audience.FindAudienceFlagsParams) middleware.Responder {
+	fs, err := entity.FindFlagsByAudience(entity.PreloadFlagTags(getDB()), util.SafeUint(params.AudienceID))
+	if err != nil {
+		return audience.NewFindAudienceFlagsDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	payload, err := e2rMapFlags(fs)
+	if err != nil {
+		return audience.NewFindAudienceFlagsDefault(500).WithPayload(
+			ErrorMessage("cannot map flags. %s", err))
+	}
+	resp := audience.NewFindAudienceFlagsOK()
+	resp.SetPayload(payload)
+	return resp
+}
@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/audience"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/segment"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudAudiences(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: util.StringPtr("funny flag"),
+			Key:         "funny_flag",
+		},
+	})
+
+	// step 1. it should be able to create an audience
+	res = c.CreateAudience(audience.CreateAudienceParams{
+		Body: &models.CreateAudienceRequest{
+			Key:         util.StringPtr("internal_employees"),
+			Description: "employees of the company",
+			Constraints: []*models.CreateConstraintRequest{
+				{
+					Property: util.StringPtr("email"),
+					Operator: util.StringPtr(models.ConstraintOperatorEREG),
+					Value:    util.StringPtr(`"@example.com$"`),
+				},
+			},
+		},
+	})
+	a := res.(*audience.CreateAudienceOK).Payload
+	assert.Equal(t, int64(1), a.ID)
+	assert.Len(t, a.Constraints, 1)
+
+	// step 2. it should be able to find and get the audience
+	res = c.FindAudiences(audience.FindAudiencesParams{Key: util.StringPtr("internal_employees")})
+	assert.Len(t, res.(*audience.FindAudiencesOK).Payload, 1)
+	res = c.FindAudiences(audience.FindAudiencesParams{DescriptionLike: util.StringPtr("EMPLOYEES")})
+	assert.Len(t, res.(*audience.FindAudiencesOK).Payload, 1)
+	res = c.GetAudience(audience.GetAudienceParams{AudienceID: int64(1)})
+	assert.Equal(t, "internal_employees", *res.(*audience.GetAudienceOK).Payload.Key)
+	assert.Len(t, res.(*audience.GetAudienceOK).Payload.Constraints, 1)
+
+	// step 3. it should be able to reference the audience from a segment
+	res = c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(1),
+		Body: &models.CreateSegmentRequest{
+			Description:    util.StringPtr("segment1"),
+			RolloutPercent: util.Float64Ptr(float64(100)),
+			AudienceIDs:    []int64{1},
+		},
+	})
+	assert.Equal(t, []int64{1}, res.(*segment.CreateSegmentOK).Payload.AudienceIDs)
+	res = c.FindSegments(segment.FindSegmentsParams{FlagID: int64(1)})
+	assert.Equal(t, []int64{1}, res.(*segment.FindSegmentsOK).Payload[0].AudienceIDs)
+
+	// step 4. it should be able to find the flags using the audience
+	res = c.FindAudienceFlags(audience.FindAudienceFlagsParams{AudienceID: int64(1)})
+	assert.Len(t, res.(*audience.FindAudienceFlagsOK).Payload, 1)
+	assert.Equal(t, "funny_flag", res.(*audience.FindAudienceFlagsOK).Payload[0].Key)
+
+	// step 5. it should be able to put the audience, and snapshot the flags using it
+	snapshotsBefore := int64(0)
+	db.Model(&entity.FlagSnapshot{}).Count(&snapshotsBefore)
+	res = c.PutAudience(audience.PutAudienceParams{
+		AudienceID: int64(1),
+		Body: &models.PutAudienceRequest{
+			Constraints: []*models.CreateConstraintRequest{
+				{
+					Property: util.StringPtr("email"),
+					Operator: util.StringPtr(models.ConstraintOperatorEREG),
+					Value:    util.StringPtr(`"@example.org$"`),
+				},
+				{
+					Property: util.StringPtr("active"),
+					Operator: util.StringPtr(models.ConstraintOperatorEQ),
+					Value:    util.StringPtr(`true`),
+				},
+			},
+		},
+	})
+	assert.Len(t, res.(*audience.PutAudienceOK).Payload.Constraints, 2)
+	assert.Equal(t, "internal_employees", *res.(*audience.PutAudienceOK).Payload.Key)
+	res = c.GetAudience(audience.GetAudienceParams{AudienceID: int64(1)})
+	assert.Len(t, res.(*audience.GetAudienceOK).Payload.Constraints, 2)
+	snapshotsAfter := int64(0)
+	db.Model(&entity.FlagSnapshot{}).Count(&snapshotsAfter)
+	assert.Equal(t, snapshotsBefore+1, snapshotsAfter)
+
+	// step 6. it should not be able to delete the audience in use
+	res = c.DeleteAudience(audience.DeleteAudienceParams{AudienceID: int64(1)})
+	assert.NotZero(t, res.(*audience.DeleteAudienceDefault).Payload)
+
+	// step 7. it should be able to delete the audience once it's not used
+	res = c.PutSegment(segment.PutSegmentParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.PutSegmentRequest{
+			Description:    util.StringPtr("segment1"),
+			RolloutPercent: util.Float64Ptr(float64(100)),
+			AudienceIDs:    []int64{},
+		},
+	})
+	assert.Empty(t, res.(*segment.PutSegmentOK).Payload.AudienceIDs)
+	res = c.DeleteAudience(audience.DeleteAudienceParams{AudienceID: int64(1)})
+	assert.NotZero(t, res.(*audience.DeleteAudienceOK))
+	res = c.GetAudience(audience.GetAudienceParams{AudienceID: int64(1)})
+	assert.NotZero(t, res.(*audience.GetAudienceDefault).Payload)
+
+	// step 8. it should be able to create an audience with the key of the deleted one
+	res = c.CreateAudience(audience.CreateAudienceParams{
+		Body: &models.CreateAudienceRequest{Key: util.StringPtr("internal_employees")},
+	})
+	assert.Equal(t, "internal_employees", *res.(*audience.CreateAudienceOK).Payload.Key)
+}
+
+func TestCrudAudiencesWithFailures(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: util.StringPtr("funny flag"),
+		},
+	})
+
+	t.Run("CreateAudience - invalid key", func(t *testing.T) {
+		res = c.CreateAudience(audience.CreateAudienceParams{
+			Body: &models.CreateAudienceRequest{Key: util.StringPtr("#invalid key")},
+		})
+		assert.NotZero(t, res.(*audience.CreateAudienceDefault).Payload)
+	})
+
+	t.Run("CreateAudience - invalid constraint", func(t *testing.T) {
+		res = c.CreateAudience(audience.CreateAudienceParams{
+			Body: &models.CreateAudienceRequest{
+				Key: util.StringPtr("eu_users"),
+				Constraints: []*models.CreateConstraintRequest{
+					{
+						Property: util.StringPtr("country"),
+						Operator: util.StringPtr("UNKNOWN"),
+						Value:    util.StringPtr(`"DE"`),
+					},
+				},
+			},
+		})
+		assert.NotZero(t, res.(*audience.CreateAudienceDefault).Payload)
+	})
+
+	t.Run("PutAudience - non-existing audience", func(t *testing.T) {
+		res = c.PutAudience(audience.PutAudienceParams{
+			AudienceID: int64(999),
+			Body:       &models.PutAudienceRequest{Description: util.StringPtr("a")},
+		})
+		assert.NotZero(t, res.(*audience.PutAudienceDefault).Payload)
+	})
+
+	t.Run("CreateSegment - non-existing audience", func(t *testing.T) {
+		res = c.CreateSegment(segment.CreateSegmentParams{
+			FlagID: int64(1),
+			Body: &models.CreateSegmentRequest{
+				Description:    util.StringPtr("segment1"),
+				RolloutPercent: util.Float64Ptr(float64(100)),
+				AudienceIDs:    []int64{999},
+			},
+		})
+		assert.NotZero(t, res.(*segment.CreateSegmentDefault).Payload)
+	})
+}
@@ -66,7 +66,7 @@ func LoadSimpleBooleanFlagTemplate(flag *entity.Flag, tx *gorm.DB) error {
 	// Create our default segment
 	s := &entity.Segment{}
 	s.FlagID = flag.ID
-	s.RolloutPercent = uint(100)
+	s.RolloutPercent = float64(100)
 	s.Rank = entity.SegmentDefaultRank
 
 	if err := tx.Create(s).Error; err != nil {
@@ -87,7 +87,7 @@ func LoadSimpleBooleanFlagTemplate(flag *entity.Flag, tx *gorm.DB) error {
 	d.SegmentID = s.ID
 	d.VariantID = v.ID
 	d.VariantKey = v.Key
-	d.Percent = uint(100)
+	d.Percent = float64(100)
 
 	if err := tx.Create(d).Error; err != nil {
 		return err
@@ -70,7 +70,7 @@ func TestCrudCreateFlag(t *testing.T) {
 		distribution := entity.Distribution{VariantID: variant.ID}
 		db.First(&distribution)
 		assert.NotZero(t, distribution.ID)
-		assert.Equal(t, distribution.Percent, uint(100))
+		assert.Equal(t, distribution.Percent, float64(100))
 		assert.Equal(t, distribution.SegmentID, segment.ID)
 		assert.Equal(t, distribution.VariantKey, variant.Key)
 	})
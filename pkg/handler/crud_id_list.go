@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/id_list"
+	"gorm.io/gorm"
+)
+
+func (c *crud) CreateIDList(params id_list.CreateIDListParams) middleware.Responder {
+	l := &entity.IDList{}
+	l.Key = util.SafeString(params.Body.Key)
+	l.Description = params.Body.Description
+	if err := l.Validate(); err != nil {
+		return id_list.NewCreateIDListDefault(400).WithPayload(
+			ErrorMessage("cannot create ID list. %s", err))
+	}
+
+	if err := getDB().Create(l).Error; err != nil {
+		return id_list.NewCreateIDListDefault(500).WithPayload(
+			ErrorMessage("cannot create ID list. %s", err))
+	}
+
+	resp := id_list.NewCreateIDListOK()
+	resp.SetPayload(e2r.MapIDList(l))
+	return resp
+}
+
+func (c *crud) FindIDLists(params id_list.FindIDListsParams) middleware.Responder {
+	tx := getDB()
+	ls := []entity.IDList{}
+	q := entity.IDList{}
+
+	if params.Key != nil {
+		q.Key = *params.Key
+	}
+	if params.Offset != nil {
+		tx = tx.Offset(int(*params.Offset))
+	}
+	if params.Limit != nil {
+		tx = tx.Limit(int(*params.Limit))
+	}
+
+	if err := tx.Order("id").Where(q).Find(&ls).Error; err != nil {
+		return id_list.NewFindIDListsDefault(500).WithPayload(
+			ErrorMessage("cannot query all ID lists. %s", err))
+	}
+	if err := entity.LoadIDListEntryCounts(getDB(), ls); err != nil {
+		return id_list.NewFindIDListsDefault(500).WithPayload(
+			ErrorMessage("cannot count the entries of ID lists. %s", err))
+	}
+
+	resp := id_list.NewFindIDListsOK()
+	resp.SetPayload(e2r.MapIDLists(ls))
+	return resp
+}
+
+func (c *crud) GetIDList(params id_list.GetIDListParams) middleware.Responder {
+	l, err := loadIDList(params.IDListID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return id_list.NewGetIDListDefault(404).WithPayload(
+			ErrorMessage("unable to find ID list %v in the database", params.IDListID))
+	}
+	if err != nil {
+		return id_list.NewGetIDListDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := id_list.NewGetIDListOK()
+	resp.SetPayload(e2r.MapIDList(l))
+	return resp
+}
+
+func (c *crud) DeleteIDList(params id_list.DeleteIDListParams) middleware.Responder {
+	l := &entity.IDList{}
+	if err := getDB().First(l, params.IDListID).Error; err != nil {
+		return id_list.NewDeleteIDListDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	referenced, err := entity.IsIDListReferenced(getDB(), l.Key)
+	if err != nil {
+		return id_list.NewDeleteIDListDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if referenced {
+		return id_list.NewDeleteIDListDefault(400).WithPayload(
+			ErrorMessage("ID list %s is referenced by constraints", l.Key))
+	}
+
+	tx := getDB().Begin()
+	if err := tx.Where("id_list_id = ?", l.ID).Delete(&entity.IDListEntry{}).Error; err != nil {
+		tx.Rollback()
+		return id_list.NewDeleteIDListDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	// hard deleted, so that the key can be used by a new ID list
+	if err := tx.Unscoped().Delete(l).Error; err != nil {
+		tx.Rollback()
+		return id_list.NewDeleteIDListDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return id_list.NewDeleteIDListDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	return id_list.NewDeleteIDListOK()
+}
+
+func (c *crud) ReplaceIDListEntries(params id_list.ReplaceIDListEntriesParams) middleware.Responder {
+	l, code, err := updateIDListEntries(params.IDListID, params.Body, entity.ReplaceIDListEntries)
+	if err != nil {
+		return id_list.NewReplaceIDListEntriesDefault(code).WithPayload(ErrorMessage("%s", err))
+	}
+	resp := id_list.NewReplaceIDListEntriesOK()
+	resp.SetPayload(e2r.MapIDList(l))
+	return resp
+}
+
+func (c *crud) AppendIDListEntries(params id_list.AppendIDListEntriesParams) middleware.Responder {
+	l, code, err := updateIDListEntries(params.IDListID, params.Body, entity.AppendIDListEntries)
+	if err != nil {
+		return id_list.NewAppendIDListEntriesDefault(code).WithPayload(ErrorMessage("%s", err))
+	}
+	resp := id_list.NewAppendIDListEntriesOK()
+	resp.SetPayload(e2r.MapIDList(l))
+	return resp
+}
+
+func (c *crud) RemoveIDListEntries(params id_list.RemoveIDListEntriesParams) middleware.Responder {
+	l, code, err := updateIDListEntries(params.IDListID, params.Body, entity.RemoveIDListEntries)
+	if err != nil {
+		return id_list.NewRemoveIDListEntriesDefault(code).WithPayload(ErrorMessage("%s", err))
+	}
+	resp := id_list.NewRemoveIDListEntriesOK()
+	resp.SetPayload(e2r.MapIDList(l))
+	return resp
+}
+
+// updateIDListEntries parses the body of the entries and applies the update to
+// the ID list in a transaction. It returns the status code if there's an error.
+func updateIDListEntries(
+	idListID int64,
+	body string,
+	update func(db *gorm.DB, idListID uint, values []string) error,
+) (*entity.IDList, int, error) {
+	l := &entity.IDList{}
+	if err := getDB().First(l, idListID).Error; err != nil {
+		return nil, 404, err
+	}
+	values, err := entity.ParseIDListEntries(body)
+	if err != nil {
+		return nil, 400, err
+	}
+
+	tx := getDB().Begin()
+	if err := update(tx, l.ID, values); err != nil {
+		tx.Rollback()
+		return nil, 500, err
+	}
+	// touch the ID list to bump its UpdatedAt
+	if err := tx.Save(l).Error; err != nil {
+		tx.Rollback()
+		return nil, 500, err
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return nil, 500, err
+	}
+
+	l, err = loadIDList(idListID)
+	if err != nil {
+		return nil, 500, err
+	}
+	return l, 0, nil
+}
+
+func loadIDList(idListID int64) (*entity.IDList, error) {
+	ls := []entity.IDList{}
+	if err := getDB().Where("id = ?", idListID).Limit(1).Find(&ls).Error; err != nil {
+		return nil, err
+	}
+	if len(ls) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if err := entity.LoadIDListEntryCounts(getDB(), ls); err != nil {
+		return nil, err
+	}
+	return &ls[0], nil
+}
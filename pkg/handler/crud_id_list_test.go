@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/constraint"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/id_list"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudIDLists(t *testing.T) {
+	var res middleware.Responder
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	// step 1. it should be able to create an ID list
+	res = c.CreateIDList(id_list.CreateIDListParams{
+		Body: &models.CreateIDListRequest{
+			Key:         util.StringPtr("beta_users"),
+			Description: "users of the beta program",
+		},
+	})
+	l := res.(*id_list.CreateIDListOK).Payload
+	assert.Equal(t, int64(1), l.ID)
+	assert.Equal(t, int64(0), l.EntryCount)
+
+	// step 2. it should be able to upload, append and remove the entries
+	res = c.ReplaceIDListEntries(id_list.ReplaceIDListEntriesParams{IDListID: 1, Body: "u1\nu2\nu3\n"})
+	assert.Equal(t, int64(3), res.(*id_list.ReplaceIDListEntriesOK).Payload.EntryCount)
+	res = c.AppendIDListEntries(id_list.AppendIDListEntriesParams{IDListID: 1, Body: "u3,u4,u5"})
+	assert.Equal(t, int64(5), res.(*id_list.AppendIDListEntriesOK).Payload.EntryCount)
+	res = c.RemoveIDListEntries(id_list.RemoveIDListEntriesParams{IDListID: 1, Body: "u1\nu5"})
+	assert.Equal(t, int64(3), res.(*id_list.RemoveIDListEntriesOK).Payload.EntryCount)
+	res = c.ReplaceIDListEntries(id_list.ReplaceIDListEntriesParams{IDListID: 1, Body: "u8,u9"})
+	assert.Equal(t, int64(2), res.(*id_list.ReplaceIDListEntriesOK).Payload.EntryCount)
+
+	// step 3. it should be able to find and get the ID list
+	res = c.FindIDLists(id_list.FindIDListsParams{Key: util.StringPtr("beta_users")})
+	assert.Len(t, res.(*id_list.FindIDListsOK).Payload, 1)
+	assert.Equal(t, int64(2), res.(*id_list.FindIDListsOK).Payload[0].EntryCount)
+	res = c.GetIDList(id_list.GetIDListParams{IDListID: 1})
+	assert.Equal(t, "beta_users", *res.(*id_list.GetIDListOK).Payload.Key)
+
+	// step 4. it should not be able to delete the ID list referenced by constraints
+	res = c.CreateConstraint(constraint.CreateConstraintParams{
+		FlagID:    100,
+		SegmentID: 200,
+		Body: &models.CreateConstraintRequest{
+			Property: util.StringPtr("user_id"),
+			Operator: util.StringPtr(models.ConstraintOperatorINLIST),
+			Value:    util.StringPtr(`"beta_users"`),
+		},
+	})
+	cons := res.(*constraint.CreateConstraintOK).Payload
+	res = c.DeleteIDList(id_list.DeleteIDListParams{IDListID: 1})
+	assert.NotZero(t, res.(*id_list.DeleteIDListDefault).Payload)
+
+	// step 5. it should be able to delete the ID list once it's not referenced
+	c.DeleteConstraint(constraint.DeleteConstraintParams{FlagID: 100, SegmentID: 200, ConstraintID: cons.ID})
+	res = c.DeleteIDList(id_list.DeleteIDListParams{IDListID: 1})
+	assert.NotZero(t, res.(*id_list.DeleteIDListOK))
+	res = c.GetIDList(id_list.GetIDListParams{IDListID: 1})
+	assert.NotZero(t, res.(*id_list.GetIDListDefault).Payload)
+	count := int64(0)
+	db.Model(&entity.IDListEntry{}).Count(&count)
+	assert.Zero(t, count)
+
+	// step 6. it should be able to create an ID list with the key of the deleted one
+	res = c.CreateIDList(id_list.CreateIDListParams{
+		Body: &models.CreateIDListRequest{Key: util.StringPtr("beta_users")},
+	})
+	assert.Equal(t, "beta_users", *res.(*id_list.CreateIDListOK).Payload.Key)
+}
+
+func TestCrudIDListsWithFailures(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateIDList(id_list.CreateIDListParams{
+		Body: &models.CreateIDListRequest{Key: util.StringPtr("beta_users")},
+	})
+
+	t.Run("CreateIDList - invalid key", func(t *testing.T) {
+		res = c.CreateIDList(id_list.CreateIDListParams{
+			Body: &models.CreateIDListRequest{Key: util.StringPtr("#invalid key")},
+		})
+		assert.NotZero(t, res.(*id_list.CreateIDListDefault).Payload)
+	})
+
+	t.Run("CreateIDList - duplicated key", func(t *testing.T) {
+		res = c.CreateIDList(id_list.CreateIDListParams{
+			Body: &models.CreateIDListRequest{Key: util.StringPtr("beta_users")},
+		})
+		assert.NotZero(t, res.(*id_list.CreateIDListDefault).Payload)
+	})
+
+	t.Run("AppendIDListEntries - non-existing ID list", func(t *testing.T) {
+		res = c.AppendIDListEntries(id_list.AppendIDListEntriesParams{IDListID: 999, Body: "u1"})
+		assert.NotZero(t, res.(*id_list.AppendIDListEntriesDefault).Payload)
+	})
+
+	t.Run("AppendIDListEntries - invalid CSV", func(t *testing.T) {
+		res = c.AppendIDListEntries(id_list.AppendIDListEntriesParams{IDListID: 1, Body: "u1,\"u2"})
+		assert.NotZero(t, res.(*id_list.AppendIDListEntriesDefault).Payload)
+	})
+
+	t.Run("DeleteIDList - non-existing ID list", func(t *testing.T) {
+		res = c.DeleteIDList(id_list.DeleteIDListParams{IDListID: 999})
+		assert.NotZero(t, res.(*id_list.DeleteIDListDefault).Payload)
+	})
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/openflagr/flagr/pkg/mapper/entity_restapi/r2e"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/rollout_ramp"
+	"gorm.io/gorm"
+)
+
+func (c *crud) CreateRolloutRamp(params rollout_ramp.CreateRolloutRampParams) middleware.Responder {
+	r := &entity.RolloutRamp{
+		FlagID:       util.SafeUint(params.FlagID),
+		SegmentID:    util.SafeUint(params.SegmentID),
+		Steps:        r2e.MapRolloutRampSteps(params.Body.Steps),
+		StepInterval: time.Duration(*params.Body.StepIntervalSeconds) * time.Second,
+		NextStepAt:   entity.Now(),
+		Status:       models.RolloutRampStatusACTIVE,
+		CreatedBy:    getSubjectFromRequest(params.HTTPRequest),
+	}
+	if !time.Time(params.Body.StartAt).IsZero() {
+		r.NextStepAt = time.Time(params.Body.StartAt)
+	}
+	if err := validateRolloutRamp(r); err != nil {
+		return rollout_ramp.NewCreateRolloutRampDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	var count int64
+	err := getDB().
+		Model(&entity.RolloutRamp{}).
+		Where("segment_id = ? AND status IN (?)", r.SegmentID, []string{models.RolloutRampStatusACTIVE, models.RolloutRampStatusPAUSED}).
+		Count(&count).
+		Error
+	if err != nil {
+		return rollout_ramp.NewCreateRolloutRampDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if count > 0 {
+		return rollout_ramp.NewCreateRolloutRampDefault(400).WithPayload(
+			ErrorMessage("segment %v already has an active or paused rollout ramp", r.SegmentID))
+	}
+
+	if err := getDB().Create(r).Error; err != nil {
+		return rollout_ramp.NewCreateRolloutRampDefault(500).WithPayload(
+			ErrorMessage("cannot create rollout ramp. %s", err))
+	}
+
+	resp := rollout_ramp.NewCreateRolloutRampOK()
+	resp.SetPayload(e2r.MapRolloutRamp(r))
+	return resp
+}
+
+func (c *crud) FindRolloutRamps(params rollout_ramp.FindRolloutRampsParams) middleware.Responder {
+	rs := []entity.RolloutRamp{}
+	q := entity.RolloutRamp{FlagID: util.SafeUint(params.FlagID), SegmentID: util.SafeUint(params.SegmentID)}
+	if err := getDB().Where(q).Order("id").Find(&rs).Error; err != nil {
+		return rollout_ramp.NewFindRolloutRampsDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := rollout_ramp.NewFindRolloutRampsOK()
+	resp.SetPayload(e2r.MapRolloutRamps(rs))
+	return resp
+}
+
+func (c *crud) PauseRolloutRamp(params rollout_ramp.PauseRolloutRampParams) middleware.Responder {
+	r, code, err := updateRolloutRamp(
+		params.FlagID, params.SegmentID, params.RolloutRampID, "paused",
+		[]string{models.RolloutRampStatusACTIVE},
+		func(r *entity.RolloutRamp) map[string]interface{} {
+			remaining := r.NextStepAt.Sub(entity.Now())
+			if remaining < 0 {
+				remaining = 0
+			}
+			return map[string]interface{}{
+				"status":           models.RolloutRampStatusPAUSED,
+				"paused_remaining": remaining,
+			}
+		},
+	)
+	if err != nil {
+		return rollout_ramp.NewPauseRolloutRampDefault(code).WithPayload(ErrorMessage("%s", err))
+	}
+	resp := rollout_ramp.NewPauseRolloutRampOK()
+	resp.SetPayload(e2r.MapRolloutRamp(r))
+	return resp
+}
+
+func (c *crud) ResumeRolloutRamp(params rollout_ramp.ResumeRolloutRampParams) middleware.Responder {
+	r, code, err := updateRolloutRamp(
+		params.FlagID, params.SegmentID, params.RolloutRampID, "resumed",
+		[]string{models.RolloutRampStatusPAUSED},
+		func(r *entity.RolloutRamp) map[string]interface{} {
+			return map[string]interface{}{
+				"status":           models.RolloutRampStatusACTIVE,
+				"next_step_at":     entity.Now().Add(r.PausedRemaining),
+				"paused_remaining": 0,
+			}
+		},
+	)
+	if err != nil {
+		return rollout_ramp.NewResumeRolloutRampDefault(code).WithPayload(ErrorMessage("%s", err))
+	}
+	resp := rollout_ramp.NewResumeRolloutRampOK()
+	resp.SetPayload(e2r.MapRolloutRamp(r))
+	return resp
+}
+
+func (c *crud) AbortRolloutRamp(params rollout_ramp.AbortRolloutRampParams) middleware.Responder {
+	r, code, err := updateRolloutRamp(
+		params.FlagID, params.SegmentID, params.RolloutRampID, "aborted",
+		[]string{models.RolloutRampStatusACTIVE, models.RolloutRampStatusPAUSED},
+		func(r *entity.RolloutRamp) map[string]interface{} {
+			return map[string]interface{}{"status": models.RolloutRampStatusABORTED}
+		},
+	)
+	if err != nil {
+		return rollout_ramp.NewAbortRolloutRampDefault(code).WithPayload(ErrorMessage("%s", err))
+	}
+	resp := rollout_ramp.NewAbortRolloutRampOK()
+	resp.SetPayload(e2r.MapRolloutRamp(r))
+	return resp
+}
+
+// updateRolloutRamp updates the rollout ramp in one of the from statuses. The update is
+// conditional on the status and the step loaded, so that it cannot race with the scheduler
+// advancing the ramp. It returns the status code if there's an error.
+func updateRolloutRamp(
+	flagID, segmentID, rolloutRampID int64,
+	action string,
+	from []string,
+	updates func(r *entity.RolloutRamp) map[string]interface{},
+) (*entity.RolloutRamp, int, error) {
+	r := &entity.RolloutRamp{}
+	err := getDB().
+		Where("id = ? AND flag_id = ? AND segment_id = ?", rolloutRampID, flagID, segmentID).
+		First(r).
+		Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, 404, fmt.Errorf("unable to find rollout ramp %v of segment %v", rolloutRampID, segmentID)
+	}
+	if err != nil {
+		return nil, 500, err
+	}
+	if !slices.Contains(from, r.Status) {
+		return nil, 400, fmt.Errorf("rollout ramp %v is %s, it cannot be %s", r.ID, r.Status, action)
+	}
+
+	res := getDB().
+		Model(&entity.RolloutRamp{}).
+		Where("id = ? AND status = ? AND current_step = ?", r.ID, r.Status, r.CurrentStep).
+		Updates(updates(r))
+	if res.Error != nil {
+		return nil, 500, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, 409, fmt.Errorf("rollout ramp %v was changed by the scheduler, please retry", r.ID)
+	}
+
+	if err := getDB().First(r, r.ID).Error; err != nil {
+		return nil, 500, err
+	}
+	return r, 0, nil
+}
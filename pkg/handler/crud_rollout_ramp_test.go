@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/rollout_ramp"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func rolloutRampSteps(percents ...float64) []*float64 {
+	steps := make([]*float64, len(percents))
+	for i, p := range percents {
+		steps[i] = util.Float64Ptr(p)
+	}
+	return steps
+}
+
+func TestCrudRolloutRamps(t *testing.T) {
+	var res middleware.Responder
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	now := time.Date(2025, 11, 27, 12, 0, 0, 0, time.UTC)
+	stubs := gostub.StubFunc(&entity.Now, now)
+	defer stubs.Reset()
+
+	// step 1. it should be able to create a rollout ramp starting right away
+	res = c.CreateRolloutRamp(rollout_ramp.CreateRolloutRampParams{
+		FlagID:    100,
+		SegmentID: 200,
+		Body: &models.CreateRolloutRampRequest{
+			Steps:               rolloutRampSteps(1, 5, 25, 50, 100),
+			StepIntervalSeconds: util.Int64Ptr(86400),
+		},
+	})
+	r := res.(*rollout_ramp.CreateRolloutRampOK).Payload
+	assert.Equal(t, int64(1), r.ID)
+	assert.Equal(t, models.RolloutRampStatusACTIVE, *r.Status)
+	assert.Equal(t, []float64{1, 5, 25, 50, 100}, r.Steps)
+	assert.Equal(t, int64(86400), *r.StepIntervalSeconds)
+	assert.Equal(t, int64(0), *r.CurrentStep)
+	assert.Equal(t, now, time.Time(*r.NextStepAt))
+
+	// step 2. it should reject another ramp of the same segment
+	res = c.CreateRolloutRamp(rollout_ramp.CreateRolloutRampParams{
+		FlagID:    100,
+		SegmentID: 200,
+		Body: &models.CreateRolloutRampRequest{
+			Steps:               rolloutRampSteps(50, 100),
+			StepIntervalSeconds: util.Int64Ptr(3600),
+		},
+	})
+	assert.NotZero(t, res.(*rollout_ramp.CreateRolloutRampDefault).Payload)
+
+	// step 3. it should be able to pause the ramp and keep the time left until the next step
+	stubs.StubFunc(&entity.Now, now.Add(-time.Hour))
+	res = c.PauseRolloutRamp(rollout_ramp.PauseRolloutRampParams{FlagID: 100, SegmentID: 200, RolloutRampID: 1})
+	r = res.(*rollout_ramp.PauseRolloutRampOK).Payload
+	assert.Equal(t, models.RolloutRampStatusPAUSED, *r.Status)
+	assert.Nil(t, r.NextStepAt)
+
+	res = c.PauseRolloutRamp(rollout_ramp.PauseRolloutRampParams{FlagID: 100, SegmentID: 200, RolloutRampID: 1})
+	assert.NotZero(t, res.(*rollout_ramp.PauseRolloutRampDefault).Payload)
+
+	// step 4. it should be able to resume the ramp with the time left
+	stubs.StubFunc(&entity.Now, now.Add(24*time.Hour))
+	res = c.ResumeRolloutRamp(rollout_ramp.ResumeRolloutRampParams{FlagID: 100, SegmentID: 200, RolloutRampID: 1})
+	r = res.(*rollout_ramp.ResumeRolloutRampOK).Payload
+	assert.Equal(t, models.RolloutRampStatusACTIVE, *r.Status)
+	assert.Equal(t, now.Add(25*time.Hour), time.Time(*r.NextStepAt).UTC())
+
+	// step 5. it should be able to abort the ramp, and then create a new one
+	res = c.AbortRolloutRamp(rollout_ramp.AbortRolloutRampParams{FlagID: 100, SegmentID: 200, RolloutRampID: 1})
+	r = res.(*rollout_ramp.AbortRolloutRampOK).Payload
+	assert.Equal(t, models.RolloutRampStatusABORTED, *r.Status)
+
+	res = c.ResumeRolloutRamp(rollout_ramp.ResumeRolloutRampParams{FlagID: 100, SegmentID: 200, RolloutRampID: 1})
+	assert.NotZero(t, res.(*rollout_ramp.ResumeRolloutRampDefault).Payload)
+
+	startAt := strfmt.DateTime(now.Add(48 * time.Hour))
+	res = c.CreateRolloutRamp(rollout_ramp.CreateRolloutRampParams{
+		FlagID:    100,
+		SegmentID: 200,
+		Body: &models.CreateRolloutRampRequest{
+			Steps:               rolloutRampSteps(50, 100),
+			StepIntervalSeconds: util.Int64Ptr(3600),
+			StartAt:             startAt,
+		},
+	})
+	r = res.(*rollout_ramp.CreateRolloutRampOK).Payload
+	assert.Equal(t, time.Time(startAt), time.Time(*r.NextStepAt))
+
+	// step 6. it should be able to find the ramps of the segment
+	res = c.FindRolloutRamps(rollout_ramp.FindRolloutRampsParams{FlagID: 100, SegmentID: 200})
+	assert.Len(t, res.(*rollout_ramp.FindRolloutRampsOK).Payload, 2)
+
+	res = c.FindRolloutRamps(rollout_ramp.FindRolloutRampsParams{FlagID: 100, SegmentID: 999})
+	assert.Len(t, res.(*rollout_ramp.FindRolloutRampsOK).Payload, 0)
+}
+
+func TestCrudRolloutRampsWithFailures(t *testing.T) {
+	var res middleware.Responder
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	for name, body := range map[string]*models.CreateRolloutRampRequest{
+		"decreasing steps": {
+			Steps:               rolloutRampSteps(50, 25),
+			StepIntervalSeconds: util.Int64Ptr(3600),
+		},
+		"step finer than the hash algorithm": {
+			Steps:               rolloutRampSteps(0.05, 100),
+			StepIntervalSeconds: util.Int64Ptr(3600),
+		},
+		"no step interval": {
+			Steps:               rolloutRampSteps(50, 100),
+			StepIntervalSeconds: util.Int64Ptr(0),
+		},
+	} {
+		t.Run("CreateRolloutRamp - "+name, func(t *testing.T) {
+			res = c.CreateRolloutRamp(rollout_ramp.CreateRolloutRampParams{FlagID: 100, SegmentID: 200, Body: body})
+			assert.NotZero(t, res.(*rollout_ramp.CreateRolloutRampDefault).Payload)
+		})
+	}
+
+	t.Run("CreateRolloutRamp - segment of another flag", func(t *testing.T) {
+		res = c.CreateRolloutRamp(rollout_ramp.CreateRolloutRampParams{
+			FlagID:    999,
+			SegmentID: 200,
+			Body: &models.CreateRolloutRampRequest{
+				Steps:               rolloutRampSteps(50, 100),
+				StepIntervalSeconds: util.Int64Ptr(3600),
+			},
+		})
+		assert.NotZero(t, res.(*rollout_ramp.CreateRolloutRampDefault).Payload)
+	})
+
+	t.Run("AbortRolloutRamp - non-existing ramp", func(t *testing.T) {
+		res = c.AbortRolloutRamp(rollout_ramp.AbortRolloutRampParams{FlagID: 100, SegmentID: 200, RolloutRampID: 999})
+		assert.NotZero(t, res.(*rollout_ramp.AbortRolloutRampDefault).Payload)
+	})
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/openflagr/flagr/pkg/mapper/entity_restapi/r2e"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/scheduled_change"
+	"gorm.io/gorm"
+)
+
+func (c *crud) CreateScheduledChange(params scheduled_change.CreateScheduledChangeParams) middleware.Responder {
+	sc := &entity.ScheduledChange{
+		FlagID:        util.SafeUint(params.FlagID),
+		ExecuteAt:     time.Time(*params.Body.ExecuteAt),
+		Status:        models.ScheduledChangeStatusPENDING,
+		Action:        util.SafeString(params.Body.Action),
+		SegmentID:     uint(params.Body.SegmentID),
+		Distributions: r2e.MapScheduledDistributions(params.Body.Distributions),
+		CreatedBy:     getSubjectFromRequest(params.HTTPRequest),
+	}
+
+	if !sc.ExecuteAt.After(entity.Now()) {
+		return scheduled_change.NewCreateScheduledChangeDefault(400).WithPayload(
+			ErrorMessage("executeAt %s is not in the future", sc.ExecuteAt.UTC().Format(time.RFC3339)))
+	}
+	if sc.Action == models.ScheduledChangeActionSETROLLOUTPERCENT {
+		if params.Body.RolloutPercent == nil {
+			return scheduled_change.NewCreateScheduledChangeDefault(400).WithPayload(
+				ErrorMessage("rolloutPercent is required for %s", sc.Action))
+		}
+		sc.RolloutPercent = *params.Body.RolloutPercent
+	}
+	if err := validateScheduledChange(sc); err != nil {
+		return scheduled_change.NewCreateScheduledChangeDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if err := getDB().Create(sc).Error; err != nil {
+		return scheduled_change.NewCreateScheduledChangeDefault(500).WithPayload(
+			ErrorMessage("cannot create scheduled change. %s", err))
+	}
+
+	resp := scheduled_change.NewCreateScheduledChangeOK()
+	resp.SetPayload(e2r.MapScheduledChange(sc))
+	return resp
+}
+
+func (c *crud) FindScheduledChanges(params scheduled_change.FindScheduledChangesParams) middleware.Responder {
+	q := entity.ScheduledChange{FlagID: util.SafeUint(params.FlagID)}
+	if params.Status != nil {
+		q.Status = *params.Status
+	}
+
+	scs := []entity.ScheduledChange{}
+	if err := getDB().Where(q).Order("execute_at, id").Find(&scs).Error; err != nil {
+		return scheduled_change.NewFindScheduledChangesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := scheduled_change.NewFindScheduledChangesOK()
+	resp.SetPayload(e2r.MapScheduledChanges(scs))
+	return resp
+}
+
+func (c *crud) CancelScheduledChange(params scheduled_change.CancelScheduledChangeParams) middleware.Responder {
+	// the status is checked in the update, so that it cannot cancel a change being applied by the scheduler
+	res := getDB().
+		Model(&entity.ScheduledChange{}).
+		Where("id = ? AND flag_id = ? AND status = ?", params.ScheduledChangeID, params.FlagID, models.ScheduledChangeStatusPENDING).
+		Update("status", models.ScheduledChangeStatusCANCELED)
+	if res.Error != nil {
+		return scheduled_change.NewCancelScheduledChangeDefault(500).WithPayload(ErrorMessage("%s", res.Error))
+	}
+
+	sc := &entity.ScheduledChange{}
+	err := getDB().Where("id = ? AND flag_id = ?", params.ScheduledChangeID, params.FlagID).First(sc).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return scheduled_change.NewCancelScheduledChangeDefault(404).WithPayload(
+			ErrorMessage("unable to find scheduled change %v of flag %v", params.ScheduledChangeID, params.FlagID))
+	}
+	if err != nil {
+		return scheduled_change.NewCancelScheduledChangeDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if res.RowsAffected == 0 {
+		return scheduled_change.NewCancelScheduledChangeDefault(400).WithPayload(
+			ErrorMessage("scheduled change %v is %s, only a pending one can be canceled", sc.ID, sc.Status))
+	}
+
+	resp := scheduled_change.NewCancelScheduledChangeOK()
+	resp.SetPayload(e2r.MapScheduledChange(sc))
+	return resp
+}
@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/scheduled_change"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudScheduledChanges(t *testing.T) {
+	var res middleware.Responder
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	now := time.Date(2025, 11, 27, 12, 0, 0, 0, time.UTC)
+	defer gostub.StubFunc(&entity.Now, now).Reset()
+	midnight := strfmt.DateTime(time.Date(2025, 11, 28, 0, 0, 0, 0, time.UTC))
+
+	// step 1. it should be able to schedule the changes
+	res = c.CreateScheduledChange(scheduled_change.CreateScheduledChangeParams{
+		FlagID: 100,
+		Body: &models.CreateScheduledChangeRequest{
+			ExecuteAt:      &midnight,
+			Action:         util.StringPtr(models.ScheduledChangeActionSETROLLOUTPERCENT),
+			SegmentID:      200,
+			RolloutPercent: util.Float64Ptr(50),
+		},
+	})
+	sc := res.(*scheduled_change.CreateScheduledChangeOK).Payload
+	assert.Equal(t, int64(1), sc.ID)
+	assert.Equal(t, models.ScheduledChangeStatusPENDING, *sc.Status)
+	assert.Equal(t, float64(50), sc.RolloutPercent)
+
+	res = c.CreateScheduledChange(scheduled_change.CreateScheduledChangeParams{
+		FlagID: 100,
+		Body: &models.CreateScheduledChangeRequest{
+			ExecuteAt: &midnight,
+			Action:    util.StringPtr(models.ScheduledChangeActionSETDISTRIBUTIONS),
+			SegmentID: 200,
+			Distributions: []*models.Distribution{
+				{VariantID: util.Int64Ptr(300), VariantKey: util.StringPtr("control"), Percent: util.Float64Ptr(20)},
+				{VariantID: util.Int64Ptr(301), VariantKey: util.StringPtr("treatment"), Percent: util.Float64Ptr(80)},
+			},
+		},
+	})
+	sc = res.(*scheduled_change.CreateScheduledChangeOK).Payload
+	assert.Len(t, sc.Distributions, 2)
+
+	res = c.CreateScheduledChange(scheduled_change.CreateScheduledChangeParams{
+		FlagID: 100,
+		Body: &models.CreateScheduledChangeRequest{
+			ExecuteAt: &midnight,
+			Action:    util.StringPtr(models.ScheduledChangeActionDISABLEFLAG),
+		},
+	})
+	assert.NotNil(t, res.(*scheduled_change.CreateScheduledChangeOK).Payload)
+
+	// step 2. it should be able to find the scheduled changes
+	res = c.FindScheduledChanges(scheduled_change.FindScheduledChangesParams{FlagID: 100})
+	assert.Len(t, res.(*scheduled_change.FindScheduledChangesOK).Payload, 3)
+
+	// step 3. it should be able to cancel a pending change
+	res = c.CancelScheduledChange(scheduled_change.CancelScheduledChangeParams{FlagID: 100, ScheduledChangeID: 3})
+	assert.Equal(t, models.ScheduledChangeStatusCANCELED, *res.(*scheduled_change.CancelScheduledChangeOK).Payload.Status)
+	res = c.CancelScheduledChange(scheduled_change.CancelScheduledChangeParams{FlagID: 100, ScheduledChangeID: 3})
+	assert.NotZero(t, res.(*scheduled_change.CancelScheduledChangeDefault).Payload)
+
+	res = c.FindScheduledChanges(scheduled_change.FindScheduledChangesParams{
+		FlagID: 100,
+		Status: util.StringPtr(models.ScheduledChangeStatusPENDING),
+	})
+	assert.Len(t, res.(*scheduled_change.FindScheduledChangesOK).Payload, 2)
+}
+
+func TestCrudScheduledChangesWithFailures(t *testing.T) {
+	var res middleware.Responder
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	now := time.Date(2025, 11, 27, 12, 0, 0, 0, time.UTC)
+	defer gostub.StubFunc(&entity.Now, now).Reset()
+	past := strfmt.DateTime(now.Add(-time.Hour))
+	future := strfmt.DateTime(now.Add(time.Hour))
+
+	for name, body := range map[string]*models.CreateScheduledChangeRequest{
+		"executeAt in the past": {
+			ExecuteAt: &past,
+			Action:    util.StringPtr(models.ScheduledChangeActionENABLEFLAG),
+		},
+		"unknown action": {
+			ExecuteAt: &future,
+			Action:    util.StringPtr("DELETE_FLAG"),
+		},
+		"missing rolloutPercent": {
+			ExecuteAt: &future,
+			Action:    util.StringPtr(models.ScheduledChangeActionSETROLLOUTPERCENT),
+			SegmentID: 200,
+		},
+		"segment of another flag": {
+			ExecuteAt:      &future,
+			Action:         util.StringPtr(models.ScheduledChangeActionSETROLLOUTPERCENT),
+			SegmentID:      999,
+			RolloutPercent: util.Float64Ptr(50),
+		},
+		"distributions not summing up to 100": {
+			ExecuteAt: &future,
+			Action:    util.StringPtr(models.ScheduledChangeActionSETDISTRIBUTIONS),
+			SegmentID: 200,
+			Distributions: []*models.Distribution{
+				{VariantID: util.Int64Ptr(300), VariantKey: util.StringPtr("control"), Percent: util.Float64Ptr(20)},
+			},
+		},
+	} {
+		t.Run("CreateScheduledChange - "+name, func(t *testing.T) {
+			res = c.CreateScheduledChange(scheduled_change.CreateScheduledChangeParams{FlagID: 100, Body: body})
+			assert.NotZero(t, res.(*scheduled_change.CreateScheduledChangeDefault).Payload)
+		})
+	}
+
+	t.Run("CreateScheduledChange - non-existing flag", func(t *testing.T) {
+		res = c.CreateScheduledChange(scheduled_change.CreateScheduledChangeParams{
+			FlagID: 999,
+			Body: &models.CreateScheduledChangeRequest{
+				ExecuteAt: &future,
+				Action:    util.StringPtr(models.ScheduledChangeActionENABLEFLAG),
+			},
+		})
+		assert.NotZero(t, res.(*scheduled_change.CreateScheduledChangeDefault).Payload)
+	})
+
+	t.Run("CancelScheduledChange - non-existing change", func(t *testing.T) {
+		res = c.CancelScheduledChange(scheduled_change.CancelScheduledChangeParams{FlagID: 100, ScheduledChangeID: 999})
+		assert.NotZero(t, res.(*scheduled_change.CancelScheduledChangeDefault).Payload)
+	})
+}
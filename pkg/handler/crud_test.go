@@ -86,7 +86,7 @@ func TestCrudFlags(t *testing.T) {
 			FlagID: int64(1),
 			Body: &models.CreateSegmentRequest{
 				Description:    util.StringPtr("segment1"),
-				RolloutPercent: util.Int64Ptr(int64(100)),
+				RolloutPercent: util.Float64Ptr(float64(100)),
 			},
 		})
 		c.CreateVariant(variant.CreateVariantParams{
@@ -147,6 +147,70 @@ func TestCrudFlags(t *testing.T) {
 		assert.NotZero(t, len(ds))
 	})
 
+	t.Run("it should be able to put flag's BucketBy", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				BucketBy: util.StringPtr("org_id"),
+			}},
+		)
+		assert.Equal(t, "org_id", res.(*flag.PutFlagOK).Payload.BucketBy)
+
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				BucketBy: util.StringPtr("org id"),
+			}},
+		)
+		assert.NotZero(t, res.(*flag.PutFlagDefault).Payload)
+	})
+
+	t.Run("it should be able to put flag's ClientVisible", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				ClientVisible: util.BoolPtr(true),
+			}},
+		)
+		assert.True(t, res.(*flag.PutFlagOK).Payload.ClientVisible)
+	})
+
+	t.Run("it should be able to put flag's StickyAssignments and reset the AssignmentSalt", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				StickyAssignments: util.BoolPtr(true),
+			}},
+		)
+		assert.True(t, res.(*flag.PutFlagOK).Payload.StickyAssignments)
+		salt := res.(*flag.PutFlagOK).Payload.AssignmentSalt
+		assert.NotEmpty(t, salt)
+
+		res = c.ResetFlagAssignmentSalt(flag.ResetFlagAssignmentSaltParams{FlagID: int64(1)})
+		assert.NotEqual(t, salt, res.(*flag.ResetFlagAssignmentSaltOK).Payload.AssignmentSalt)
+
+		res = c.ResetFlagAssignmentSalt(flag.ResetFlagAssignmentSaltParams{FlagID: int64(999)})
+		assert.NotZero(t, res.(*flag.ResetFlagAssignmentSaltDefault).Payload)
+	})
+
+	t.Run("it should be able to put flag's HashAlgorithm", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				HashAlgorithm: util.StringPtr(models.PutFlagRequestHashAlgorithmMurmur3),
+			}},
+		)
+		assert.Equal(t, models.FlagHashAlgorithmMurmur3, *res.(*flag.PutFlagOK).Payload.HashAlgorithm)
+
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				HashAlgorithm: util.StringPtr("md5"),
+			}},
+		)
+		assert.NotZero(t, res.(*flag.PutFlagDefault).Payload)
+	})
+
 	t.Run("it should be able to get all the flags' EntityType", func(t *testing.T) {
 		res = c.GetFlagEntityTypes(flag.GetFlagEntityTypesParams{})
 		assert.NotZero(t, len(res.(*flag.GetFlagEntityTypesOK).Payload))
@@ -347,7 +411,7 @@ func TestFindFlags(t *testing.T) {
 			FlagID: int64(1),
 			Body: &models.CreateSegmentRequest{
 				Description:    util.StringPtr("segment1"),
-				RolloutPercent: util.Int64Ptr(int64(100)),
+				RolloutPercent: util.Float64Ptr(float64(100)),
 			},
 		})
 		c.CreateVariant(variant.CreateVariantParams{
@@ -374,7 +438,7 @@ func TestFindFlags(t *testing.T) {
 			FlagID: 1,
 			Body: &models.CreateSegmentRequest{
 				Description:    util.StringPtr("segment2"),
-				RolloutPercent: util.Int64Ptr(int64(100)),
+				RolloutPercent: util.Float64Ptr(float64(100)),
 			},
 		})
 		c.CreateVariant(variant.CreateVariantParams{
@@ -562,7 +626,7 @@ func TestCrudSegments(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    util.StringPtr("segment1"),
-			RolloutPercent: util.Int64Ptr(int64(100)),
+			RolloutPercent: util.Float64Ptr(float64(100)),
 		},
 	})
 	assert.NotZero(t, res.(*segment.CreateSegmentOK).Payload)
@@ -570,7 +634,7 @@ func TestCrudSegments(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    util.StringPtr("segment2"),
-			RolloutPercent: util.Int64Ptr(int64(100)),
+			RolloutPercent: util.Float64Ptr(float64(100)),
 		},
 	})
 	assert.NotZero(t, res.(*segment.CreateSegmentOK).Payload)
@@ -585,11 +649,58 @@ func TestCrudSegments(t *testing.T) {
 		SegmentID: int64(1),
 		Body: &models.PutSegmentRequest{
 			Description:    util.StringPtr("segment1"),
-			RolloutPercent: util.Int64Ptr(int64(0)),
+			RolloutPercent: util.Float64Ptr(float64(0)),
 		},
 	})
 	assert.NotZero(t, res.(*segment.PutSegmentOK).Payload.ID)
 
+	res = c.PutSegment(segment.PutSegmentParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.PutSegmentRequest{
+			Description:    util.StringPtr("segment1"),
+			RolloutPercent: util.Float64Ptr(float64(0)),
+			BucketBy:       util.StringPtr("org_id"),
+		},
+	})
+	assert.Equal(t, "org_id", res.(*segment.PutSegmentOK).Payload.BucketBy)
+
+	res = c.PutSegment(segment.PutSegmentParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.PutSegmentRequest{
+			Description:    util.StringPtr("segment1"),
+			RolloutPercent: util.Float64Ptr(float64(0)),
+			ConstraintGroups: []*models.ConstraintGroup{
+				{
+					Operator: util.StringPtr(models.ConstraintGroupOperatorOR),
+					Constraints: []*models.CreateConstraintRequest{
+						{Property: util.StringPtr("country"), Operator: util.StringPtr(models.ConstraintOperatorEQ), Value: util.StringPtr(`"US"`)},
+						{Property: util.StringPtr("plan"), Operator: util.StringPtr(models.ConstraintOperatorEQ), Value: util.StringPtr(`"enterprise"`)},
+					},
+				},
+			},
+		},
+	})
+	assert.Len(t, res.(*segment.PutSegmentOK).Payload.ConstraintGroups, 1)
+	assert.Len(t, res.(*segment.PutSegmentOK).Payload.ConstraintGroups[0].Constraints, 2)
+
+	res = c.PutSegment(segment.PutSegmentParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.PutSegmentRequest{
+			Description:    util.StringPtr("segment1"),
+			RolloutPercent: util.Float64Ptr(float64(0)),
+			ConstraintGroups: []*models.ConstraintGroup{
+				{Operator: util.StringPtr(models.ConstraintGroupOperatorOR)},
+			},
+		},
+	})
+	assert.NotZero(t, res.(*segment.PutSegmentDefault).Payload)
+
+	res = c.FindSegments(segment.FindSegmentsParams{FlagID: int64(1)})
+	assert.Len(t, res.(*segment.FindSegmentsOK).Payload[0].ConstraintGroups, 1)
+
 	// step 4. it should be able to reorder the segments
 	res = c.PutSegmentsReorder(segment.PutSegmentsReorderParams{
 		FlagID: int64(1),
@@ -643,7 +754,7 @@ func TestCrudSegmentsWithFailures(t *testing.T) {
 			FlagID: int64(1),
 			Body: &models.CreateSegmentRequest{
 				Description:    util.StringPtr("segment1"),
-				RolloutPercent: util.Int64Ptr(int64(100)),
+				RolloutPercent: util.Float64Ptr(float64(100)),
 			},
 		})
 		assert.NotZero(t, res.(*segment.CreateSegmentDefault).Payload)
@@ -656,7 +767,7 @@ func TestCrudSegmentsWithFailures(t *testing.T) {
 			SegmentID: int64(999999),
 			Body: &models.PutSegmentRequest{
 				Description:    util.StringPtr("segment1"),
-				RolloutPercent: util.Int64Ptr(int64(0)),
+				RolloutPercent: util.Float64Ptr(float64(0)),
 			},
 		})
 		assert.NotZero(t, res.(*segment.PutSegmentDefault).Payload)
@@ -707,7 +818,7 @@ func TestCrudConstraints(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    util.StringPtr("segment1"),
-			RolloutPercent: util.Int64Ptr(int64(100)),
+			RolloutPercent: util.Float64Ptr(float64(100)),
 		},
 	})
 
@@ -797,7 +908,7 @@ func TestCrudConstraintsFailures(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    util.StringPtr("segment1"),
-			RolloutPercent: util.Int64Ptr(int64(100)),
+			RolloutPercent: util.Float64Ptr(float64(100)),
 		},
 	})
 	c.CreateConstraint(constraint.CreateConstraintParams{
@@ -1284,7 +1395,7 @@ func TestCrudDistributions(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    util.StringPtr("segment1"),
-			RolloutPercent: util.Int64Ptr(int64(100)),
+			RolloutPercent: util.Float64Ptr(float64(100)),
 		},
 	})
 	c.CreateVariant(variant.CreateVariantParams{
@@ -1308,7 +1419,7 @@ func TestCrudDistributions(t *testing.T) {
 		Body: &models.PutDistributionsRequest{
 			Distributions: []*models.Distribution{
 				{
-					Percent:    util.Int64Ptr(int64(100)),
+					Percent:    util.Float64Ptr(float64(100)),
 					VariantID:  util.Int64Ptr(int64(1)),
 					VariantKey: util.StringPtr("control"),
 				},
@@ -1347,7 +1458,7 @@ func TestCrudDistributionsWithFailures(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    util.StringPtr("segment1"),
-			RolloutPercent: util.Int64Ptr(int64(100)),
+			RolloutPercent: util.Float64Ptr(float64(100)),
 		},
 	})
 	c.CreateVariant(variant.CreateVariantParams{
@@ -1362,7 +1473,7 @@ func TestCrudDistributionsWithFailures(t *testing.T) {
 		Body: &models.PutDistributionsRequest{
 			Distributions: []*models.Distribution{
 				{
-					Percent:    util.Int64Ptr(int64(100)),
+					Percent:    util.Float64Ptr(float64(100)),
 					VariantID:  util.Int64Ptr(int64(1)),
 					VariantKey: util.StringPtr("control"),
 				},
@@ -1387,7 +1498,7 @@ func TestCrudDistributionsWithFailures(t *testing.T) {
 			Body: &models.PutDistributionsRequest{
 				Distributions: []*models.Distribution{
 					{
-						Percent:    util.Int64Ptr(int64(50)), // not adds up to 100
+						Percent:    util.Float64Ptr(float64(50)), // not adds up to 100
 						VariantID:  util.Int64Ptr(int64(1)),
 						VariantKey: util.StringPtr("control"),
 					},
@@ -1406,7 +1517,7 @@ func TestCrudDistributionsWithFailures(t *testing.T) {
 			Body: &models.PutDistributionsRequest{
 				Distributions: []*models.Distribution{
 					{
-						Percent:    util.Int64Ptr(int64(100)),
+						Percent:    util.Float64Ptr(float64(100)),
 						VariantID:  util.Int64Ptr(int64(1)),
 						VariantKey: util.StringPtr("control"),
 					},
@@ -1417,3 +1528,178 @@ func TestCrudDistributionsWithFailures(t *testing.T) {
 		db.Error = nil
 	})
 }
+
+func TestCrudDefaultVariants(t *testing.T) {
+	var res middleware.Responder
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	t.Run("it should be able to put flag's default variant", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(100),
+			Body:   &models.PutFlagRequest{DefaultVariantID: util.Int64Ptr(300)},
+		})
+		assert.Equal(t, int64(300), *res.(*flag.PutFlagOK).Payload.DefaultVariantID)
+
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(100),
+			Body:   &models.PutFlagRequest{DefaultVariantID: util.Int64Ptr(999)},
+		})
+		assert.NotZero(t, res.(*flag.PutFlagDefault).Payload)
+	})
+
+	t.Run("it should be able to create and put segment's default variant", func(t *testing.T) {
+		res = c.CreateSegment(segment.CreateSegmentParams{
+			FlagID: int64(100),
+			Body: &models.CreateSegmentRequest{
+				Description:      util.StringPtr("segment with a default variant"),
+				RolloutPercent:   util.Float64Ptr(10),
+				DefaultVariantID: util.Int64Ptr(300),
+			},
+		})
+		s := res.(*segment.CreateSegmentOK).Payload
+		assert.Equal(t, int64(300), *s.DefaultVariantID)
+
+		res = c.PutSegment(segment.PutSegmentParams{
+			FlagID:    int64(100),
+			SegmentID: s.ID,
+			Body: &models.PutSegmentRequest{
+				Description:      util.StringPtr("segment with a default variant"),
+				RolloutPercent:   util.Float64Ptr(10),
+				DefaultVariantID: util.Int64Ptr(301),
+			},
+		})
+		assert.Equal(t, int64(301), *res.(*segment.PutSegmentOK).Payload.DefaultVariantID)
+
+		res = c.PutSegment(segment.PutSegmentParams{
+			FlagID:    int64(100),
+			SegmentID: s.ID,
+			Body: &models.PutSegmentRequest{
+				Description:      util.StringPtr("segment with a default variant"),
+				RolloutPercent:   util.Float64Ptr(10),
+				DefaultVariantID: util.Int64Ptr(999),
+			},
+		})
+		assert.NotZero(t, res.(*segment.PutSegmentDefault).Payload)
+	})
+
+	t.Run("it should not delete the default variant", func(t *testing.T) {
+		res = c.DeleteVariant(variant.DeleteVariantParams{FlagID: int64(100), VariantID: int64(300)})
+		assert.NotZero(t, res.(*variant.DeleteVariantDefault).Payload)
+
+		res = c.DeleteVariant(variant.DeleteVariantParams{FlagID: int64(100), VariantID: int64(301)})
+		assert.NotZero(t, res.(*variant.DeleteVariantDefault).Payload)
+	})
+}
+
+func TestCrudFlagValueTypes(t *testing.T) {
+	var res middleware.Responder
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	schema := map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"hex_color"},
+		"properties": map[string]interface{}{
+			"hex_color": map[string]interface{}{"type": "string"},
+		},
+	}
+
+	t.Run("it should reject the value type that existing variants don't fit", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(100),
+			Body:   &models.PutFlagRequest{ValueType: util.StringPtr(entity.ValueTypeBoolean)},
+		})
+		assert.NotZero(t, res.(*flag.PutFlagDefault).Payload)
+
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(100),
+			Body:   &models.PutFlagRequest{ValueType: util.StringPtr("integer")},
+		})
+		assert.NotZero(t, res.(*flag.PutFlagDefault).Payload)
+	})
+
+	t.Run("it should be able to put flag's value type and schema", func(t *testing.T) {
+		for _, id := range []int64{300, 301} {
+			res = c.PutVariant(variant.PutVariantParams{
+				FlagID:    int64(100),
+				VariantID: id,
+				Body: &models.PutVariantRequest{
+					Key:        util.StringPtr(map[int64]string{300: "control", 301: "treatment"}[id]),
+					Attachment: map[string]interface{}{"hex_color": "#42b983"},
+				},
+			})
+			assert.IsType(t, &variant.PutVariantOK{}, res)
+		}
+
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(100),
+			Body: &models.PutFlagRequest{
+				ValueType:   util.StringPtr(entity.ValueTypeObject),
+				ValueSchema: schema,
+			},
+		})
+		f := res.(*flag.PutFlagOK).Payload
+		assert.Equal(t, entity.ValueTypeObject, f.ValueType)
+		assert.Equal(t, "object", f.ValueSchema.(map[string]interface{})["type"])
+	})
+
+	t.Run("it should reject the attachments that don't match the schema", func(t *testing.T) {
+		res = c.CreateVariant(variant.CreateVariantParams{
+			FlagID: int64(100),
+			Body: &models.CreateVariantRequest{
+				Key:        util.StringPtr("typo"),
+				Attachment: map[string]interface{}{"hex_colour": "#42b983"},
+			},
+		})
+		assert.NotZero(t, res.(*variant.CreateVariantDefault).Payload)
+
+		res = c.PutVariant(variant.PutVariantParams{
+			FlagID:    int64(100),
+			VariantID: int64(300),
+			Body: &models.PutVariantRequest{
+				Key:        util.StringPtr("control"),
+				Attachment: map[string]interface{}{"hex_color": 42},
+			},
+		})
+		assert.NotZero(t, res.(*variant.PutVariantDefault).Payload)
+
+		res = c.CreateVariant(variant.CreateVariantParams{
+			FlagID: int64(100),
+			Body: &models.CreateVariantRequest{
+				Key:        util.StringPtr("pink"),
+				Attachment: map[string]interface{}{"hex_color": "#ff69b4"},
+			},
+		})
+		assert.IsType(t, &variant.CreateVariantOK{}, res)
+	})
+
+	t.Run("it should be able to clear the schema with an empty object", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(100),
+			Body: &models.PutFlagRequest{
+				ValueType:   util.StringPtr(""),
+				ValueSchema: map[string]interface{}{},
+			},
+		})
+		f := res.(*flag.PutFlagOK).Payload
+		assert.Empty(t, f.ValueType)
+		assert.Nil(t, f.ValueSchema)
+	})
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/variant_override"
+	"gorm.io/gorm"
+)
+
+func (c *crud) CreateVariantOverride(params variant_override.CreateVariantOverrideParams) middleware.Responder {
+	o := &entity.VariantOverride{
+		FlagID:     util.SafeUint(params.FlagID),
+		EntityID:   util.SafeString(params.Body.EntityID),
+		EntityType: params.Body.EntityType,
+		VariantID:  util.SafeUint(params.Body.VariantID),
+		CreatedBy:  getSubjectFromRequest(params.HTTPRequest),
+	}
+	if params.Body.ExpiresAt != nil {
+		expiresAt := time.Time(*params.Body.ExpiresAt)
+		if !expiresAt.After(entity.Now()) {
+			return variant_override.NewCreateVariantOverrideDefault(400).WithPayload(
+				ErrorMessage("expiresAt %s is not in the future", expiresAt.UTC().Format(time.RFC3339)))
+		}
+		o.ExpiresAt = &expiresAt
+	}
+	if err := o.Validate(); err != nil {
+		return variant_override.NewCreateVariantOverrideDefault(400).WithPayload(
+			ErrorMessage("cannot create variant override. %s", err))
+	}
+
+	if err := getDB().First(&entity.Flag{}, params.FlagID).Error; err != nil {
+		return variant_override.NewCreateVariantOverrideDefault(404).WithPayload(
+			ErrorMessage("error finding flagID %v. reason %s", params.FlagID, err))
+	}
+	v := &entity.Variant{}
+	if err := getDB().Where("id = ? AND flag_id = ?", o.VariantID, o.FlagID).First(v).Error; err != nil {
+		return variant_override.NewCreateVariantOverrideDefault(400).WithPayload(
+			ErrorMessage("error finding variantID %v under flagID %v. reason %s", o.VariantID, o.FlagID, err))
+	}
+
+	var count int64
+	q := entity.VariantOverride{FlagID: o.FlagID, EntityID: o.EntityID}
+	if err := getDB().Model(&entity.VariantOverride{}).Where(q).Where("entity_type = ?", o.EntityType).Count(&count).Error; err != nil {
+		return variant_override.NewCreateVariantOverrideDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if count > 0 {
+		return variant_override.NewCreateVariantOverrideDefault(400).WithPayload(
+			ErrorMessage("entityID %s with entityType %q already has an override", o.EntityID, o.EntityType))
+	}
+
+	if err := getDB().Create(o).Error; err != nil {
+		return variant_override.NewCreateVariantOverrideDefault(500).WithPayload(
+			ErrorMessage("cannot create variant override. %s", err))
+	}
+
+	resp := variant_override.NewCreateVariantOverrideOK()
+	resp.SetPayload(e2r.MapVariantOverride(o, v.Key))
+	entity.SaveFlagSnapshot(getDB(), o.FlagID, getSubjectFromRequest(params.HTTPRequest))
+	return resp
+}
+
+func (c *crud) FindVariantOverrides(params variant_override.FindVariantOverridesParams) middleware.Responder {
+	overrides := []entity.VariantOverride{}
+	if err := getDB().Where(entity.VariantOverride{FlagID: util.SafeUint(params.FlagID)}).Order("id").Find(&overrides).Error; err != nil {
+		return variant_override.NewFindVariantOverridesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	vs := []entity.Variant{}
+	if err := getDB().Where(entity.Variant{FlagID: util.SafeUint(params.FlagID)}).Find(&vs).Error; err != nil {
+		return variant_override.NewFindVariantOverridesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := variant_override.NewFindVariantOverridesOK()
+	resp.SetPayload(e2r.MapVariantOverrides(overrides, vs))
+	return resp
+}
+
+func (c *crud) DeleteVariantOverride(params variant_override.DeleteVariantOverrideParams) middleware.Responder {
+	o := &entity.VariantOverride{}
+	err := getDB().Where("id = ? AND flag_id = ?", params.VariantOverrideID, params.FlagID).First(o).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return variant_override.NewDeleteVariantOverrideDefault(404).WithPayload(
+			ErrorMessage("unable to find variant override %v of flag %v", params.VariantOverrideID, params.FlagID))
+	}
+	if err != nil {
+		return variant_override.NewDeleteVariantOverrideDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if err := getDB().Delete(o).Error; err != nil {
+		return variant_override.NewDeleteVariantOverrideDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	entity.SaveFlagSnapshot(getDB(), o.FlagID, getSubjectFromRequest(params.HTTPRequest))
+	return variant_override.NewDeleteVariantOverrideOK()
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/variant"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/variant_override"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudVariantOverrides(t *testing.T) {
+	var res middleware.Responder
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	now := time.Date(2025, 11, 27, 12, 0, 0, 0, time.UTC)
+	defer gostub.StubFunc(&entity.Now, now).Reset()
+	tomorrow := strfmt.DateTime(now.Add(24 * time.Hour))
+
+	// step 1. it should be able to create the overrides
+	res = c.CreateVariantOverride(variant_override.CreateVariantOverrideParams{
+		FlagID: 100,
+		Body: &models.CreateVariantOverrideRequest{
+			EntityID:  util.StringPtr("qa_user"),
+			VariantID: util.Int64Ptr(301),
+		},
+	})
+	o := res.(*variant_override.CreateVariantOverrideOK).Payload
+	assert.Equal(t, int64(1), o.ID)
+	assert.Equal(t, "treatment", o.VariantKey)
+	assert.Nil(t, o.ExpiresAt)
+
+	res = c.CreateVariantOverride(variant_override.CreateVariantOverrideParams{
+		FlagID: 100,
+		Body: &models.CreateVariantOverrideRequest{
+			EntityID:   util.StringPtr("qa_user"),
+			EntityType: "tester",
+			VariantID:  util.Int64Ptr(300),
+			ExpiresAt:  &tomorrow,
+		},
+	})
+	o = res.(*variant_override.CreateVariantOverrideOK).Payload
+	assert.Equal(t, "control", o.VariantKey)
+	assert.Equal(t, time.Time(tomorrow), time.Time(*o.ExpiresAt))
+
+	// step 2. it should be able to find the overrides, and they are in the flag snapshots
+	res = c.FindVariantOverrides(variant_override.FindVariantOverridesParams{FlagID: 100})
+	assert.Len(t, res.(*variant_override.FindVariantOverridesOK).Payload, 2)
+
+	f := &entity.Flag{}
+	db.First(f, 100)
+	snapshot := &entity.FlagSnapshot{}
+	db.First(snapshot, f.SnapshotID)
+	assert.Contains(t, string(snapshot.Flag), "qa_user")
+
+	// step 3. it should not delete the variant used by an override
+	res = c.DeleteVariant(variant.DeleteVariantParams{FlagID: 100, VariantID: 301})
+	assert.NotZero(t, res.(*variant.DeleteVariantDefault).Payload)
+
+	// step 4. it should be able to delete the override
+	res = c.DeleteVariantOverride(variant_override.DeleteVariantOverrideParams{FlagID: 100, VariantOverrideID: 1})
+	assert.IsType(t, &variant_override.DeleteVariantOverrideOK{}, res)
+
+	res = c.FindVariantOverrides(variant_override.FindVariantOverridesParams{FlagID: 100})
+	assert.Len(t, res.(*variant_override.FindVariantOverridesOK).Payload, 1)
+}
+
+func TestCrudVariantOverridesWithFailures(t *testing.T) {
+	var res middleware.Responder
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	now := time.Date(2025, 11, 27, 12, 0, 0, 0, time.UTC)
+	defer gostub.StubFunc(&entity.Now, now).Reset()
+	yesterday := strfmt.DateTime(now.Add(-24 * time.Hour))
+
+	db.Create(&entity.VariantOverride{FlagID: 100, EntityID: "qa_user", VariantID: 301})
+
+	for name, body := range map[string]*models.CreateVariantOverrideRequest{
+		"empty entityID": {
+			EntityID:  util.StringPtr(""),
+			VariantID: util.Int64Ptr(301),
+		},
+		"variant of another flag": {
+			EntityID:  util.StringPtr("another_user"),
+			VariantID: util.Int64Ptr(999),
+		},
+		"expiresAt in the past": {
+			EntityID:  util.StringPtr("another_user"),
+			VariantID: util.Int64Ptr(301),
+			ExpiresAt: &yesterday,
+		},
+		"duplicate override": {
+			EntityID:  util.StringPtr("qa_user"),
+			VariantID: util.Int64Ptr(300),
+		},
+	} {
+		t.Run("CreateVariantOverride - "+name, func(t *testing.T) {
+			res = c.CreateVariantOverride(variant_override.CreateVariantOverrideParams{FlagID: 100, Body: body})
+			assert.NotZero(t, res.(*variant_override.CreateVariantOverrideDefault).Payload)
+		})
+	}
+
+	t.Run("CreateVariantOverride - non-existing flag", func(t *testing.T) {
+		res = c.CreateVariantOverride(variant_override.CreateVariantOverrideParams{
+			FlagID: 999,
+			Body: &models.CreateVariantOverrideRequest{
+				EntityID:  util.StringPtr("qa_user"),
+				VariantID: util.Int64Ptr(301),
+			},
+		})
+		assert.NotZero(t, res.(*variant_override.CreateVariantOverrideDefault).Payload)
+	})
+
+	t.Run("DeleteVariantOverride - non-existing override", func(t *testing.T) {
+		res = c.DeleteVariantOverride(variant_override.DeleteVariantOverrideParams{FlagID: 100, VariantOverrideID: 999})
+		assert.NotZero(t, res.(*variant_override.DeleteVariantOverrideDefault).Payload)
+	})
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"sync"
+	"time"
+
+	sqlite "github.com/glebarez/sqlite" // sqlite driver with pure go
+	mysql "gorm.io/driver/mysql"        // mysql driver
+	postgres "gorm.io/driver/postgres"  // postgres driver
+
+	retry "github.com/avast/retry-go"
+	"github.com/openflagr/flagr/pkg/config"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	gorm_logger "gorm.io/gorm/logger"
+)
+
+var (
+	singletonDB     *gorm.DB
+	singletonDBOnce sync.Once
+)
+
+func connectDB() (db *gorm.DB, err error) {
+	logger := &entity.Logger{
+		LogLevel:                  gorm_logger.Info,
+		SlowThreshold:             time.Millisecond,
+		IgnoreRecordNotFoundError: false,
+	}
+
+	err = retry.Do(
+		func() error {
+			switch config.Config.DBDriver {
+			case "postgres":
+				db, err = gorm.Open(postgres.Open(config.Config.DBConnectionStr), &gorm.Config{
+					Logger: logger,
+				})
+			case "sqlite3":
+				db, err = gorm.Open(sqlite.Open(config.Config.DBConnectionStr), &gorm.Config{
+					Logger: logger,
+				})
+			case "mysql":
+				db, err = gorm.Open(mysql.Open(config.Config.DBConnectionStr), &gorm.Config{
+					Logger: logger,
+				})
+			}
+			return err
+		},
+		retry.Attempts(config.Config.DBConnectionRetryAttempts),
+		retry.Delay(config.Config.DBConnectionRetryDelay),
+	)
+	return db, err
+}
+
+// GetDB gets the db singleton
+func GetDB() *gorm.DB {
+	singletonDBOnce.Do(func() {
+		db, err := connectDB()
+		if err != nil {
+			if config.Config.DBConnectionDebug {
+				logrus.WithField("err", err).Fatal("failed to connect to db")
+			} else {
+				logrus.Fatal("failed to connect to db")
+			}
+		}
+		db.AutoMigrate(entity.AutoMigrateTables...)
+		singletonDB = db
+	})
+
+	return singletonDB
+}
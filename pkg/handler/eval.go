@@ -2,7 +2,6 @@ package handler
 
 import (
 	"fmt"
-	"math/rand"
 	"sync"
 	"time"
 
@@ -10,21 +9,27 @@ import (
 
 	"github.com/openflagr/flagr/pkg/config"
 	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/evaluator"
 	"github.com/openflagr/flagr/pkg/util"
 	"github.com/openflagr/flagr/swagger_gen/models"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
-	"gorm.io/gorm"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/ofrep"
 
 	"github.com/bsm/ratelimit"
-	"github.com/davecgh/go-spew/spew"
 	"github.com/go-openapi/runtime/middleware"
-	"github.com/zhouzhuojie/conditions"
 )
 
 // Eval is the Eval interface
 type Eval interface {
 	PostEvaluation(evaluation.PostEvaluationParams) middleware.Responder
 	PostEvaluationBatch(evaluation.PostEvaluationBatchParams) middleware.Responder
+	PostEvaluationDryRun(evaluation.PostEvaluationDryRunParams) middleware.Responder
+	PostEvaluationSnapshot(evaluation.PostEvaluationSnapshotParams) middleware.Responder
+	PostEvaluationSimulation(evaluation.PostEvaluationSimulationParams) middleware.Responder
+	PostEvaluationBootstrap(evaluation.PostEvaluationBootstrapParams) middleware.Responder
+	GetEvaluationChanges(evaluation.GetEvaluationChangesParams) middleware.Responder
+	PostOfrepEvaluateFlag(ofrep.PostOfrepEvaluateFlagParams) middleware.Responder
+	PostOfrepEvaluateFlags(ofrep.PostOfrepEvaluateFlagsParams) middleware.Responder
 }
 
 // NewEval creates a new Eval instance
@@ -49,49 +54,28 @@ func (e *eval) PostEvaluation(params evaluation.PostEvaluationParams) middleware
 
 func (e *eval) PostEvaluationBatch(params evaluation.PostEvaluationBatchParams) middleware.Responder {
 	entities := params.Body.Entities
-	flagIDs := params.Body.FlagIDs
-	flagKeys := params.Body.FlagKeys
-	flagTags := params.Body.FlagTags
-	flagTagsOperator := params.Body.FlagTagsOperator
-	results := &models.EvaluationBatchResponse{}
-
-	// TODO make it concurrent
-	for _, entity := range entities {
-		if len(flagTags) > 0 {
-			evalContext := models.EvalContext{
-				EnableDebug:      params.Body.EnableDebug,
-				EntityContext:    entity.EntityContext,
-				EntityID:         entity.EntityID,
-				EntityType:       entity.EntityType,
-				FlagTags:         flagTags,
-				FlagTagsOperator: flagTagsOperator,
-			}
-			evalResults := EvalFlagsByTags(evalContext)
-			results.EvaluationResults = append(results.EvaluationResults, evalResults...)
+	keyed := util.SafeString(params.Body.ResponseFormat) == models.EvaluationBatchRequestResponseFormatKEYED
+	if keyed {
+		if err := validateKeyedBatchEntities(entities); err != nil {
+			return evaluation.NewPostEvaluationBatchDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
 		}
-		for _, flagID := range flagIDs {
-			evalContext := models.EvalContext{
-				EnableDebug:   params.Body.EnableDebug,
-				EntityContext: entity.EntityContext,
-				EntityID:      entity.EntityID,
-				EntityType:    entity.EntityType,
-				FlagID:        flagID,
-			}
+	}
 
-			evalResult := EvalFlag(evalContext)
-			results.EvaluationResults = append(results.EvaluationResults, evalResult)
-		}
-		for _, flagKey := range flagKeys {
-			evalContext := models.EvalContext{
-				EnableDebug:   params.Body.EnableDebug,
-				EntityContext: entity.EntityContext,
-				EntityID:      entity.EntityID,
-				EntityType:    entity.EntityType,
-				FlagKey:       flagKey,
-			}
+	entityResults := evalBatch(params.Body)
 
-			evalResult := EvalFlag(evalContext)
-			results.EvaluationResults = append(results.EvaluationResults, evalResult)
+	results := &models.EvaluationBatchResponse{EvaluationResults: []*models.EvalResult{}}
+	if keyed {
+		results.KeyedEvaluationResults = make(map[string]map[string]models.EvalResult, len(entities))
+		for i, entity := range entities {
+			m := make(map[string]models.EvalResult, len(entityResults[i]))
+			for _, r := range entityResults[i] {
+				m[util.SafeStringWithDefault(r.FlagKey, util.SafeString(r.FlagID))] = *r
+			}
+			results.KeyedEvaluationResults[entity.EntityID] = m
+		}
+	} else {
+		for _, rs := range entityResults {
+			results.EvaluationResults = append(results.EvaluationResults, rs...)
 		}
 	}
 
@@ -100,45 +84,94 @@ func (e *eval) PostEvaluationBatch(params evaluation.PostEvaluationBatchParams)
 	return resp
 }
 
-// BlankResult creates a blank result
-func BlankResult(f *entity.Flag, evalContext models.EvalContext, msg string) *models.EvalResult {
-	flagID := uint(0)
-	flagKey := ""
-	flagSnapshotID := uint(0)
-	flagTags := []string{}
-	if f != nil {
-		flagID = f.ID
-		flagSnapshotID = f.SnapshotID
-		flagKey = f.Key
-		if len(f.Tags) > 0 {
-			for _, tag := range f.Tags {
-				flagTags = append(flagTags, tag.Value)
+// evalBatch evaluates the flags selected by the batch request for the entities concurrently,
+// and the results keep the order of the entities
+func evalBatch(body *models.EvaluationBatchRequest) [][]*models.EvalResult {
+	entities := body.Entities
+	flags := selectBatchFlags(body)
+	entityResults := make([][]*models.EvalResult, len(entities))
+
+	sem := make(chan struct{}, max(config.Config.EvalBatchConcurrency, 1))
+	wg := sync.WaitGroup{}
+	for i, entity := range entities {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, entity *models.EvaluationEntity) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results := make([]*models.EvalResult, 0, len(flags))
+			for _, f := range flags {
+				evalContext := models.EvalContext{
+					EnableDebug:   body.EnableDebug,
+					EntityContext: entity.EntityContext,
+					EntityID:      entity.EntityID,
+					EntityType:    entity.EntityType,
+					FlagID:        f.flagID,
+					FlagKey:       f.flagKey,
+				}
+				if f.byTags {
+					evalContext.FlagTags = body.FlagTags
+					evalContext.FlagTagsOperator = body.FlagTagsOperator
+				}
+				results = append(results, EvalFlagWithContext(f.flag, evalContext))
 			}
+			entityResults[i] = results
+		}(i, entity)
+	}
+	wg.Wait()
+	return entityResults
+}
+
+// batchFlag is a flag selected by a batch evaluation request, the flag is nil if it's not found
+type batchFlag struct {
+	flag    *entity.Flag
+	flagID  int64
+	flagKey string
+	byTags  bool
+}
+
+// selectBatchFlags selects the flags by the tags, the IDs and the keys in order. A flag
+// selected more than once, e.g. by both a tag and its key, is only selected the first time.
+func selectBatchFlags(body *models.EvaluationBatchRequest) []batchFlag {
+	cache := GetEvalCache()
+	flags := []batchFlag{}
+	seen := make(map[uint]bool)
+	notFound := make(map[string]bool)
+
+	add := func(f *entity.Flag, bf batchFlag) {
+		if f == nil {
+			k := fmt.Sprintf("%d/%s", bf.flagID, bf.flagKey)
+			if !notFound[k] {
+				notFound[k] = true
+				flags = append(flags, bf)
+			}
+			return
+		}
+		if !seen[f.ID] {
+			seen[f.ID] = true
+			bf.flag = f
+			flags = append(flags, bf)
 		}
 	}
-	return &models.EvalResult{
-		EvalContext: &evalContext,
-		EvalDebugLog: &models.EvalDebugLog{
-			Msg:              msg,
-			SegmentDebugLogs: nil,
-		},
-		FlagID:         int64(flagID),
-		FlagKey:        flagKey,
-		FlagSnapshotID: int64(flagSnapshotID),
-		FlagTags:       flagTags,
-		Timestamp:      util.TimeNow(),
+
+	if len(body.FlagTags) > 0 {
+		for _, f := range cache.GetByTags(body.FlagTags, body.FlagTagsOperator) {
+			add(f, batchFlag{flagID: int64(f.ID), flagKey: f.Key, byTags: true})
+		}
+	}
+	for _, flagID := range body.FlagIDs {
+		add(cache.GetByFlagKeyOrID(flagID), batchFlag{flagID: flagID})
 	}
+	for _, flagKey := range body.FlagKeys {
+		add(cache.GetByFlagKeyOrID(flagKey), batchFlag{flagKey: flagKey})
+	}
+	return flags
 }
 
 var LookupFlag = func(evalContext models.EvalContext) *entity.Flag {
-	cache := GetEvalCache()
-	flagID := util.SafeUint(evalContext.FlagID)
-	flagKey := util.SafeString(evalContext.FlagKey)
-	f := cache.GetByFlagKeyOrID(flagID)
-	if f == nil {
-		f = cache.GetByFlagKeyOrID(flagKey)
-	}
-	return f
+	return evaluator.LookupFlag(GetEvalCache(), evalContext)
 }
 
 var EvalFlagsByTags = func(evalContext models.EvalContext) []*models.EvalResult {
@@ -157,59 +190,22 @@ var EvalFlag = func(evalContext models.EvalContext) *models.EvalResult {
 }
 
 var EvalFlagWithContext = func(flag *entity.Flag, evalContext models.EvalContext) *models.EvalResult {
-	flagID := util.SafeUint(evalContext.FlagID)
-	flagKey := util.SafeString(evalContext.FlagKey)
-
-	if flag == nil {
-		emptyFlag := &entity.Flag{Model: gorm.Model{ID: flagID}, Key: flagKey}
-		return BlankResult(emptyFlag, evalContext, fmt.Sprintf("flagID %v not found or deleted", flagID))
-	}
-
-	if !flag.Enabled {
-		return BlankResult(flag, evalContext, fmt.Sprintf("flagID %v is not enabled", flag.ID))
-	}
-
-	if len(flag.Segments) == 0 {
-		return BlankResult(flag, evalContext, fmt.Sprintf("flagID %v has no segments", flag.ID))
-	}
-
-	if evalContext.EntityID == "" {
-		evalContext.EntityID = fmt.Sprintf("randomly_generated_%d", rand.Int31())
+	ev := newEvaluator()
+	// the store is checked per flag, including the sticky prerequisites of a non-sticky flag
+	ev.Assignments = GetAssignmentStore()
+	evalResult := ev.Evaluate(flag, evalContext)
+	if flag != nil && flag.Enabled && evalResult.Reason != models.EvalResultReasonNOSEGMENTS {
+		logEvalResult(evalResult, flag.DataRecordsEnabled)
 	}
+	return evalResult
+}
 
-	if flag.EntityType != "" {
-		evalContext.EntityType = flag.EntityType
-	}
-
-	logs := []*models.SegmentDebugLog{}
-	var vID int64
-	var sID int64
-
-	for _, segment := range flag.Segments {
-		sID = int64(segment.ID)
-		variantID, log, evalNextSegment := evalSegment(flag.ID, evalContext, segment)
-		if config.Config.EvalDebugEnabled && evalContext.EnableDebug {
-			logs = append(logs, log)
-		}
-		if variantID != nil {
-			vID = int64(*variantID)
-		}
-		if !evalNextSegment {
-			break
-		}
+// newEvaluator creates the Evaluator of the flags in the EvalCache
+func newEvaluator() *evaluator.Evaluator {
+	return &evaluator.Evaluator{
+		Flags:        GetEvalCache(),
+		DebugEnabled: config.Config.EvalDebugEnabled,
 	}
-	evalResult := BlankResult(flag, evalContext, "")
-	evalResult.EvalDebugLog.SegmentDebugLogs = logs
-	evalResult.SegmentID = sID
-	evalResult.VariantID = vID
-	v := flag.FlagEvaluation.VariantsMap[util.SafeUint(vID)]
-	if v != nil {
-		evalResult.VariantAttachment = v.Attachment
-		evalResult.VariantKey = v.Key
-	}
-
-	logEvalResult(evalResult, flag.DataRecordsEnabled)
-	return evalResult
 }
 
 var logEvalResult = func(r *models.EvalResult, dataRecordsEnabled bool) {
@@ -244,6 +240,7 @@ var logEvalResultToDatadog = func(r *models.EvalResult) {
 			fmt.Sprintf("FlagID:%d", util.SafeUint(r.FlagID)),
 			fmt.Sprintf("VariantID:%d", util.SafeUint(r.VariantID)),
 			fmt.Sprintf("VariantKey:%s", util.SafeStringWithDefault(r.VariantKey, "null")),
+			fmt.Sprintf("Reason:%s", util.SafeStringWithDefault(r.Reason, "null")),
 		},
 		float64(1),
 	)
@@ -259,70 +256,11 @@ var logEvalResultToPrometheus = func(r *models.EvalResult) {
 		util.SafeStringWithDefault(r.FlagKey, "null"),
 		util.SafeStringWithDefault(r.VariantID, "null"),
 		util.SafeStringWithDefault(r.VariantKey, "null"),
+		util.SafeStringWithDefault(r.Reason, "null"),
 	).Inc()
 
 }
 
-var evalSegment = func(
-	flagID uint,
-	evalContext models.EvalContext,
-	segment entity.Segment,
-) (
-	vID *uint, // returns VariantID
-	log *models.SegmentDebugLog,
-	evalNextSegment bool,
-) {
-	if len(segment.Constraints) != 0 {
-		m, ok := evalContext.EntityContext.(map[string]interface{})
-		if !ok {
-			log = &models.SegmentDebugLog{
-				Msg:       fmt.Sprintf("constraints are present in the segment_id %v, but got invalid entity_context: %s.", segment.ID, spew.Sdump(evalContext.EntityContext)),
-				SegmentID: int64(segment.ID),
-			}
-			return nil, log, true
-		}
-
-		expr := segment.SegmentEvaluation.ConditionsExpr
-		match, err := conditions.Evaluate(expr, m)
-		if err != nil {
-			log = &models.SegmentDebugLog{
-				Msg:       err.Error(),
-				SegmentID: int64(segment.ID),
-			}
-			return nil, log, true
-		}
-		if !match {
-			log = &models.SegmentDebugLog{
-				Msg:       debugConstraintMsg(evalContext.EnableDebug, expr, m),
-				SegmentID: int64(segment.ID),
-			}
-			return nil, log, true
-		}
-	}
-
-	vID, debugMsg := segment.SegmentEvaluation.DistributionArray.Rollout(
-		evalContext.EntityID,
-		fmt.Sprint(flagID), // default use the flagID as salt
-		segment.RolloutPercent,
-	)
-
-	log = &models.SegmentDebugLog{
-		Msg:       "matched all constraints. " + debugMsg,
-		SegmentID: int64(segment.ID),
-	}
-
-	// at this point, all constraints are matched, so we shouldn't go to next segment
-	// thus setting evalNextSegment = false
-	return vID, log, false
-}
-
-func debugConstraintMsg(enableDebug bool, expr conditions.Expr, m map[string]interface{}) string {
-	if !enableDebug {
-		return ""
-	}
-	return fmt.Sprintf("constraint not match. constraint: %s, entity_context: %+v.", expr, m)
-}
-
 var rateLimitMap = sync.Map{}
 
 var rateLimitPerFlagConsoleLogging = func(r *models.EvalResult) {
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
+	"github.com/sirupsen/logrus"
+)
+
+// PostEvaluationBootstrap evaluates all the enabled flags, optionally filtered by the tags
+// or the client visible marker, for the entity. The assignments are keyed by the flag keys.
+func (e *eval) PostEvaluationBootstrap(params evaluation.PostEvaluationBootstrapParams) middleware.Responder {
+	cache := GetEvalCache()
+	var fs []*entity.Flag
+	if len(params.Body.FlagTags) > 0 {
+		fs = cache.GetByTags(params.Body.FlagTags, params.Body.FlagTagsOperator)
+	} else {
+		fs = cache.GetAll()
+	}
+
+	assignments := models.EvaluationBootstrapResponse{}
+	for _, f := range fs {
+		if !f.Enabled || f.Key == "" || (params.Body.ClientVisibleOnly && !f.ClientVisible) {
+			continue
+		}
+		r := EvalFlagWithContext(f, models.EvalContext{
+			EntityContext: params.Body.EntityContext,
+			EntityID:      params.Body.EntityID,
+			EntityType:    params.Body.EntityType,
+			FlagID:        int64(f.ID),
+			FlagKey:       f.Key,
+		})
+		a := models.FlagAssignment{VariantKey: r.VariantKey}
+		if attachment, ok := r.VariantAttachment.(entity.Attachment); ok && len(attachment) > 0 {
+			a.Attachment = attachment
+		}
+		assignments[f.Key] = a
+	}
+	return htmlSafeJSONResponder(assignments)
+}
+
+// htmlSafeJSONResponder writes the payload as JSON with <, > and & escaped, unlike the JSON
+// producer of the API, so that it's safe to be embedded in a <script> tag of HTML
+func htmlSafeJSONResponder(payload interface{}) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set("Content-Type", "application/json")
+		rw.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(rw).Encode(payload); err != nil {
+			logrus.WithField("err", err).Error("failed to write the JSON response")
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestPostEvaluationBootstrap(t *testing.T) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	genFlag := func(id uint, key string, modify func(f *entity.Flag)) *entity.Flag {
+		f := entity.GenFixtureFlag()
+		f.ID = id
+		f.Key = key
+		// all treatment, whose attachment is {"value": "</script>"}
+		f.Segments[0].Distributions = []entity.Distribution{{VariantID: 301, VariantKey: "treatment", Percent: 100}}
+		f.Variants[1].Attachment = entity.Attachment{"value": "</script>"}
+		modify(&f)
+		f.PrepareEvaluation()
+		return &f
+	}
+	fs := []*entity.Flag{
+		genFlag(1, "flag_1", func(f *entity.Flag) { f.ClientVisible = true }),
+		genFlag(2, "flag_2", func(f *entity.Flag) { f.Tags = []entity.Tag{{Model: gorm.Model{ID: 1}, Value: "web"}} }),
+		genFlag(3, "flag_3", func(f *entity.Flag) { f.Enabled = false }),
+		genFlag(4, "flag_4", func(f *entity.Flag) { f.Segments[0].RolloutPercent = 0 }),
+	}
+	ec := &EvalCache{cache: &cacheContainer{
+		idCache:  map[string]*entity.Flag{},
+		keyCache: map[string]*entity.Flag{},
+		tagCache: map[string]map[uint]*entity.Flag{"web": {2: fs[1]}},
+	}}
+	for _, f := range fs {
+		ec.cache.idCache[f.Key[5:]] = f
+		ec.cache.keyCache[f.Key] = f
+	}
+	defer gostub.StubFunc(&GetEvalCache, ec).Reset()
+
+	bootstrap := func(body *models.EvaluationBootstrapRequest) string {
+		body.EntityID = "entityID1"
+		body.EntityContext = map[string]interface{}{"dl_state": "CA"}
+		res := NewEval().PostEvaluationBootstrap(evaluation.PostEvaluationBootstrapParams{Body: body})
+		rec := httptest.NewRecorder()
+		res.WriteResponse(rec, nil)
+		assert.Equal(t, 200, rec.Code)
+		return rec.Body.String()
+	}
+
+	t.Run("all enabled flags", func(t *testing.T) {
+		assert.JSONEq(t, `{
+			"flag_1": {"variantKey": "treatment", "attachment": {"value": "</script>"}},
+			"flag_2": {"variantKey": "treatment", "attachment": {"value": "</script>"}},
+			"flag_4": {}
+		}`, bootstrap(&models.EvaluationBootstrapRequest{}))
+	})
+
+	t.Run("it's safe to be embedded in HTML", func(t *testing.T) {
+		body := bootstrap(&models.EvaluationBootstrapRequest{})
+		assert.NotContains(t, body, "</script>")
+		assert.Contains(t, body, `\u003c/script\u003e`)
+	})
+
+	t.Run("flags filtered by tags", func(t *testing.T) {
+		assert.JSONEq(t, `{
+			"flag_2": {"variantKey": "treatment", "attachment": {"value": "</script>"}}
+		}`, bootstrap(&models.EvaluationBootstrapRequest{FlagTags: []string{"web"}}))
+	})
+
+	t.Run("client visible flags", func(t *testing.T) {
+		assert.JSONEq(t, `{
+			"flag_1": {"variantKey": "treatment", "attachment": {"value": "</script>"}}
+		}`, bootstrap(&models.EvaluationBootstrapRequest{ClientVisibleOnly: true}))
+	})
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -20,9 +21,11 @@ var (
 )
 
 type cacheContainer struct {
-	idCache  map[string]*entity.Flag
-	keyCache map[string]*entity.Flag
-	tagCache map[string]map[uint]*entity.Flag
+	idCache       map[string]*entity.Flag
+	keyCache      map[string]*entity.Flag
+	tagCache      map[string]map[uint]*entity.Flag
+	audienceCache map[uint]*entity.Audience
+	idListCache   map[string]*entity.IDList
 }
 
 // EvalCache is the in-memory cache just for evaluation
@@ -31,6 +34,8 @@ type EvalCache struct {
 	cacheMutex      sync.RWMutex
 	refreshTimeout  time.Duration
 	refreshInterval time.Duration
+
+	changes flagChangeHub
 }
 
 // GetEvalCache gets the EvalCache
@@ -133,6 +138,19 @@ func (ec *EvalCache) getByTagsALL(tags []string) map[uint]*entity.Flag {
 	return results
 }
 
+// GetAll gets all the flags in the order of their IDs
+func (ec *EvalCache) GetAll() []*entity.Flag {
+	ec.cacheMutex.RLock()
+	defer ec.cacheMutex.RUnlock()
+
+	fs := make([]*entity.Flag, 0, len(ec.cache.idCache))
+	for _, f := range ec.cache.idCache {
+		fs = append(fs, f)
+	}
+	sort.Slice(fs, func(i, j int) bool { return fs[i].ID < fs[j].ID })
+	return fs
+}
+
 // GetByFlagKeyOrID gets the flag by Key or ID
 func (ec *EvalCache) GetByFlagKeyOrID(keyOrID interface{}) *entity.Flag {
 	s := util.SafeString(keyOrID)
@@ -153,21 +171,49 @@ func (ec *EvalCache) reloadMapCache() error {
 	}
 
 	_, _, err := withtimeout.Do(ec.refreshTimeout, func() (interface{}, error) {
-		idCache, keyCache, tagCache, err := ec.fetchAllFlags()
+		cache, err := ec.fetchAll()
 		if err != nil {
 			return nil, err
 		}
 
 		ec.cacheMutex.Lock()
-		ec.cache = &cacheContainer{
-			idCache:  idCache,
-			keyCache: keyCache,
-			tagCache: tagCache,
-		}
+		old := ec.cache
+		ec.cache = cache
 		ec.cacheMutex.Unlock()
 
+		// the first load is not a change
+		if old.idCache != nil {
+			if changes := diffEvalCache(old, cache); changes != nil {
+				ec.changes.publish(changes)
+			}
+		}
+
 		return nil, err
 	})
 
 	return err
 }
+
+// prepareFlag prepares the flag that's not from the cache for evaluation, e.g. the flag of
+// a dry run, linking the audiences and the ID lists of the cache. It returns the IDs of
+// the audiences referenced by the segments but missing from the cache.
+func (ec *EvalCache) prepareFlag(f *entity.Flag) ([]uint, error) {
+	if err := f.PrepareEvaluation(); err != nil {
+		return nil, err
+	}
+
+	ec.cacheMutex.RLock()
+	defer ec.cacheMutex.RUnlock()
+
+	missing := []uint{}
+	for _, s := range f.Segments {
+		for _, a := range s.Audiences {
+			if ec.cache.audienceCache[a.ID] == nil {
+				missing = append(missing, a.ID)
+			}
+		}
+	}
+	f.LinkAudiences(ec.cache.audienceCache)
+	f.LinkIDLists(ec.cache.idListCache)
+	return missing, nil
+}
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// flagChangeBufferSize is the number of the pending changes of a subscriber
+const flagChangeBufferSize = 16
+
+// flagChangeHub broadcasts the flag changes picked up by the reloads of the EvalCache
+// to the subscribers, e.g. the flag changes streams. The zero value is ready to use.
+type flagChangeHub struct {
+	mu          sync.Mutex
+	subscribers map[chan *models.FlagChanges]struct{}
+}
+
+// subscribe subscribes to the flag changes. The channel is closed by unsubscribe, or when
+// the subscriber falls behind, so that it can start over with a full refresh instead of
+// missing changes silently.
+func (h *flagChangeHub) subscribe() (<-chan *models.FlagChanges, func()) {
+	ch := make(chan *models.FlagChanges, flagChangeBufferSize)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.subscribers == nil {
+		h.subscribers = make(map[chan *models.FlagChanges]struct{})
+	}
+	h.subscribers[ch] = struct{}{}
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.remove(ch)
+	}
+}
+
+func (h *flagChangeHub) publish(changes *models.FlagChanges) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers {
+		select {
+		case ch <- changes:
+		default:
+			h.remove(ch)
+		}
+	}
+}
+
+func (h *flagChangeHub) remove(ch chan *models.FlagChanges) {
+	if _, ok := h.subscribers[ch]; ok {
+		delete(h.subscribers, ch)
+		close(ch)
+	}
+}
+
+// diffEvalCache gets the changes between two reloads of the EvalCache, or nil if nothing changed.
+// Besides the changed flags, the flags referencing the changed audiences or ID lists are changed,
+// since their evaluation results may change.
+func diffEvalCache(old, cur *cacheContainer) *models.FlagChanges {
+	idLists := diffIDLists(old.idListCache, cur.idListCache)
+	audiences := diffAudiences(old.audienceCache, cur.audienceCache, idLists)
+	changes := diffFlags(old.idCache, cur.idCache)
+	if len(changes) == 0 && len(audiences) == 0 && len(idLists) == 0 {
+		return nil
+	}
+
+	changed := make(map[int64]bool, len(changes))
+	for _, c := range changes {
+		changed[*c.FlagID] = true
+	}
+	for _, f := range cur.idCache {
+		if !changed[int64(f.ID)] && referencesChanges(f, audiences, idLists) {
+			changes = append(changes, mapFlagChange(f, false))
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return *changes[i].FlagID < *changes[j].FlagID })
+
+	fc := &models.FlagChanges{Flags: changes}
+	for _, key := range audiences {
+		fc.AudienceKeys = append(fc.AudienceKeys, key)
+	}
+	for key := range idLists {
+		fc.IDListKeys = append(fc.IDListKeys, key)
+	}
+	sort.Strings(fc.AudienceKeys)
+	sort.Strings(fc.IDListKeys)
+	return fc
+}
+
+// diffIDLists gets the keys of the ID lists added, deleted or changed between two reloads. An ID
+// list is changed if it's saved again, e.g. its entries are updated, or its size changes.
+func diffIDLists(old, cur map[string]*entity.IDList) map[string]bool {
+	changed := map[string]bool{}
+	for key, l := range cur {
+		o, ok := old[key]
+		if ok && o.UpdatedAt.Equal(l.UpdatedAt) && len(o.Values) == len(l.Values) {
+			continue
+		}
+		changed[key] = true
+	}
+	for key := range old {
+		if _, ok := cur[key]; !ok {
+			changed[key] = true
+		}
+	}
+	return changed
+}
+
+// diffAudiences gets the keys by the IDs of the audiences added, deleted or changed between two
+// reloads, including the audiences referencing the changed ID lists
+func diffAudiences(old, cur map[uint]*entity.Audience, idLists map[string]bool) map[uint]string {
+	changed := map[uint]string{}
+	for id, a := range cur {
+		o, ok := old[id]
+		if !ok || !o.UpdatedAt.Equal(a.UpdatedAt) || audienceCondition(o) != audienceCondition(a) {
+			changed[id] = a.Key
+			continue
+		}
+		for _, key := range a.IDListKeys() {
+			if idLists[key] {
+				changed[id] = a.Key
+				break
+			}
+		}
+	}
+	for id, o := range old {
+		if _, ok := cur[id]; !ok {
+			changed[id] = o.Key
+		}
+	}
+	return changed
+}
+
+func audienceCondition(a *entity.Audience) string {
+	if a.AudienceEvaluation.ConditionsExpr == nil {
+		return ""
+	}
+	return a.AudienceEvaluation.ConditionsExpr.String()
+}
+
+// referencesChanges returns whether any segment of the flag references the changed audiences or
+// ID lists
+func referencesChanges(f *entity.Flag, audiences map[uint]string, idLists map[string]bool) bool {
+	for _, s := range f.Segments {
+		for _, a := range s.Audiences {
+			if _, ok := audiences[a.ID]; ok {
+				return true
+			}
+		}
+		for _, key := range s.IDListKeys() {
+			if idLists[key] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// diffFlags gets the changes between the flags of two reloads of the EvalCache in the order
+// of the flag IDs. A flag is changed if it's saved with a new snapshot, and the update time
+// covers the flags loaded from the JSON files without snapshots.
+func diffFlags(old, cur map[string]*entity.Flag) []*models.FlagChange {
+	changes := []*models.FlagChange{}
+	for id, f := range cur {
+		o, ok := old[id]
+		if ok && o.SnapshotID == f.SnapshotID && o.UpdatedAt.Equal(f.UpdatedAt) {
+			continue
+		}
+		changes = append(changes, mapFlagChange(f, false))
+	}
+	for id, o := range old {
+		if _, ok := cur[id]; !ok {
+			changes = append(changes, mapFlagChange(o, true))
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return *changes[i].FlagID < *changes[j].FlagID })
+	return changes
+}
+
+func mapFlagChange(f *entity.Flag, deleted bool) *models.FlagChange {
+	return &models.FlagChange{
+		FlagID:         util.Int64Ptr(int64(f.ID)),
+		FlagKey:        util.StringPtr(f.Key),
+		FlagSnapshotID: util.Int64Ptr(int64(f.SnapshotID)),
+		Deleted:        util.BoolPtr(deleted),
+	}
+}
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/evaluator"
+	"github.com/openflagr/flagr/swagger_gen/models"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestFlagChangeHub(t *testing.T) {
+	t.Run("publish to the subscribers", func(t *testing.T) {
+		h := &flagChangeHub{}
+		ch1, unsubscribe1 := h.subscribe()
+		ch2, unsubscribe2 := h.subscribe()
+		defer unsubscribe2()
+
+		c := &models.FlagChanges{}
+		h.publish(c)
+		assert.Same(t, c, <-ch1)
+		assert.Same(t, c, <-ch2)
+
+		unsubscribe1()
+		_, ok := <-ch1
+		assert.False(t, ok)
+		assert.NotPanics(t, unsubscribe1)
+
+		h.publish(c)
+		assert.Same(t, c, <-ch2)
+	})
+
+	t.Run("drop the subscriber falling behind", func(t *testing.T) {
+		h := &flagChangeHub{}
+		ch, unsubscribe := h.subscribe()
+		defer unsubscribe()
+
+		for i := 0; i <= flagChangeBufferSize; i++ {
+			h.publish(&models.FlagChanges{})
+		}
+		for i := 0; i < flagChangeBufferSize; i++ {
+			_, ok := <-ch
+			assert.True(t, ok)
+		}
+		_, ok := <-ch
+		assert.False(t, ok)
+	})
+}
+
+func TestDiffFlags(t *testing.T) {
+	f1 := &entity.Flag{Key: "f1", SnapshotID: 1}
+	f1.ID = 1
+	f2 := &entity.Flag{Key: "f2", SnapshotID: 2}
+	f2.ID = 2
+	f3 := &entity.Flag{Key: "f3", SnapshotID: 3}
+	f3.ID = 3
+	f2Updated := &entity.Flag{Key: "f2", SnapshotID: 4}
+	f2Updated.ID = 2
+
+	t.Run("no changes", func(t *testing.T) {
+		old := map[string]*entity.Flag{"1": f1, "2": f2}
+		assert.Empty(t, diffFlags(old, map[string]*entity.Flag{"1": f1, "2": f2}))
+	})
+
+	t.Run("added, updated and deleted flags", func(t *testing.T) {
+		old := map[string]*entity.Flag{"1": f1, "2": f2}
+		changes := diffFlags(old, map[string]*entity.Flag{"2": f2Updated, "3": f3})
+		assert.Equal(t, []*models.FlagChange{
+			mapFlagChange(f1, true),
+			mapFlagChange(f2Updated, false),
+			mapFlagChange(f3, false),
+		}, changes)
+		assert.Equal(t, int64(4), *changes[1].FlagSnapshotID)
+	})
+}
+
+func TestDiffEvalCache(t *testing.T) {
+	genCache := func(mutate func(ecj *evaluator.EvalCacheJSON)) *cacheContainer {
+		f := entity.GenFixtureFlag()
+		f.Segments[0].Audiences = []entity.Audience{{Model: gorm.Model{ID: 1}}}
+		other := entity.GenFixtureFlag()
+		other.ID, other.Key = 101, "flag_key_101"
+		other.Segments[0].Constraints = entity.ConstraintArray{
+			{Property: "user_id", Operator: models.ConstraintOperatorINLIST, Value: `"beta_users"`},
+		}
+		unrelated := entity.GenFixtureFlag()
+		unrelated.ID, unrelated.Key = 102, "flag_key_102"
+
+		ecj := &evaluator.EvalCacheJSON{
+			Flags: []entity.Flag{f, other, unrelated},
+			Audiences: []entity.Audience{{
+				Model:       gorm.Model{ID: 1},
+				Key:         "ca_drivers",
+				Constraints: entity.ConstraintArray{{Property: "dl_state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`}},
+			}},
+			IDLists: []entity.IDList{{Key: "beta_users", Values: []string{"u1"}}},
+		}
+		if mutate != nil {
+			mutate(ecj)
+		}
+		fs, err := evaluator.PrepareFlags(ecj)
+		assert.NoError(t, err)
+		return &cacheContainer{idCache: fs.ByID, audienceCache: fs.Audiences, idListCache: fs.IDLists}
+	}
+	flagKeys := func(c *models.FlagChanges) []string {
+		keys := []string{}
+		for _, f := range c.Flags {
+			keys = append(keys, *f.FlagKey)
+		}
+		return keys
+	}
+
+	t.Run("no changes", func(t *testing.T) {
+		assert.Nil(t, diffEvalCache(genCache(nil), genCache(nil)))
+	})
+
+	t.Run("audience changed", func(t *testing.T) {
+		c := diffEvalCache(genCache(nil), genCache(func(ecj *evaluator.EvalCacheJSON) {
+			ecj.Audiences[0].Constraints[0].Value = `"NY"`
+		}))
+		assert.Equal(t, []string{"ca_drivers"}, c.AudienceKeys)
+		assert.Empty(t, c.IDListKeys)
+		assert.Equal(t, []string{"flag_key_100"}, flagKeys(c))
+		assert.Equal(t, int64(0), *c.Flags[0].FlagSnapshotID)
+	})
+
+	t.Run("audience deleted", func(t *testing.T) {
+		c := diffEvalCache(genCache(nil), genCache(func(ecj *evaluator.EvalCacheJSON) {
+			ecj.Audiences = nil
+		}))
+		assert.Equal(t, []string{"ca_drivers"}, c.AudienceKeys)
+		assert.Equal(t, []string{"flag_key_100"}, flagKeys(c))
+	})
+
+	t.Run("ID list entries changed", func(t *testing.T) {
+		c := diffEvalCache(genCache(nil), genCache(func(ecj *evaluator.EvalCacheJSON) {
+			ecj.IDLists[0].Values = append(ecj.IDLists[0].Values, "u2")
+		}))
+		assert.Equal(t, []string{"beta_users"}, c.IDListKeys)
+		assert.Equal(t, []string{"flag_key_101"}, flagKeys(c))
+	})
+
+	t.Run("ID list of an audience changed", func(t *testing.T) {
+		c := diffEvalCache(genCache(func(ecj *evaluator.EvalCacheJSON) {
+			ecj.Audiences[0].Constraints[0] = entity.Constraint{
+				Property: "user_id", Operator: models.ConstraintOperatorINLIST, Value: `"beta_users"`,
+			}
+		}), genCache(func(ecj *evaluator.EvalCacheJSON) {
+			ecj.Audiences[0].Constraints[0] = entity.Constraint{
+				Property: "user_id", Operator: models.ConstraintOperatorINLIST, Value: `"beta_users"`,
+			}
+			ecj.IDLists[0].UpdatedAt = time.Now()
+		}))
+		assert.Equal(t, []string{"ca_drivers"}, c.AudienceKeys)
+		assert.Equal(t, []string{"beta_users"}, c.IDListKeys)
+		assert.Equal(t, []string{"flag_key_100", "flag_key_101"}, flagKeys(c))
+	})
+}
+
+func TestReloadMapCachePublishesFlagChanges(t *testing.T) {
+	fixtureFlag := entity.GenFixtureFlag()
+	db := entity.PopulateTestDB(fixtureFlag)
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	ec := &EvalCache{cache: &cacheContainer{}, refreshTimeout: time.Second}
+	changes, unsubscribe := ec.changes.subscribe()
+	defer unsubscribe()
+
+	// the first load is not a change
+	assert.NoError(t, ec.reloadMapCache())
+	assert.NoError(t, ec.reloadMapCache())
+	assert.Len(t, changes, 0)
+
+	db.Model(&entity.Flag{}).Where("id = ?", fixtureFlag.ID).Update("description", "updated")
+	entity.SaveFlagSnapshot(db, fixtureFlag.ID, "flagr-test")
+	f := &entity.Flag{}
+	db.First(f, fixtureFlag.ID)
+
+	assert.NoError(t, ec.reloadMapCache())
+	assert.Len(t, changes, 1)
+	c := <-changes
+	assert.Len(t, c.Flags, 1)
+	assert.Equal(t, "flag_key_100", *c.Flags[0].FlagKey)
+	assert.Equal(t, int64(f.SnapshotID), *c.Flags[0].FlagSnapshotID)
+	assert.False(t, *c.Flags[0].Deleted)
+}
@@ -2,22 +2,15 @@ package handler
 
 import (
 	"fmt"
-	"io"
-	"net/http"
-	"os"
-
-	"encoding/json"
 
 	"github.com/openflagr/flagr/pkg/config"
 	"github.com/openflagr/flagr/pkg/entity"
-	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/pkg/evaluator"
 	"gorm.io/gorm"
 )
 
-// EvalCacheJSON is the JSON serialization format of EvalCache's flags
-type EvalCacheJSON struct {
-	Flags []entity.Flag
-}
+// EvalCacheJSON is the JSON serialization format of EvalCache's flags, audiences and ID lists
+type EvalCacheJSON = evaluator.EvalCacheJSON
 
 func (ec *EvalCache) export() EvalCacheJSON {
 	ec.cacheMutex.RLock()
@@ -29,45 +22,42 @@ func (ec *EvalCache) export() EvalCacheJSON {
 		ff := *f
 		fs = append(fs, ff)
 	}
-	return EvalCacheJSON{Flags: fs}
+
+	audienceCache := ec.cache.audienceCache
+	as := make([]entity.Audience, 0, len(audienceCache))
+	for _, a := range audienceCache {
+		as = append(as, *a)
+	}
+
+	idListCache := ec.cache.idListCache
+	ls := make([]entity.IDList, 0, len(idListCache))
+	for _, l := range idListCache {
+		ls = append(ls, *l)
+	}
+	return EvalCacheJSON{Flags: fs, Audiences: as, IDLists: ls}
 }
 
-func (ec *EvalCache) fetchAllFlags() (idCache map[string]*entity.Flag, keyCache map[string]*entity.Flag, tagCache map[string]map[uint]*entity.Flag, err error) {
-	fs, err := fetchAllFlags()
+func (ec *EvalCache) fetchAll() (*cacheContainer, error) {
+	ecj, err := fetchEvalCacheJSON()
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, err
 	}
 
-	idCache = make(map[string]*entity.Flag)
-	keyCache = make(map[string]*entity.Flag)
-	tagCache = make(map[string]map[uint]*entity.Flag)
-
-	for i := range fs {
-		f := &fs[i]
-		if err := f.PrepareEvaluation(); err != nil {
-			return nil, nil, nil, err
-		}
-
-		if f.ID != 0 {
-			idCache[util.SafeString(f.ID)] = f
-		}
-		if f.Key != "" {
-			keyCache[f.Key] = f
-		}
-		if f.Tags != nil {
-			for _, s := range f.Tags {
-				if tagCache[s.Value] == nil {
-					tagCache[s.Value] = make(map[uint]*entity.Flag)
-				}
-				tagCache[s.Value][f.ID] = f
-			}
-		}
+	fs, err := evaluator.PrepareFlags(ecj)
+	if err != nil {
+		return nil, err
 	}
-	return idCache, keyCache, tagCache, nil
+	return &cacheContainer{
+		idCache:       fs.ByID,
+		keyCache:      fs.ByKey,
+		tagCache:      fs.ByTag,
+		audienceCache: fs.Audiences,
+		idListCache:   fs.IDLists,
+	}, nil
 }
 
 type evalCacheFetcher interface {
-	fetch() ([]entity.Flag, error)
+	fetch() (*EvalCacheJSON, error)
 }
 
 func newFetcher() (evalCacheFetcher, error) {
@@ -88,7 +78,7 @@ func newFetcher() (evalCacheFetcher, error) {
 	}
 }
 
-var fetchAllFlags = func() ([]entity.Flag, error) {
+var fetchEvalCacheJSON = func() (*EvalCacheJSON, error) {
 	fetcher, err := newFetcher()
 	if err != nil {
 		return nil, err
@@ -96,56 +86,51 @@ var fetchAllFlags = func() ([]entity.Flag, error) {
 	return fetcher.fetch()
 }
 
-type jsonFileFetcher struct {
-	filePath string
-}
-
-func (ff *jsonFileFetcher) fetch() ([]entity.Flag, error) {
-	b, err := os.ReadFile(ff.filePath)
-	if err != nil {
-		return nil, err
-	}
-	ecj := &EvalCacheJSON{}
-	err = json.Unmarshal(b, ecj)
+var fetchAllFlags = func() ([]entity.Flag, error) {
+	ecj, err := fetchEvalCacheJSON()
 	if err != nil {
 		return nil, err
 	}
 	return ecj.Flags, nil
 }
 
-type jsonHTTPFetcher struct {
-	url string
+type jsonFileFetcher struct {
+	filePath string
 }
 
-func (hf *jsonHTTPFetcher) fetch() ([]entity.Flag, error) {
-	client := http.Client{Timeout: config.Config.EvalCacheRefreshTimeout}
-	res, err := client.Get(hf.url)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Body.Close()
+func (ff *jsonFileFetcher) fetch() (*EvalCacheJSON, error) {
+	return evaluator.FileSource(ff.filePath)()
+}
 
-	b, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, err
-	}
+type jsonHTTPFetcher struct {
+	url string
+}
 
-	ecj := &EvalCacheJSON{}
-	err = json.Unmarshal(b, ecj)
-	if err != nil {
-		return nil, err
-	}
-	return ecj.Flags, nil
+func (hf *jsonHTTPFetcher) fetch() (*EvalCacheJSON, error) {
+	return evaluator.URLSource(hf.url, config.Config.EvalCacheRefreshTimeout)()
 }
 
 type dbFetcher struct {
 	db *gorm.DB
 }
 
-func (df *dbFetcher) fetch() ([]entity.Flag, error) {
+func (df *dbFetcher) fetch() (*EvalCacheJSON, error) {
 	// Use eager loading to avoid N+1 problem
 	// doc: http://jinzhu.me/gorm/crud.html#preloading-eager-loading
 	fs := []entity.Flag{}
-	err := entity.PreloadSegmentsVariantsTags(df.db).Find(&fs).Error
-	return fs, err
+	if err := entity.PreloadSegmentsVariantsTags(df.db).Find(&fs).Error; err != nil {
+		return nil, err
+	}
+	as := []entity.Audience{}
+	if err := entity.PreloadAudienceConstraints(df.db).Find(&as).Error; err != nil {
+		return nil, err
+	}
+	ls := []entity.IDList{}
+	if err := df.db.Find(&ls).Error; err != nil {
+		return nil, err
+	}
+	if err := entity.LoadIDListValues(df.db, ls); err != nil {
+		return nil, err
+	}
+	return &EvalCacheJSON{Flags: fs, Audiences: as, IDLists: ls}, nil
 }
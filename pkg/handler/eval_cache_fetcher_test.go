@@ -1,28 +1,35 @@
 package handler
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/openflagr/flagr/pkg/config"
+	"github.com/openflagr/flagr/pkg/evaluator"
+	"github.com/openflagr/flagr/swagger_gen/models"
+
+	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestJSONFileFetcher(t *testing.T) {
 	t.Run("happy code path", func(t *testing.T) {
 		jff := &jsonFileFetcher{filePath: "./testdata/sample_eval_cache.json"}
-		fs, err := jff.fetch()
+		ecj, err := jff.fetch()
 		assert.NoError(t, err)
-		assert.NotZero(t, len(fs))
+		assert.NotZero(t, len(ecj.Flags))
 	})
 
 	t.Run("non-exists file path", func(t *testing.T) {
 		jff := &jsonFileFetcher{filePath: "./testdata/non-exists.json"}
-		fs, err := jff.fetch()
+		ecj, err := jff.fetch()
 		assert.Error(t, err)
-		assert.Zero(t, fs)
+		assert.Nil(t, ecj)
 	})
 }
 
@@ -37,16 +44,16 @@ func TestJSONHTTPFetcher(t *testing.T) {
 		defer server.Close()
 
 		jhf := &jsonHTTPFetcher{url: server.URL}
-		fs, err := jhf.fetch()
+		ecj, err := jhf.fetch()
 		assert.NoError(t, err)
-		assert.NotZero(t, len(fs))
+		assert.NotZero(t, len(ecj.Flags))
 	})
 
 	t.Run("non-exists file path", func(t *testing.T) {
 		jhf := &jsonHTTPFetcher{url: "http://invalid-url"}
-		fs, err := jhf.fetch()
+		ecj, err := jhf.fetch()
 		assert.Error(t, err)
-		assert.Zero(t, len(fs))
+		assert.Nil(t, ecj)
 	})
 }
 
@@ -97,3 +104,32 @@ func TestNewFetcher(t *testing.T) {
 		assert.Nil(t, fetcher)
 	})
 }
+
+func TestEvaluatorClientParity(t *testing.T) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+	ec := GenFixtureEvalCache()
+	defer gostub.StubFunc(&GetEvalCache, ec).Reset()
+
+	b, err := json.Marshal(ec.export())
+	assert.NoError(t, err)
+	p := filepath.Join(t.TempDir(), "eval_cache.json")
+	assert.NoError(t, os.WriteFile(p, b, 0600))
+
+	c, err := evaluator.NewClient(evaluator.FileSource(p), evaluator.Options{})
+	assert.NoError(t, err)
+	defer c.Close()
+
+	for i := 0; i < 1000; i++ {
+		evalContext := models.EvalContext{
+			EntityID:      fmt.Sprintf("entityID%d", i),
+			EntityContext: map[string]interface{}{"dl_state": []string{"CA", "NY"}[i%2]},
+			FlagKey:       "flag_key_100",
+		}
+		expected := EvalFlag(evalContext)
+		actual := c.Evaluate(evalContext)
+		assert.Equal(t, expected.Reason, actual.Reason)
+		assert.Equal(t, expected.SegmentID, actual.SegmentID)
+		assert.Equal(t, expected.VariantID, actual.VariantID)
+		assert.Equal(t, expected.VariantKey, actual.VariantKey)
+	}
+}
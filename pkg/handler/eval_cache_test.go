@@ -71,3 +71,78 @@ func TestGetByTags(t *testing.T) {
 	f = ec.GetByTags(tags, &all)
 	assert.Len(t, f, 0)
 }
+
+func TestReloadMapCacheWithAudiences(t *testing.T) {
+	fixtureFlag := entity.GenFixtureFlag()
+	db := entity.PopulateTestDB(fixtureFlag)
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	a := entity.Audience{
+		Key: "ca_drivers",
+		Constraints: []entity.Constraint{
+			{Property: "dl_state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`},
+		},
+	}
+	db.Create(&a)
+	db.Model(&entity.Segment{Model: fixtureFlag.Segments[0].Model}).Association("Audiences").Append(&a)
+
+	ec := GetEvalCache()
+	assert.NoError(t, ec.reloadMapCache())
+
+	f := ec.GetByFlagKeyOrID(fixtureFlag.ID)
+	compiled := f.Segments[0].SegmentEvaluation.Audiences
+	assert.Len(t, compiled, 1)
+	assert.NotNil(t, compiled[0].AudienceEvaluation.ConditionsExpr)
+	assert.Same(t, ec.cache.audienceCache[a.ID], compiled[0])
+
+	ecj := ec.export()
+	assert.Len(t, ecj.Audiences, 1)
+	assert.Equal(t, "ca_drivers", ecj.Audiences[0].Key)
+}
+
+func TestReloadMapCacheWithIDLists(t *testing.T) {
+	fixtureFlag := entity.GenFixtureFlag()
+	db := entity.PopulateTestDB(fixtureFlag)
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	l := entity.IDList{Key: "beta_users"}
+	db.Create(&l)
+	assert.NoError(t, entity.AppendIDListEntries(db, l.ID, []string{"u1", "u2"}))
+	db.Create(&entity.Constraint{
+		SegmentID: fixtureFlag.Segments[0].ID,
+		Property:  "user_id",
+		Operator:  models.ConstraintOperatorINLIST,
+		Value:     `"beta_users"`,
+	})
+
+	ec := GetEvalCache()
+	assert.NoError(t, ec.reloadMapCache())
+
+	f := ec.GetByFlagKeyOrID(fixtureFlag.ID)
+	expr := f.Segments[0].SegmentEvaluation.ConditionsExpr
+	match, err := expr.Evaluate(map[string]interface{}{"dl_state": "CA", "user_id": "u2"})
+	assert.NoError(t, err)
+	assert.True(t, match)
+	match, err = expr.Evaluate(map[string]interface{}{"dl_state": "CA", "user_id": "u3"})
+	assert.NoError(t, err)
+	assert.False(t, match)
+
+	ecj := ec.export()
+	assert.Len(t, ecj.IDLists, 1)
+	assert.Equal(t, "beta_users", ecj.IDLists[0].Key)
+	assert.Equal(t, []string{"u1", "u2"}, ecj.IDLists[0].Values)
+}
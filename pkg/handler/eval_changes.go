@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openflagr/flagr/pkg/config"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
+	"github.com/sirupsen/logrus"
+)
+
+// GetEvaluationChanges streams the flag changes picked up by the EvalCache as server-sent events.
+// The stream ends when the client disconnects, or when the client falls behind the changes.
+func (e *eval) GetEvaluationChanges(params evaluation.GetEvaluationChangesParams) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		flusher, ok := rw.(http.Flusher)
+		if !ok {
+			http.Error(rw, "streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+
+		changes, unsubscribe := GetEvalCache().changes.subscribe()
+		defer unsubscribe()
+
+		rw.Header().Set("Content-Type", "text/event-stream")
+		rw.Header().Set("Cache-Control", "no-cache")
+		rw.Header().Set("X-Accel-Buffering", "no")
+		rw.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		// no heartbeats if the interval is not positive, the nil channel never fires
+		var heartbeat <-chan time.Time
+		if interval := config.Config.EvalChangesHeartbeatInterval; interval > 0 {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			heartbeat = ticker.C
+		}
+
+		for {
+			var err error
+			select {
+			case <-params.HTTPRequest.Context().Done():
+				return
+			case c, ok := <-changes:
+				if !ok {
+					return
+				}
+				err = writeFlagChangesEvent(rw, c)
+			case <-heartbeat:
+				_, err = fmt.Fprint(rw, ": heartbeat\n\n")
+			}
+			if err != nil {
+				logrus.WithField("err", err).Debug("failed to write the flag changes stream")
+				return
+			}
+			flusher.Flush()
+		}
+	})
+}
+
+func writeFlagChangesEvent(rw http.ResponseWriter, changes interface{}) error {
+	b, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(rw, "event: flag_changes\ndata: %s\n\n", b)
+	return err
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openflagr/flagr/pkg/config"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEvaluationChanges(t *testing.T) {
+	ec := &EvalCache{cache: &cacheContainer{}}
+	defer gostub.StubFunc(&GetEvalCache, ec).Reset()
+	defer gostub.Stub(&config.Config.EvalChangesHeartbeatInterval, 10*time.Millisecond).Reset()
+
+	e := NewEval()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := e.GetEvaluationChanges(evaluation.GetEvaluationChangesParams{HTTPRequest: r})
+		res.WriteResponse(w, nil)
+	}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	reader := bufio.NewReader(resp.Body)
+	readEvent := func() string {
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			if !assert.NoError(t, err) || line == "\n" {
+				return strings.Join(lines, "")
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	// it's subscribed before the response header is written
+	ec.changes.publish(&models.FlagChanges{Flags: []*models.FlagChange{{
+		FlagID:         util.Int64Ptr(100),
+		FlagKey:        util.StringPtr("flag_key_100"),
+		FlagSnapshotID: util.Int64Ptr(2),
+		Deleted:        util.BoolPtr(false),
+	}}})
+
+	event := readEvent()
+	for event == ": heartbeat\n" {
+		event = readEvent()
+	}
+	assert.Equal(t,
+		"event: flag_changes\n"+
+			`data: {"flags":[{"deleted":false,"flagID":100,"flagKey":"flag_key_100","flagSnapshotID":2}]}`+"\n",
+		event,
+	)
+	assert.Equal(t, ": heartbeat\n", readEvent())
+}
+
+func TestGetEvaluationChangesWithoutHeartbeats(t *testing.T) {
+	ec := &EvalCache{cache: &cacheContainer{}}
+	defer gostub.StubFunc(&GetEvalCache, ec).Reset()
+	defer gostub.Stub(&config.Config.EvalChangesHeartbeatInterval, time.Duration(0)).Reset()
+
+	e := NewEval()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := e.GetEvaluationChanges(evaluation.GetEvaluationChangesParams{HTTPRequest: r})
+		res.WriteResponse(w, nil)
+	}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	ec.changes.publish(&models.FlagChanges{Flags: []*models.FlagChange{}, IDListKeys: []string{"beta_users"}})
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	assert.NoError(t, err)
+	assert.Equal(t, "event: flag_changes\n", line)
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/mapper/entity_restapi/r2e"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
+)
+
+// PostEvaluationDryRun evaluates the unsaved flag definition with the entities. Nothing is
+// saved, and the results are not logged or recorded.
+func (e *eval) PostEvaluationDryRun(params evaluation.PostEvaluationDryRunParams) middleware.Responder {
+	f, err := prepareDryRunFlag(params.Body.Flag)
+	if err != nil {
+		return evaluation.NewPostEvaluationDryRunDefault(err.StatusCode).WithPayload(
+			ErrorMessage(err.Message, err.Values...))
+	}
+
+	results := &models.EvaluationDryRunResponse{EvaluationResults: []*models.EvalResult{}}
+	for _, entity := range params.Body.Entities {
+		evalContext := models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: entity.EntityContext,
+			EntityID:      entity.EntityID,
+			EntityType:    entity.EntityType,
+			FlagID:        int64(f.ID),
+			FlagKey:       f.Key,
+		}
+		r := newEvaluator().Evaluate(f, evalContext)
+		results.EvaluationResults = append(results.EvaluationResults, r)
+	}
+
+	resp := evaluation.NewPostEvaluationDryRunOK()
+	resp.SetPayload(results)
+	return resp
+}
+
+// prepareDryRunFlag maps and validates the unsaved flag definition, and prepares it for
+// evaluation with the audiences and the ID lists of the EvalCache
+func prepareDryRunFlag(body interface{}) (*entity.Flag, *Error) {
+	f, err := mapDryRunFlag(body)
+	if err != nil {
+		return nil, NewError(400, "invalid flag. reason: %s", err)
+	}
+	if err := validateDryRunFlag(f); err != nil {
+		return nil, err
+	}
+
+	// the segments are evaluated in the order of their ranks, like the saved flags
+	sort.SliceStable(f.Segments, func(i, j int) bool {
+		return f.Segments[i].Rank < f.Segments[j].Rank
+	})
+	missing, err := GetEvalCache().prepareFlag(f)
+	if err != nil {
+		return nil, NewError(400, "invalid flag. reason: %s", err)
+	}
+	if len(missing) != 0 {
+		return nil, NewError(400, "audiences %v not found", missing)
+	}
+	return f, nil
+}
+
+// mapDryRunFlag maps the flag of the dry run, which is in the format of the flag returned
+// by the API. It's validated without the read-only check, so that it can have the IDs.
+func mapDryRunFlag(body interface{}) (*entity.Flag, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	r := &models.Flag{}
+	if err := r.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+	if err := r.Validate(strfmt.Default); err != nil {
+		return nil, err
+	}
+	return r2e.MapFlag(r)
+}
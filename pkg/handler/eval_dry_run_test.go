@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestPostEvaluationDryRun(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+
+	logged := 0
+	defer gostub.Stub(&logEvalResult, func(r *models.EvalResult, dataRecordsEnabled bool) {
+		logged++
+	}).Reset()
+
+	genFlagBody := func(modify func(f *entity.Flag)) interface{} {
+		f := entity.GenFixtureFlag()
+		modify(&f)
+		return genDryRunFlagBody(t, &f)
+	}
+	dryRun := func(flag interface{}, entities ...*models.EvaluationEntity) interface{} {
+		return NewEval().PostEvaluationDryRun(evaluation.PostEvaluationDryRunParams{
+			Body: &models.EvaluationDryRunRequest{Flag: flag, Entities: entities},
+		})
+	}
+
+	t.Run("happy code path", func(t *testing.T) {
+		res := dryRun(
+			genFlagBody(func(f *entity.Flag) { f.ID = 1000 }),
+			&models.EvaluationEntity{EntityID: "entityID1", EntityContext: map[string]interface{}{"dl_state": "CA"}},
+			&models.EvaluationEntity{EntityID: "entityID2", EntityContext: map[string]interface{}{"dl_state": "NY"}},
+		)
+		results := res.(*evaluation.PostEvaluationDryRunOK).Payload.EvaluationResults
+		assert.Len(t, results, 2)
+
+		assert.Equal(t, int64(1000), results[0].FlagID)
+		assert.Equal(t, models.EvalResultReasonMATCHED, results[0].Reason)
+		assert.NotZero(t, results[0].VariantID)
+		assert.NotEmpty(t, results[0].EvalDebugLog.SegmentDebugLogs)
+
+		assert.Equal(t, models.EvalResultReasonNOMATCH, results[1].Reason)
+		assert.Zero(t, results[1].VariantID)
+		assert.Len(t, results[1].EvalDebugLog.SegmentDebugLogs[0].ConstraintTraces, 1)
+
+		assert.Zero(t, logged)
+	})
+
+	t.Run("segments are evaluated in the order of their ranks", func(t *testing.T) {
+		res := dryRun(
+			genFlagBody(func(f *entity.Flag) {
+				s := entity.GenFixtureSegment()
+				s.ID = 201
+				s.Description = "segment 201"
+				s.Constraints = nil
+				s.Distributions = []entity.Distribution{{VariantID: 301, VariantKey: "treatment", Percent: 100}}
+				f.Segments[0].Rank = 1
+				f.Segments = append(f.Segments, s)
+			}),
+			&models.EvaluationEntity{EntityID: "entityID1", EntityContext: map[string]interface{}{"dl_state": "CA"}},
+		)
+		results := res.(*evaluation.PostEvaluationDryRunOK).Payload.EvaluationResults
+		assert.Equal(t, int64(201), results[0].SegmentID)
+		assert.Equal(t, "treatment", results[0].VariantKey)
+	})
+
+	t.Run("invalid flag format", func(t *testing.T) {
+		body := genFlagBody(func(f *entity.Flag) {})
+		delete(body.(map[string]interface{}), "enabled")
+		res := dryRun(body, &models.EvaluationEntity{EntityID: "entityID1"})
+		assert.NotZero(t, res.(*evaluation.PostEvaluationDryRunDefault).Payload)
+	})
+
+	t.Run("invalid distributions", func(t *testing.T) {
+		res := dryRun(
+			genFlagBody(func(f *entity.Flag) { f.Segments[0].Distributions[0].Percent = 10 }),
+			&models.EvaluationEntity{EntityID: "entityID1"},
+		)
+		assert.Contains(t, *res.(*evaluation.PostEvaluationDryRunDefault).Payload.Message, "is not 100")
+	})
+
+	t.Run("invalid constraint", func(t *testing.T) {
+		res := dryRun(
+			genFlagBody(func(f *entity.Flag) { f.Segments[0].Constraints[0].Value = `"CA` }),
+			&models.EvaluationEntity{EntityID: "entityID1"},
+		)
+		assert.Contains(t, *res.(*evaluation.PostEvaluationDryRunDefault).Payload.Message, "invalid constraint of segment 200")
+	})
+
+	t.Run("missing audience", func(t *testing.T) {
+		res := dryRun(
+			genFlagBody(func(f *entity.Flag) { f.Segments[0].Audiences = []entity.Audience{{Model: gorm.Model{ID: 9}}} }),
+			&models.EvaluationEntity{EntityID: "entityID1"},
+		)
+		assert.Equal(t, "audiences [9] not found", *res.(*evaluation.PostEvaluationDryRunDefault).Payload.Message)
+	})
+}
+
+// genDryRunFlagBody generates the flag definition of a dry run in the format of the API, with
+// the descriptions required by the API
+func genDryRunFlagBody(t *testing.T, f *entity.Flag) interface{} {
+	f.Description = fmt.Sprintf("flag %d", f.ID)
+	for i := range f.Segments {
+		f.Segments[i].Description = fmt.Sprintf("segment %d", f.Segments[i].ID)
+	}
+	r, err := e2r.MapFlag(f)
+	assert.NoError(t, err)
+	b, err := json.Marshal(r)
+	assert.NoError(t, err)
+	var body interface{}
+	assert.NoError(t, json.Unmarshal(b, &body))
+	return body
+}
@@ -0,0 +1,267 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/openflagr/flagr/pkg/config"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/proto_gen/flagrpb"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// grpcAuthPaths maps the gRPC methods to the evaluation endpoints of the REST API they mirror,
+// so that the auth whitelists of the endpoints apply to the methods
+var grpcAuthPaths = map[string]string{
+	flagrpb.Evaluation_Evaluate_FullMethodName:      "/api/v1/evaluation",
+	flagrpb.Evaluation_EvaluateBatch_FullMethodName: "/api/v1/evaluation/batch",
+	flagrpb.Evaluation_EvaluateAll_FullMethodName:   "/api/v1/evaluation/bootstrap",
+}
+
+// NewEvalGRPCServer creates the gRPC server of the evaluation service
+func NewEvalGRPCServer() *grpc.Server {
+	opts := []grpc.ServerOption{}
+	if interceptor := config.SetupGRPCAuthInterceptor(grpcAuthPaths); interceptor != nil {
+		opts = append(opts, grpc.UnaryInterceptor(interceptor))
+	}
+	s := grpc.NewServer(opts...)
+	flagrpb.RegisterEvaluationServer(s, &evalGRPC{})
+	return s
+}
+
+// startEvalGRPCServer serves the gRPC evaluation service, and stops it gracefully when the HTTP
+// server is shut down
+func startEvalGRPCServer(api *operations.FlagrAPI) error {
+	addr := fmt.Sprintf("%s:%d", config.Config.Host, config.Config.GRPCPort)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	logrus.Infof("Serving flagr gRPC evaluation at %s", lis.Addr())
+
+	s := NewEvalGRPCServer()
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			logrus.WithField("err", err).Error("gRPC evaluation server error")
+		}
+	}()
+
+	serverShutdown := api.ServerShutdown
+	api.ServerShutdown = func() {
+		s.GracefulStop()
+		logrus.Infof("Stopped serving flagr gRPC evaluation at %s", lis.Addr())
+		if serverShutdown != nil {
+			serverShutdown()
+		}
+	}
+	return nil
+}
+
+type evalGRPC struct {
+	flagrpb.UnimplementedEvaluationServer
+}
+
+func (e *evalGRPC) Evaluate(ctx context.Context, req *flagrpb.EvalContext) (*flagrpb.EvalResult, error) {
+	return mapGRPCEvalResult(EvalFlag(mapGRPCEvalContext(req)))
+}
+
+func (e *evalGRPC) EvaluateBatch(ctx context.Context, req *flagrpb.EvaluateBatchRequest) (*flagrpb.EvaluateBatchResponse, error) {
+	if len(req.Entities) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "entities is required")
+	}
+
+	body := &models.EvaluationBatchRequest{
+		EnableDebug:      req.EnableDebug,
+		FlagIDs:          req.FlagIds,
+		FlagKeys:         req.FlagKeys,
+		FlagTags:         req.FlagTags,
+		FlagTagsOperator: mapGRPCTagsOperator(req.FlagTagsOperator),
+	}
+	for _, e := range req.Entities {
+		body.Entities = append(body.Entities, mapGRPCEntity(e))
+	}
+
+	resp := &flagrpb.EvaluateBatchResponse{}
+	for _, rs := range evalBatch(body) {
+		for _, r := range rs {
+			result, err := mapGRPCEvalResult(r)
+			if err != nil {
+				return nil, err
+			}
+			resp.EvaluationResults = append(resp.EvaluationResults, result)
+		}
+	}
+	return resp, nil
+}
+
+func (e *evalGRPC) EvaluateAll(ctx context.Context, req *flagrpb.EvaluateAllRequest) (*flagrpb.EvaluateAllResponse, error) {
+	en := mapGRPCEntity(req.Entity)
+	evalContext := models.EvalContext{
+		EnableDebug:   req.EnableDebug,
+		EntityContext: en.EntityContext,
+		EntityID:      en.EntityID,
+		EntityType:    en.EntityType,
+	}
+
+	var results []*models.EvalResult
+	if len(req.FlagTags) > 0 {
+		evalContext.FlagTags = req.FlagTags
+		evalContext.FlagTagsOperator = mapGRPCTagsOperator(req.FlagTagsOperator)
+		results = EvalFlagsByTags(evalContext)
+		sort.Slice(results, func(i, j int) bool { return results[i].FlagID < results[j].FlagID })
+	} else {
+		for _, f := range GetEvalCache().GetAll() {
+			if !f.Enabled {
+				continue
+			}
+			c := evalContext
+			c.FlagID = int64(f.ID)
+			c.FlagKey = f.Key
+			results = append(results, EvalFlagWithContext(f, c))
+		}
+	}
+
+	resp := &flagrpb.EvaluateAllResponse{}
+	for _, r := range results {
+		result, err := mapGRPCEvalResult(r)
+		if err != nil {
+			return nil, err
+		}
+		resp.EvaluationResults = append(resp.EvaluationResults, result)
+	}
+	return resp, nil
+}
+
+func mapGRPCTagsOperator(o flagrpb.TagsOperator) *string {
+	if o == flagrpb.TagsOperator_ALL {
+		return util.StringPtr(models.EvalContextFlagTagsOperatorALL)
+	}
+	return util.StringPtr(models.EvalContextFlagTagsOperatorANY)
+}
+
+func mapGRPCEntity(e *flagrpb.EvaluationEntity) *models.EvaluationEntity {
+	r := &models.EvaluationEntity{
+		EntityID:   e.GetEntityId(),
+		EntityType: e.GetEntityType(),
+	}
+	if e.GetEntityContext() != nil {
+		r.EntityContext = e.GetEntityContext().AsMap()
+	}
+	return r
+}
+
+func mapGRPCEvalContext(c *flagrpb.EvalContext) models.EvalContext {
+	evalContext := models.EvalContext{
+		EnableDebug: c.EnableDebug,
+		EntityID:    c.EntityId,
+		EntityType:  c.EntityType,
+		FlagID:      c.FlagId,
+		FlagKey:     c.FlagKey,
+	}
+	if c.EntityContext != nil {
+		evalContext.EntityContext = c.EntityContext.AsMap()
+	}
+	return evalContext
+}
+
+func mapGRPCEvalResult(r *models.EvalResult) (*flagrpb.EvalResult, error) {
+	result := &flagrpb.EvalResult{
+		FlagId:         r.FlagID,
+		FlagKey:        r.FlagKey,
+		FlagSnapshotId: r.FlagSnapshotID,
+		FlagTags:       r.FlagTags,
+		SegmentId:      r.SegmentID,
+		VariantId:      r.VariantID,
+		VariantKey:     r.VariantKey,
+		Timestamp:      r.Timestamp,
+		ValueType:      r.ValueType,
+		Reason:         r.Reason,
+		SegmentRank:    -1,
+	}
+	if r.SegmentRank != nil {
+		result.SegmentRank = *r.SegmentRank
+	}
+
+	attachment, err := toGRPCStruct(r.VariantAttachment)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "invalid variantAttachment. reason: %s", err)
+	}
+	result.VariantAttachment = attachment
+
+	if c := r.EvalContext; c != nil {
+		entityContext, err := toGRPCStruct(c.EntityContext)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid entityContext. reason: %s", err)
+		}
+		result.EvalContext = &flagrpb.EvalContext{
+			EntityId:      c.EntityID,
+			EntityType:    c.EntityType,
+			EntityContext: entityContext,
+			EnableDebug:   c.EnableDebug,
+			FlagId:        c.FlagID,
+			FlagKey:       c.FlagKey,
+		}
+	}
+
+	if l := r.EvalDebugLog; l != nil {
+		result.EvalDebugLog = &flagrpb.EvalDebugLog{Msg: l.Msg}
+		for _, s := range l.SegmentDebugLogs {
+			log := &flagrpb.SegmentDebugLog{SegmentId: s.SegmentID, Msg: s.Msg}
+			for _, t := range s.ConstraintTraces {
+				trace, err := mapGRPCConstraintTrace(t)
+				if err != nil {
+					return nil, err
+				}
+				log.ConstraintTraces = append(log.ConstraintTraces, trace)
+			}
+			result.EvalDebugLog.SegmentDebugLogs = append(result.EvalDebugLog.SegmentDebugLogs, log)
+		}
+	}
+	return result, nil
+}
+
+func mapGRPCConstraintTrace(t *models.ConstraintTrace) (*flagrpb.ConstraintTrace, error) {
+	trace := &flagrpb.ConstraintTrace{
+		Property:    t.Property,
+		Operator:    t.Operator,
+		Value:       t.Value,
+		Result:      t.Result,
+		Msg:         t.Msg,
+		Group:       t.Group,
+		AudienceKey: t.AudienceKey,
+	}
+	if t.Actual != nil {
+		actual, err := structpb.NewValue(t.Actual)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid actual of the constraint trace. reason: %s", err)
+		}
+		trace.Actual = actual
+	}
+	return trace, nil
+}
+
+// toGRPCStruct maps a JSON object, e.g. a variant attachment, to the protobuf Struct
+func toGRPCStruct(v interface{}) (*structpb.Struct, error) {
+	var m map[string]interface{}
+	switch o := v.(type) {
+	case map[string]interface{}:
+		m = o
+	case entity.Attachment:
+		m = o
+	default:
+		return nil, nil
+	}
+	if len(m) == 0 {
+		return nil, nil
+	}
+	return structpb.NewStruct(m)
+}
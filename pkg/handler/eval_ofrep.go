@@ -0,0 +1,237 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/ofrep"
+)
+
+// The OpenFeature reasons of the OFREP evaluation results
+const (
+	ofrepReasonTargetingMatch = "TARGETING_MATCH"
+	ofrepReasonDefault        = "DEFAULT"
+)
+
+// ofrepTargetingKey is the attribute of the OpenFeature evaluation context mapped to the entityID
+const ofrepTargetingKey = "targetingKey"
+
+// ofrepError is an evaluation failure of OFREP, e.g. FLAG_NOT_FOUND
+type ofrepError struct {
+	code    string
+	details string
+}
+
+// PostOfrepEvaluateFlag evaluates the flag of the key with the OpenFeature Remote Evaluation Protocol.
+// Only the client visible flags are evaluated, the OFREP paths are in the default auth whitelists.
+func (e *eval) PostOfrepEvaluateFlag(params ofrep.PostOfrepEvaluateFlagParams) middleware.Responder {
+	evalContext, err := mapOfrepContext(params.Body.Context)
+	if err != nil {
+		return ofrep.NewPostOfrepEvaluateFlagBadRequest().WithPayload(ofrepFailure(params.Key, err))
+	}
+
+	evalContext.FlagKey = params.Key
+	f := LookupFlag(evalContext)
+	if f != nil && !f.ClientVisible {
+		f = nil
+	}
+	r, err := mapOfrepResult(params.Key, EvalFlagWithContext(f, evalContext))
+	if err != nil {
+		if err.code == models.OfrepEvaluationResultErrorCodeFLAGNOTFOUND {
+			return ofrep.NewPostOfrepEvaluateFlagNotFound().WithPayload(ofrepFailure(params.Key, err))
+		}
+		return ofrep.NewPostOfrepEvaluateFlagBadRequest().WithPayload(ofrepFailure(params.Key, err))
+	}
+	return ofrep.NewPostOfrepEvaluateFlagOK().WithPayload(r)
+}
+
+// PostOfrepEvaluateFlags evaluates all the enabled and client visible flags with the OpenFeature
+// Remote Evaluation Protocol. The ETag is the hash of the results, so it changes with the flags and
+// the context. The results are not logged or recorded, the clients poll it for the flags they may
+// never read.
+func (e *eval) PostOfrepEvaluateFlags(params ofrep.PostOfrepEvaluateFlagsParams) middleware.Responder {
+	evalContext, err := mapOfrepContext(params.Body.Context)
+	if err != nil {
+		return ofrep.NewPostOfrepEvaluateFlagsBadRequest().WithPayload(&models.OfrepBulkEvaluationFailure{
+			ErrorCode:    util.StringPtr(err.code),
+			ErrorDetails: err.details,
+		})
+	}
+
+	ev := newEvaluator()
+	ev.Assignments = GetAssignmentStore()
+	resp := &models.OfrepBulkEvaluationResponse{Flags: []*models.OfrepEvaluationResult{}}
+	for _, f := range GetEvalCache().GetAll() {
+		if !f.Enabled || f.Key == "" || !f.ClientVisible {
+			continue
+		}
+		c := evalContext
+		c.FlagID = int64(f.ID)
+		c.FlagKey = f.Key
+		r, err := mapOfrepResult(f.Key, ev.Evaluate(f, c))
+		if err != nil {
+			r = ofrepFailure(f.Key, err)
+		}
+		resp.Flags = append(resp.Flags, r)
+	}
+
+	b, jsonErr := json.Marshal(resp)
+	if jsonErr != nil {
+		return ofrep.NewPostOfrepEvaluateFlagsDefault(500).WithPayload(
+			ErrorMessage("cannot marshal the results. reason: %s", jsonErr))
+	}
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(b))
+	if util.SafeString(params.IfNoneMatch) == etag {
+		return ofrep.NewPostOfrepEvaluateFlagsNotModified()
+	}
+	return ofrep.NewPostOfrepEvaluateFlagsOK().WithETag(etag).WithPayload(resp)
+}
+
+// mapOfrepContext maps the OpenFeature evaluation context, the targetingKey is the entityID
+// and the other attributes are the entityContext
+func mapOfrepContext(context interface{}) (models.EvalContext, *ofrepError) {
+	evalContext := models.EvalContext{}
+	if context == nil {
+		return evalContext, nil
+	}
+	m, ok := context.(map[string]interface{})
+	if !ok {
+		return evalContext, &ofrepError{
+			code:    models.OfrepEvaluationResultErrorCodeINVALIDCONTEXT,
+			details: "context is expecting a JSON object",
+		}
+	}
+
+	entityContext := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if k != ofrepTargetingKey {
+			entityContext[k] = v
+			continue
+		}
+		entityID, ok := v.(string)
+		if !ok {
+			return evalContext, &ofrepError{
+				code:    models.OfrepEvaluationResultErrorCodeINVALIDCONTEXT,
+				details: fmt.Sprintf("%s is expecting a string, got %v", ofrepTargetingKey, v),
+			}
+		}
+		evalContext.EntityID = entityID
+	}
+	evalContext.EntityContext = entityContext
+	return evalContext, nil
+}
+
+// mapOfrepResult maps the evaluation result of flagr to OFREP. The results without a variant are
+// errors, since a successful OFREP result needs a value, so the SDK falls back to the default value.
+func mapOfrepResult(key string, r *models.EvalResult) (*models.OfrepEvaluationResult, *ofrepError) {
+	switch r.Reason {
+	case models.EvalResultReasonFLAGNOTFOUND:
+		return nil, &ofrepError{
+			code:    models.OfrepEvaluationResultErrorCodeFLAGNOTFOUND,
+			details: fmt.Sprintf("flag %s is not found", key),
+		}
+	case models.EvalResultReasonFLAGDISABLED:
+		return nil, &ofrepError{
+			code:    models.OfrepEvaluationResultErrorCodeFLAGNOTFOUND,
+			details: fmt.Sprintf("flag %s is disabled. flagr reason: %s", key, r.Reason),
+		}
+	case models.EvalResultReasonERROR:
+		return nil, &ofrepError{
+			code:    models.OfrepEvaluationResultErrorCodeGENERAL,
+			details: "the evaluation of a segment failed, e.g. an invalid context",
+		}
+	}
+	if r.VariantKey == "" {
+		return nil, &ofrepError{
+			code:    models.OfrepEvaluationResultErrorCodeGENERAL,
+			details: fmt.Sprintf("flag %s has no variant for the context. flagr reason: %s", key, r.Reason),
+		}
+	}
+
+	attachment, _ := r.VariantAttachment.(entity.Attachment)
+	value, err := mapOfrepValue(r.ValueType, r.VariantKey, attachment)
+	if err != nil {
+		return nil, err
+	}
+	return &models.OfrepEvaluationResult{
+		Key:      util.StringPtr(key),
+		Reason:   mapOfrepReason(r.Reason),
+		Variant:  r.VariantKey,
+		Value:    value,
+		Metadata: mapOfrepMetadata(r),
+	}, nil
+}
+
+func mapOfrepReason(reason string) string {
+	switch reason {
+	case models.EvalResultReasonMATCHED, models.EvalResultReasonOVERRIDE, models.EvalResultReasonSTICKY:
+		return ofrepReasonTargetingMatch
+	default:
+		return ofrepReasonDefault
+	}
+}
+
+// mapOfrepValue maps the variant to the OpenFeature value, see the value of ofrepEvaluationResult
+func mapOfrepValue(valueType string, variantKey string, attachment entity.Attachment) (interface{}, *ofrepError) {
+	switch valueType {
+	case entity.ValueTypeObject:
+		if attachment == nil {
+			return map[string]interface{}{}, nil
+		}
+		return map[string]interface{}(attachment), nil
+	case entity.ValueTypeBoolean, entity.ValueTypeString, entity.ValueTypeNumber:
+		v := attachment[entity.AttachmentValueKey]
+		valid := false
+		switch v.(type) {
+		case bool:
+			valid = valueType == entity.ValueTypeBoolean
+		case string:
+			valid = valueType == entity.ValueTypeString
+		case float64, float32, int, int64, json.Number:
+			valid = valueType == entity.ValueTypeNumber
+		}
+		if !valid {
+			return nil, &ofrepError{
+				code:    models.OfrepEvaluationResultErrorCodeTYPEMISMATCH,
+				details: fmt.Sprintf("the value %v of variant %s is not a %s", v, variantKey, valueType),
+			}
+		}
+		return v, nil
+	default:
+		if v, ok := attachment[entity.AttachmentValueKey]; ok && len(attachment) == 1 {
+			return v, nil
+		}
+		if len(attachment) > 0 {
+			return map[string]interface{}(attachment), nil
+		}
+		return variantKey, nil
+	}
+}
+
+func mapOfrepMetadata(r *models.EvalResult) map[string]interface{} {
+	m := map[string]interface{}{
+		"flagID":         r.FlagID,
+		"flagSnapshotID": r.FlagSnapshotID,
+		"flagrReason":    r.Reason,
+	}
+	if r.SegmentID != 0 {
+		m["segmentID"] = r.SegmentID
+	}
+	if r.VariantID != 0 {
+		m["variantID"] = r.VariantID
+	}
+	return m
+}
+
+func ofrepFailure(key string, err *ofrepError) *models.OfrepEvaluationResult {
+	return &models.OfrepEvaluationResult{
+		Key:          util.StringPtr(key),
+		ErrorCode:    err.code,
+		ErrorDetails: err.details,
+	}
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/ofrep"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+// genOfrepEvalCache generates the fixture eval cache with the client visible flag_key_100
+func genOfrepEvalCache() *EvalCache {
+	ec := GenFixtureEvalCache()
+	ec.cache.keyCache["flag_key_100"].ClientVisible = true
+	return ec
+}
+
+func TestPostOfrepEvaluateFlag(t *testing.T) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+	ec := genOfrepEvalCache()
+	defer gostub.StubFunc(&GetEvalCache, ec).Reset()
+
+	evaluate := func(key string, context interface{}) interface{} {
+		return NewEval().PostOfrepEvaluateFlag(ofrep.PostOfrepEvaluateFlagParams{
+			Key:  key,
+			Body: &models.OfrepEvaluationRequest{Context: context},
+		})
+	}
+
+	t.Run("targeting match", func(t *testing.T) {
+		res := evaluate("flag_key_100", map[string]interface{}{"targetingKey": "entityID1", "dl_state": "CA"})
+		assert.IsType(t, &ofrep.PostOfrepEvaluateFlagOK{}, res)
+		r := res.(*ofrep.PostOfrepEvaluateFlagOK).Payload
+		assert.Equal(t, "flag_key_100", *r.Key)
+		assert.Equal(t, "TARGETING_MATCH", r.Reason)
+		assert.Contains(t, []string{"control", "treatment"}, r.Variant)
+		assert.Equal(t, models.EvalResultReasonMATCHED, r.Metadata.(map[string]interface{})["flagrReason"])
+		assert.Equal(t, int64(200), r.Metadata.(map[string]interface{})["segmentID"])
+
+		// the targetingKey is the entityID, so the variant is sticky
+		for i := 0; i < 10; i++ {
+			res := evaluate("flag_key_100", map[string]interface{}{"targetingKey": "entityID1", "dl_state": "CA"})
+			assert.Equal(t, r.Variant, res.(*ofrep.PostOfrepEvaluateFlagOK).Payload.Variant)
+		}
+	})
+
+	t.Run("no variant", func(t *testing.T) {
+		res := evaluate("flag_key_100", map[string]interface{}{"targetingKey": "entityID1", "dl_state": "NY"})
+		assert.IsType(t, &ofrep.PostOfrepEvaluateFlagBadRequest{}, res)
+		r := res.(*ofrep.PostOfrepEvaluateFlagBadRequest).Payload
+		assert.Equal(t, models.OfrepEvaluationResultErrorCodeGENERAL, r.ErrorCode)
+		assert.Contains(t, r.ErrorDetails, models.EvalResultReasonNOMATCH)
+		assert.Nil(t, r.Value)
+	})
+
+	t.Run("default variant", func(t *testing.T) {
+		ec.cache.keyCache["flag_key_100"].DefaultVariantID = 300
+		defer func() { ec.cache.keyCache["flag_key_100"].DefaultVariantID = 0 }()
+
+		res := evaluate("flag_key_100", map[string]interface{}{"targetingKey": "entityID1", "dl_state": "NY"})
+		r := res.(*ofrep.PostOfrepEvaluateFlagOK).Payload
+		assert.Equal(t, "DEFAULT", r.Reason)
+		assert.Equal(t, "control", r.Variant)
+		assert.Equal(t, "control", r.Value)
+	})
+
+	t.Run("flag disabled", func(t *testing.T) {
+		ec.cache.keyCache["flag_key_100"].Enabled = false
+		defer func() { ec.cache.keyCache["flag_key_100"].Enabled = true }()
+
+		res := evaluate("flag_key_100", map[string]interface{}{"targetingKey": "entityID1", "dl_state": "CA"})
+		assert.IsType(t, &ofrep.PostOfrepEvaluateFlagNotFound{}, res)
+		r := res.(*ofrep.PostOfrepEvaluateFlagNotFound).Payload
+		assert.Contains(t, r.ErrorDetails, models.EvalResultReasonFLAGDISABLED)
+	})
+
+	t.Run("flag not found", func(t *testing.T) {
+		res := evaluate("non_exists", nil)
+		assert.IsType(t, &ofrep.PostOfrepEvaluateFlagNotFound{}, res)
+		r := res.(*ofrep.PostOfrepEvaluateFlagNotFound).Payload
+		assert.Equal(t, models.OfrepEvaluationResultErrorCodeFLAGNOTFOUND, r.ErrorCode)
+	})
+
+	t.Run("flag not client visible", func(t *testing.T) {
+		ec.cache.keyCache["flag_key_100"].ClientVisible = false
+		defer func() { ec.cache.keyCache["flag_key_100"].ClientVisible = true }()
+
+		res := evaluate("flag_key_100", map[string]interface{}{"targetingKey": "entityID1", "dl_state": "CA"})
+		assert.IsType(t, &ofrep.PostOfrepEvaluateFlagNotFound{}, res)
+	})
+
+	t.Run("invalid context", func(t *testing.T) {
+		res := evaluate("flag_key_100", map[string]interface{}{"targetingKey": 123})
+		assert.IsType(t, &ofrep.PostOfrepEvaluateFlagBadRequest{}, res)
+		r := res.(*ofrep.PostOfrepEvaluateFlagBadRequest).Payload
+		assert.Equal(t, models.OfrepEvaluationResultErrorCodeINVALIDCONTEXT, r.ErrorCode)
+
+		res = evaluate("flag_key_100", []interface{}{"entityID1"})
+		assert.IsType(t, &ofrep.PostOfrepEvaluateFlagBadRequest{}, res)
+	})
+}
+
+func TestPostOfrepEvaluateFlags(t *testing.T) {
+	logged := 0
+	defer gostub.Stub(&logEvalResult, func(r *models.EvalResult, dataRecordsEnabled bool) {
+		logged++
+	}).Reset()
+	ec := genOfrepEvalCache()
+	defer gostub.StubFunc(&GetEvalCache, ec).Reset()
+
+	evaluate := func(etag *string) interface{} {
+		return NewEval().PostOfrepEvaluateFlags(ofrep.PostOfrepEvaluateFlagsParams{
+			IfNoneMatch: etag,
+			Body: &models.OfrepEvaluationRequest{
+				Context: map[string]interface{}{"targetingKey": "entityID1", "dl_state": "CA"},
+			},
+		})
+	}
+
+	res := evaluate(nil)
+	assert.IsType(t, &ofrep.PostOfrepEvaluateFlagsOK{}, res)
+	ok := res.(*ofrep.PostOfrepEvaluateFlagsOK)
+	assert.Len(t, ok.Payload.Flags, 1)
+	assert.Equal(t, "flag_key_100", *ok.Payload.Flags[0].Key)
+	assert.NotEmpty(t, ok.ETag)
+
+	assert.IsType(t, &ofrep.PostOfrepEvaluateFlagsNotModified{}, evaluate(util.StringPtr(ok.ETag)))
+	assert.IsType(t, &ofrep.PostOfrepEvaluateFlagsOK{}, evaluate(util.StringPtr(`"stale"`)))
+	assert.Zero(t, logged)
+
+	ec.cache.keyCache["flag_key_100"].ClientVisible = false
+	assert.Empty(t, evaluate(nil).(*ofrep.PostOfrepEvaluateFlagsOK).Payload.Flags)
+}
+
+func TestMapOfrepValue(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		valueType  string
+		attachment entity.Attachment
+		expected   interface{}
+		errorCode  string
+	}{
+		{name: "untyped without attachment", expected: "control"},
+		{name: "untyped with value", attachment: entity.Attachment{"value": 1.5}, expected: 1.5},
+		{
+			name:       "untyped with attachment",
+			attachment: entity.Attachment{"color": "red"},
+			expected:   map[string]interface{}{"color": "red"},
+		},
+		{name: "boolean", valueType: entity.ValueTypeBoolean, attachment: entity.Attachment{"value": false}, expected: false},
+		{name: "string", valueType: entity.ValueTypeString, attachment: entity.Attachment{"value": "red"}, expected: "red"},
+		{name: "number", valueType: entity.ValueTypeNumber, attachment: entity.Attachment{"value": float64(3)}, expected: float64(3)},
+		{name: "object without attachment", valueType: entity.ValueTypeObject, expected: map[string]interface{}{}},
+		{
+			name:       "type mismatch",
+			valueType:  entity.ValueTypeBoolean,
+			attachment: entity.Attachment{"value": "true"},
+			errorCode:  models.OfrepEvaluationResultErrorCodeTYPEMISMATCH,
+		},
+		{
+			name:      "missing value",
+			valueType: entity.ValueTypeNumber,
+			errorCode: models.OfrepEvaluationResultErrorCodeTYPEMISMATCH,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := mapOfrepValue(tc.valueType, "control", tc.attachment)
+			if tc.errorCode != "" {
+				assert.Equal(t, tc.errorCode, err.code)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, v)
+		})
+	}
+}
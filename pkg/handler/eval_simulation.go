@@ -0,0 +1,270 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
+)
+
+const (
+	simulationEntityIDColumn   = "entityID"
+	simulationEntityTypeColumn = "entityType"
+)
+
+// PostEvaluationSimulation simulates the rollout of the flag definition, or the live flag, over
+// the sample of entities, and compares the variants with the live flag. Nothing is saved, and the
+// results are not logged or recorded.
+func (e *eval) PostEvaluationSimulation(params evaluation.PostEvaluationSimulationParams) middleware.Responder {
+	body := params.Body
+	flagID := body.FlagID
+
+	var f *entity.Flag
+	if body.Flag != nil {
+		pf, err := prepareDryRunFlag(body.Flag)
+		if err != nil {
+			return evaluation.NewPostEvaluationSimulationDefault(err.StatusCode).WithPayload(
+				ErrorMessage(err.Message, err.Values...))
+		}
+		f = pf
+		if flagID == 0 {
+			flagID = int64(f.ID)
+		}
+	}
+
+	var live *entity.Flag
+	if flagID != 0 {
+		live = GetEvalCache().GetByFlagKeyOrID(flagID)
+	}
+	if f == nil {
+		if body.FlagID == 0 {
+			return evaluation.NewPostEvaluationSimulationDefault(400).WithPayload(
+				ErrorMessage("flag or flagID is required"))
+		}
+		if live == nil {
+			return evaluation.NewPostEvaluationSimulationDefault(404).WithPayload(
+				ErrorMessage("flag %d not found", body.FlagID))
+		}
+		f = live
+	}
+
+	entities, err := parseSimulationSample(util.SafeString(body.Sample), util.SafeString(body.SampleFormat))
+	if err != nil {
+		return evaluation.NewPostEvaluationSimulationDefault(400).WithPayload(
+			ErrorMessage("invalid sample. reason: %s", err))
+	}
+
+	resp := evaluation.NewPostEvaluationSimulationOK()
+	resp.SetPayload(simulateFlag(f, live, entities))
+	return resp
+}
+
+// simulateFlag evaluates the flag for the entities, and counts the results by the reasons, the
+// segments and the variants. The variant keys are compared with the live flag if it's not nil.
+// The entities keep their sticky assignments, but the new ones are not saved.
+func simulateFlag(f *entity.Flag, live *entity.Flag, entities []*models.EvaluationEntity) *models.EvaluationSimulationResponse {
+	ev := newEvaluator()
+	if store := GetAssignmentStore(); store != nil {
+		ev.Assignments = readOnlyAssignmentStore{store}
+	}
+	evalContext := func(flag *entity.Flag, en *models.EvaluationEntity) models.EvalContext {
+		return models.EvalContext{
+			EntityContext: en.EntityContext,
+			EntityID:      en.EntityID,
+			EntityType:    en.EntityType,
+			FlagID:        int64(flag.ID),
+			FlagKey:       flag.Key,
+		}
+	}
+
+	reasons := map[string]int64{}
+	matched := map[int64]int64{}
+	rolloutExcluded := map[int64]int64{}
+	variants := map[int64]int64{}
+	noVariant := int64(0)
+	changed := int64(0)
+	transitions := map[[2]string]int64{}
+
+	for _, en := range entities {
+		r := ev.Evaluate(f, evalContext(f, en))
+		reasons[r.Reason]++
+		switch r.Reason {
+		case models.EvalResultReasonMATCHED:
+			matched[r.SegmentID]++
+		case models.EvalResultReasonROLLOUTEXCLUDED:
+			rolloutExcluded[r.SegmentID]++
+		}
+		if r.VariantID == 0 {
+			noVariant++
+		} else {
+			variants[r.VariantID]++
+		}
+
+		if live == nil {
+			continue
+		}
+		lr := ev.Evaluate(live, evalContext(live, en))
+		if lr.VariantKey != r.VariantKey {
+			changed++
+			transitions[[2]string{lr.VariantKey, r.VariantKey}]++
+		}
+	}
+
+	resp := &models.EvaluationSimulationResponse{
+		Total:         util.Int64Ptr(int64(len(entities))),
+		Reasons:       reasons,
+		Segments:      []*models.SimulationSegment{},
+		Variants:      []*models.SimulationVariant{},
+		NoVariant:     util.Int64Ptr(noVariant),
+		LiveFlagFound: util.BoolPtr(live != nil),
+		Changed:       util.Int64Ptr(changed),
+		Transitions:   []*models.SimulationTransition{},
+	}
+	for _, s := range f.Segments {
+		resp.Segments = append(resp.Segments, &models.SimulationSegment{
+			SegmentID:       util.Int64Ptr(int64(s.ID)),
+			Description:     s.Description,
+			Rank:            util.Int64Ptr(int64(s.Rank)),
+			Matched:         util.Int64Ptr(matched[int64(s.ID)]),
+			RolloutExcluded: util.Int64Ptr(rolloutExcluded[int64(s.ID)]),
+		})
+	}
+	for _, v := range f.Variants {
+		resp.Variants = append(resp.Variants, &models.SimulationVariant{
+			VariantID:  util.Int64Ptr(int64(v.ID)),
+			VariantKey: util.StringPtr(v.Key),
+			Count:      util.Int64Ptr(variants[int64(v.ID)]),
+		})
+	}
+	for t, count := range transitions {
+		resp.Transitions = append(resp.Transitions, &models.SimulationTransition{
+			FromVariantKey: util.StringPtr(t[0]),
+			ToVariantKey:   util.StringPtr(t[1]),
+			Count:          util.Int64Ptr(count),
+		})
+	}
+	sort.Slice(resp.Transitions, func(i, j int) bool {
+		ti, tj := resp.Transitions[i], resp.Transitions[j]
+		if *ti.Count != *tj.Count {
+			return *ti.Count > *tj.Count
+		}
+		if *ti.FromVariantKey != *tj.FromVariantKey {
+			return *ti.FromVariantKey < *tj.FromVariantKey
+		}
+		return *ti.ToVariantKey < *tj.ToVariantKey
+	})
+	return resp
+}
+
+// parseSimulationSample parses the sample of entities in JSONL or CSV, see the sample of
+// evaluationSimulationRequest. Every entity needs the entityID.
+func parseSimulationSample(sample string, format string) ([]*models.EvaluationEntity, error) {
+	var entities []*models.EvaluationEntity
+	var err error
+	if format == models.EvaluationSimulationRequestSampleFormatCSV {
+		entities, err = parseSimulationSampleCSV(sample)
+	} else {
+		entities, err = parseSimulationSampleJSONL(sample)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(entities) == 0 {
+		return nil, fmt.Errorf("no entities in the sample")
+	}
+	return entities, nil
+}
+
+func parseSimulationSampleJSONL(sample string) ([]*models.EvaluationEntity, error) {
+	entities := []*models.EvaluationEntity{}
+	for i, line := range strings.Split(sample, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		en := &models.EvaluationEntity{}
+		if err := json.Unmarshal([]byte(line), en); err != nil {
+			return nil, fmt.Errorf("line %d. %s", i+1, err)
+		}
+		if en.EntityID == "" {
+			return nil, fmt.Errorf("line %d has no %s", i+1, simulationEntityIDColumn)
+		}
+		entities = append(entities, en)
+	}
+	return entities, nil
+}
+
+func parseSimulationSampleCSV(sample string) ([]*models.EvaluationEntity, error) {
+	r := csv.NewReader(strings.NewReader(sample))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("no header in the sample")
+	}
+	if err != nil {
+		return nil, err
+	}
+	hasEntityID := false
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+		hasEntityID = hasEntityID || header[i] == simulationEntityIDColumn
+	}
+	if !hasEntityID {
+		return nil, fmt.Errorf("no %s column in the header", simulationEntityIDColumn)
+	}
+
+	entities := []*models.EvaluationEntity{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		en := &models.EvaluationEntity{}
+		entityContext := map[string]interface{}{}
+		for i, field := range record {
+			switch header[i] {
+			case simulationEntityIDColumn:
+				en.EntityID = field
+			case simulationEntityTypeColumn:
+				en.EntityType = field
+			default:
+				if field != "" {
+					entityContext[header[i]] = parseSimulationCSVField(field)
+				}
+			}
+		}
+		if en.EntityID == "" {
+			line, _ := r.FieldPos(0)
+			return nil, fmt.Errorf("line %d has no %s", line, simulationEntityIDColumn)
+		}
+		en.EntityContext = entityContext
+		entities = append(entities, en)
+	}
+	return entities, nil
+}
+
+// parseSimulationCSVField parses the numbers and the booleans of the CSV fields as JSON, and
+// keeps the others as strings
+func parseSimulationCSVField(field string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(field), &v); err == nil {
+		switch v.(type) {
+		case float64, bool:
+			return v
+		}
+	}
+	return field
+}
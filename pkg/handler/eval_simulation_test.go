@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/evaluator"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostEvaluationSimulation(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+
+	logged := 0
+	defer gostub.Stub(&logEvalResult, func(r *models.EvalResult, dataRecordsEnabled bool) {
+		logged++
+	}).Reset()
+
+	// 100 entities in CA and 100 in NY
+	genSample := func() string {
+		lines := []string{}
+		for i := 0; i < 200; i++ {
+			state := []string{"CA", "NY"}[i%2]
+			lines = append(lines, fmt.Sprintf(`{"entityID": "%d", "entityContext": {"dl_state": "%s"}}`, i, state))
+		}
+		return strings.Join(lines, "\n")
+	}
+	simulate := func(body *models.EvaluationSimulationRequest) interface{} {
+		return NewEval().PostEvaluationSimulation(evaluation.PostEvaluationSimulationParams{Body: body})
+	}
+	payload := func(t *testing.T, res interface{}) *models.EvaluationSimulationResponse {
+		assert.IsType(t, &evaluation.PostEvaluationSimulationOK{}, res)
+		return res.(*evaluation.PostEvaluationSimulationOK).Payload
+	}
+
+	t.Run("live flag", func(t *testing.T) {
+		p := payload(t, simulate(&models.EvaluationSimulationRequest{FlagID: 100, Sample: util.StringPtr(genSample())}))
+		assert.Equal(t, int64(200), *p.Total)
+		assert.Equal(t, map[string]int64{"MATCHED": 100, "NO_MATCH": 100}, p.Reasons)
+		assert.Len(t, p.Segments, 1)
+		assert.Equal(t, int64(100), *p.Segments[0].Matched)
+		assert.Len(t, p.Variants, 2)
+		assert.Equal(t, int64(100), *p.Variants[0].Count+*p.Variants[1].Count)
+		assert.Equal(t, int64(100), *p.NoVariant)
+		assert.True(t, *p.LiveFlagFound)
+		assert.Zero(t, *p.Changed)
+		assert.Empty(t, p.Transitions)
+		assert.Zero(t, logged)
+	})
+
+	t.Run("proposed distributions", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.Segments[0].Distributions = f.Segments[0].Distributions[1:]
+		f.Segments[0].Distributions[0].Percent = 100
+		live := payload(t, simulate(&models.EvaluationSimulationRequest{FlagID: 100, Sample: util.StringPtr(genSample())}))
+
+		p := payload(t, simulate(&models.EvaluationSimulationRequest{
+			Flag:   genDryRunFlagBody(t, &f),
+			Sample: util.StringPtr(genSample()),
+		}))
+		assert.Equal(t, int64(0), *p.Variants[0].Count)
+		assert.Equal(t, int64(100), *p.Variants[1].Count)
+		assert.True(t, *p.LiveFlagFound)
+
+		// only the entities of control in the live flag move to treatment
+		assert.Equal(t, *live.Variants[0].Count, *p.Changed)
+		assert.Len(t, p.Transitions, 1)
+		assert.Equal(t, "control", *p.Transitions[0].FromVariantKey)
+		assert.Equal(t, "treatment", *p.Transitions[0].ToVariantKey)
+	})
+
+	t.Run("proposed constraints against another live flag", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.ID = 1000
+		f.Segments[0].Constraints[0].Value = `"NY"`
+		f.Segments[0].Distributions = f.Segments[0].Distributions[1:]
+		f.Segments[0].Distributions[0].Percent = 100
+
+		p := payload(t, simulate(&models.EvaluationSimulationRequest{
+			FlagID: 100,
+			Flag:   genDryRunFlagBody(t, &f),
+			Sample: util.StringPtr(genSample()),
+		}))
+		assert.Equal(t, int64(100), *p.Segments[0].Matched)
+		assert.Equal(t, int64(200), *p.Changed)
+	})
+
+	t.Run("new flag", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.ID = 1000
+		p := payload(t, simulate(&models.EvaluationSimulationRequest{
+			Flag:   genDryRunFlagBody(t, &f),
+			Sample: util.StringPtr(genSample()),
+		}))
+		assert.False(t, *p.LiveFlagFound)
+		assert.Zero(t, *p.Changed)
+	})
+
+	t.Run("sticky assignments are kept but not saved", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.StickyAssignments = true
+		f.AssignmentSalt = "salt"
+		f.Segments[0].Distributions = f.Segments[0].Distributions[1:]
+		f.Segments[0].Distributions[0].Percent = 100
+		store := evaluator.NewMemoryAssignmentStore()
+		store.SetAssignment(100, "salt", "0", 300)
+		defer gostub.StubFunc(&GetAssignmentStore, store).Reset()
+
+		p := payload(t, simulate(&models.EvaluationSimulationRequest{
+			Flag:   genDryRunFlagBody(t, &f),
+			Sample: util.StringPtr(genSample()),
+		}))
+		assert.Equal(t, map[string]int64{"MATCHED": 99, "NO_MATCH": 100, "STICKY": 1}, p.Reasons)
+		assert.Equal(t, int64(1), *p.Variants[0].Count)
+		assert.Equal(t, int64(99), *p.Variants[1].Count)
+		_, ok := store.GetAssignment(100, "salt", "2")
+		assert.False(t, ok)
+	})
+
+	t.Run("csv sample", func(t *testing.T) {
+		p := payload(t, simulate(&models.EvaluationSimulationRequest{
+			FlagID:       100,
+			Sample:       util.StringPtr("entityID,dl_state\n1,CA\n2,NY\n3,CA\n"),
+			SampleFormat: util.StringPtr(models.EvaluationSimulationRequestSampleFormatCSV),
+		}))
+		assert.Equal(t, int64(3), *p.Total)
+		assert.Equal(t, int64(2), *p.Segments[0].Matched)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		res := simulate(&models.EvaluationSimulationRequest{Sample: util.StringPtr(genSample())})
+		assert.Contains(t, *res.(*evaluation.PostEvaluationSimulationDefault).Payload.Message, "flag or flagID is required")
+
+		res = simulate(&models.EvaluationSimulationRequest{FlagID: 999, Sample: util.StringPtr(genSample())})
+		assert.Contains(t, *res.(*evaluation.PostEvaluationSimulationDefault).Payload.Message, "flag 999 not found")
+
+		res = simulate(&models.EvaluationSimulationRequest{FlagID: 100, Sample: util.StringPtr(`{"entityContext": {}}`)})
+		assert.Contains(t, *res.(*evaluation.PostEvaluationSimulationDefault).Payload.Message, "has no entityID")
+
+		f := entity.GenFixtureFlag()
+		f.Segments[0].Distributions[0].Percent = 10
+		res = simulate(&models.EvaluationSimulationRequest{Flag: genDryRunFlagBody(t, &f), Sample: util.StringPtr(genSample())})
+		assert.Contains(t, *res.(*evaluation.PostEvaluationSimulationDefault).Payload.Message, "is not 100")
+	})
+}
+
+func TestParseSimulationSample(t *testing.T) {
+	t.Run("jsonl", func(t *testing.T) {
+		entities, err := parseSimulationSample(
+			"{\"entityID\": \"1\", \"entityType\": \"user\", \"entityContext\": {\"age\": 30}}\n\n{\"entityID\": \"2\"}\n",
+			models.EvaluationSimulationRequestSampleFormatJSONL,
+		)
+		assert.NoError(t, err)
+		assert.Len(t, entities, 2)
+		assert.Equal(t, "user", entities[0].EntityType)
+		assert.Equal(t, map[string]interface{}{"age": float64(30)}, entities[0].EntityContext)
+
+		_, err = parseSimulationSample("{\"entityID\": \"1\"}\nnot json", models.EvaluationSimulationRequestSampleFormatJSONL)
+		assert.ErrorContains(t, err, "line 2")
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		entities, err := parseSimulationSample(
+			"entityID,entityType,age,beta,zip,state\n1,user,30,true,02134,CA\n2,user,,false,94105,\n",
+			models.EvaluationSimulationRequestSampleFormatCSV,
+		)
+		assert.NoError(t, err)
+		assert.Len(t, entities, 2)
+		assert.Equal(t, "1", entities[0].EntityID)
+		assert.Equal(t, map[string]interface{}{
+			"age": float64(30), "beta": true, "zip": "02134", "state": "CA",
+		}, entities[0].EntityContext)
+		assert.Equal(t, map[string]interface{}{"beta": false, "zip": float64(94105)}, entities[1].EntityContext)
+
+		_, err = parseSimulationSample("state\nCA\n", models.EvaluationSimulationRequestSampleFormatCSV)
+		assert.ErrorContains(t, err, "no entityID column")
+
+		_, err = parseSimulationSample("entityID,state\n1,CA\n,NY\n", models.EvaluationSimulationRequestSampleFormatCSV)
+		assert.ErrorContains(t, err, "line 3")
+	})
+
+	t.Run("empty sample", func(t *testing.T) {
+		_, err := parseSimulationSample("entityID,state\n", models.EvaluationSimulationRequestSampleFormatCSV)
+		assert.Error(t, err)
+	})
+}
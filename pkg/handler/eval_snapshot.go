@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
+	"gorm.io/gorm"
+)
+
+// PostEvaluationSnapshot evaluates a historical snapshot of the flag, found by its ID or by
+// the timestamp, with the entities. The results are not logged or recorded.
+func (e *eval) PostEvaluationSnapshot(params evaluation.PostEvaluationSnapshotParams) middleware.Responder {
+	flagID := util.SafeUint(params.Body.FlagID)
+	snapshotID := uint(params.Body.FlagSnapshotID)
+	if (snapshotID == 0) == (params.Body.Timestamp == nil) {
+		return evaluation.NewPostEvaluationSnapshotDefault(400).WithPayload(
+			ErrorMessage("either flagSnapshotID or timestamp is required"))
+	}
+
+	fs := &entity.FlagSnapshot{}
+	q := getDB().Where("flag_id = ?", flagID)
+	if snapshotID != 0 {
+		q = q.Where("id = ?", snapshotID)
+	} else {
+		q = q.Where("created_at <= ?", time.Time(*params.Body.Timestamp)).Order("created_at desc")
+	}
+	if err := q.First(fs).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return evaluation.NewPostEvaluationSnapshotDefault(404).WithPayload(
+				ErrorMessage("snapshot of flagID %v not found", flagID))
+		}
+		return evaluation.NewPostEvaluationSnapshotDefault(500).WithPayload(
+			ErrorMessage("cannot find the snapshot of flagID %v. reason: %s", flagID, err))
+	}
+
+	f := &entity.Flag{}
+	if err := json.Unmarshal(fs.Flag, f); err != nil {
+		return evaluation.NewPostEvaluationSnapshotDefault(500).WithPayload(
+			ErrorMessage("cannot parse the snapshot %v. reason: %s", fs.ID, err))
+	}
+	// the audiences and the ID lists are not in the snapshot, so the current ones are used
+	if _, err := GetEvalCache().prepareFlag(f); err != nil {
+		return evaluation.NewPostEvaluationSnapshotDefault(500).WithPayload(
+			ErrorMessage("cannot prepare the snapshot %v for evaluation. reason: %s", fs.ID, err))
+	}
+
+	// the entities are evaluated at the time of the request, or the time of the snapshot
+	evaluatedAt := fs.CreatedAt
+	if params.Body.Timestamp != nil {
+		evaluatedAt = time.Time(*params.Body.Timestamp)
+	}
+	ev := newEvaluator()
+	ev.Time = evaluatedAt
+
+	results := &models.EvaluationSnapshotResponse{
+		FlagSnapshotID:    util.Int64Ptr(int64(fs.ID)),
+		EvaluatedAt:       (*strfmt.DateTime)(&evaluatedAt),
+		Warnings:          snapshotWarnings(f),
+		EvaluationResults: []*models.EvalResult{},
+	}
+	for _, entity := range params.Body.Entities {
+		evalContext := models.EvalContext{
+			EnableDebug:   params.Body.EnableDebug,
+			EntityContext: entity.EntityContext,
+			EntityID:      entity.EntityID,
+			EntityType:    entity.EntityType,
+			FlagID:        int64(f.ID),
+			FlagKey:       f.Key,
+		}
+		r := ev.Evaluate(f, evalContext)
+		results.EvaluationResults = append(results.EvaluationResults, r)
+	}
+
+	resp := evaluation.NewPostEvaluationSnapshotOK()
+	resp.SetPayload(results)
+	return resp
+}
+
+// snapshotWarnings returns the warnings of the parts of the snapshot evaluated with the current
+// data instead of the historical one, see the warnings of evaluationSnapshotResponse
+func snapshotWarnings(f *entity.Flag) []string {
+	audiences, idLists, prerequisites := false, false, false
+	for _, s := range f.Segments {
+		audiences = audiences || len(s.Audiences) != 0
+		idLists = idLists || len(s.IDListKeys()) != 0
+		prerequisites = prerequisites || len(s.Prerequisites) != 0
+	}
+
+	warnings := []string{}
+	if audiences {
+		warnings = append(warnings, "the audiences of the segments are the current ones, not the ones at the time of the snapshot")
+	}
+	if idLists {
+		warnings = append(warnings, "the ID lists of the constraints are the current ones, not the ones at the time of the snapshot")
+	}
+	if prerequisites {
+		warnings = append(warnings, "the prerequisite flags of the segments are evaluated with their current definitions")
+	}
+	return warnings
+}
@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestPostEvaluationSnapshot(t *testing.T) {
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+
+	logged := 0
+	defer gostub.Stub(&logEvalResult, func(r *models.EvalResult, dataRecordsEnabled bool) {
+		logged++
+	}).Reset()
+
+	// the flag was all control at 13:00, and all treatment since 15:00
+	saveSnapshot := func(id uint, createdAt time.Time, variantID uint, variantKey string) {
+		f := entity.GenFixtureFlag()
+		f.Segments[0].Distributions = []entity.Distribution{{VariantID: variantID, VariantKey: variantKey, Percent: 100}}
+		b, err := json.Marshal(f)
+		assert.NoError(t, err)
+		db.Create(&entity.FlagSnapshot{Model: gorm.Model{ID: id, CreatedAt: createdAt}, FlagID: 100, Flag: b})
+	}
+	day := time.Date(2025, 11, 27, 0, 0, 0, 0, time.UTC)
+	saveSnapshot(1, day.Add(13*time.Hour), 300, "control")
+	saveSnapshot(2, day.Add(15*time.Hour), 301, "treatment")
+
+	e := NewEval()
+	entities := []*models.EvaluationEntity{
+		{EntityID: "entityID1", EntityContext: map[string]interface{}{"dl_state": "CA"}},
+	}
+	timestamp := func(t time.Time) *strfmt.DateTime {
+		ts := strfmt.DateTime(t)
+		return &ts
+	}
+
+	t.Run("by timestamp", func(t *testing.T) {
+		res := e.PostEvaluationSnapshot(evaluation.PostEvaluationSnapshotParams{
+			Body: &models.EvaluationSnapshotRequest{
+				FlagID:    util.Int64Ptr(100),
+				Timestamp: timestamp(day.Add(14 * time.Hour)),
+				Entities:  entities,
+			},
+		})
+		payload := res.(*evaluation.PostEvaluationSnapshotOK).Payload
+		assert.Equal(t, int64(1), *payload.FlagSnapshotID)
+		assert.Equal(t, "control", payload.EvaluationResults[0].VariantKey)
+		assert.Equal(t, models.EvalResultReasonMATCHED, payload.EvaluationResults[0].Reason)
+	})
+
+	t.Run("by flagSnapshotID", func(t *testing.T) {
+		res := e.PostEvaluationSnapshot(evaluation.PostEvaluationSnapshotParams{
+			Body: &models.EvaluationSnapshotRequest{
+				FlagID:         util.Int64Ptr(100),
+				FlagSnapshotID: 2,
+				Entities:       entities,
+			},
+		})
+		payload := res.(*evaluation.PostEvaluationSnapshotOK).Payload
+		assert.Equal(t, int64(2), *payload.FlagSnapshotID)
+		assert.Equal(t, "treatment", payload.EvaluationResults[0].VariantKey)
+	})
+
+	t.Run("evaluated at the time of the timestamp", func(t *testing.T) {
+		// the segment is time-boxed until 17:00, and entityID2 is pinned to control until 17:00
+		f := entity.GenFixtureFlag()
+		f.Segments[0].Constraints = append(f.Segments[0].Constraints, entity.Constraint{
+			Property: entity.NowProperty,
+			Operator: models.ConstraintOperatorBEFORE,
+			Value:    `"2025-11-27T17:00:00Z"`,
+		})
+		f.Segments[0].Prerequisites = entity.Prerequisites{{FlagKey: "flag_key_100", VariantKeys: []string{"control", "treatment"}}}
+		expiresAt := day.Add(17 * time.Hour)
+		f.Overrides = []entity.VariantOverride{{FlagID: 100, EntityID: "entityID2", VariantID: 300, ExpiresAt: &expiresAt}}
+		b, err := json.Marshal(f)
+		assert.NoError(t, err)
+		db.Create(&entity.FlagSnapshot{Model: gorm.Model{ID: 3, CreatedAt: day.Add(16 * time.Hour)}, FlagID: 100, Flag: b})
+
+		evalAt := func(ts *strfmt.DateTime) *models.EvaluationSnapshotResponse {
+			body := &models.EvaluationSnapshotRequest{
+				FlagID:    util.Int64Ptr(100),
+				Timestamp: ts,
+				Entities: append(entities, &models.EvaluationEntity{
+					EntityID: "entityID2", EntityContext: map[string]interface{}{"dl_state": "NY"},
+				}),
+			}
+			if ts == nil {
+				body.FlagSnapshotID = 3
+			}
+			res := e.PostEvaluationSnapshot(evaluation.PostEvaluationSnapshotParams{Body: body})
+			return res.(*evaluation.PostEvaluationSnapshotOK).Payload
+		}
+
+		payload := evalAt(timestamp(day.Add(16*time.Hour + 30*time.Minute)))
+		assert.Equal(t, int64(3), *payload.FlagSnapshotID)
+		assert.Equal(t, strfmt.DateTime(day.Add(16*time.Hour+30*time.Minute)).String(), payload.EvaluatedAt.String())
+		assert.Equal(t, models.EvalResultReasonMATCHED, payload.EvaluationResults[0].Reason)
+		assert.Equal(t, models.EvalResultReasonOVERRIDE, payload.EvaluationResults[1].Reason)
+		assert.Equal(t, []string{"the prerequisite flags of the segments are evaluated with their current definitions"}, payload.Warnings)
+
+		payload = evalAt(timestamp(day.Add(18 * time.Hour)))
+		assert.Equal(t, int64(3), *payload.FlagSnapshotID)
+		assert.Equal(t, models.EvalResultReasonNOMATCH, payload.EvaluationResults[0].Reason)
+		assert.Equal(t, models.EvalResultReasonNOMATCH, payload.EvaluationResults[1].Reason)
+
+		// the time of the snapshot found by flagSnapshotID
+		payload = evalAt(nil)
+		assert.Equal(t, strfmt.DateTime(day.Add(16*time.Hour)).String(), payload.EvaluatedAt.String())
+		assert.Equal(t, models.EvalResultReasonMATCHED, payload.EvaluationResults[0].Reason)
+		assert.Equal(t, models.EvalResultReasonOVERRIDE, payload.EvaluationResults[1].Reason)
+	})
+
+	t.Run("timestamp before the first snapshot", func(t *testing.T) {
+		res := e.PostEvaluationSnapshot(evaluation.PostEvaluationSnapshotParams{
+			Body: &models.EvaluationSnapshotRequest{
+				FlagID:    util.Int64Ptr(100),
+				Timestamp: timestamp(day),
+				Entities:  entities,
+			},
+		})
+		assert.NotZero(t, res.(*evaluation.PostEvaluationSnapshotDefault).Payload)
+	})
+
+	t.Run("snapshot of another flag", func(t *testing.T) {
+		res := e.PostEvaluationSnapshot(evaluation.PostEvaluationSnapshotParams{
+			Body: &models.EvaluationSnapshotRequest{
+				FlagID:         util.Int64Ptr(999),
+				FlagSnapshotID: 2,
+				Entities:       entities,
+			},
+		})
+		assert.NotZero(t, res.(*evaluation.PostEvaluationSnapshotDefault).Payload)
+	})
+
+	t.Run("either flagSnapshotID or timestamp", func(t *testing.T) {
+		res := e.PostEvaluationSnapshot(evaluation.PostEvaluationSnapshotParams{
+			Body: &models.EvaluationSnapshotRequest{
+				FlagID:   util.Int64Ptr(100),
+				Entities: entities,
+			},
+		})
+		assert.NotZero(t, res.(*evaluation.PostEvaluationSnapshotDefault).Payload)
+
+		res = e.PostEvaluationSnapshot(evaluation.PostEvaluationSnapshotParams{
+			Body: &models.EvaluationSnapshotRequest{
+				FlagID:         util.Int64Ptr(100),
+				FlagSnapshotID: 2,
+				Timestamp:      timestamp(day),
+				Entities:       entities,
+			},
+		})
+		assert.NotZero(t, res.(*evaluation.PostEvaluationSnapshotDefault).Payload)
+	})
+
+	assert.Zero(t, logged)
+}
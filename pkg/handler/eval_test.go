@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/dchest/uniuri"
+	"github.com/openflagr/flagr/pkg/config"
 	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/evaluator"
 	"github.com/openflagr/flagr/pkg/util"
 	"github.com/openflagr/flagr/swagger_gen/models"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
@@ -16,135 +19,6 @@ import (
 	"gorm.io/gorm"
 )
 
-func TestEvalSegment(t *testing.T) {
-	t.Run("test empty evalContext", func(t *testing.T) {
-		s := entity.GenFixtureSegment()
-		vID, log, evalNextSegment := evalSegment(100, models.EvalContext{}, s)
-
-		assert.Nil(t, vID)
-		assert.NotEmpty(t, log)
-		assert.True(t, evalNextSegment)
-	})
-
-	t.Run("test happy code path", func(t *testing.T) {
-		s := entity.GenFixtureSegment()
-		s.RolloutPercent = uint(100)
-		vID, log, evalNextSegment := evalSegment(100, models.EvalContext{
-			EnableDebug:   true,
-			EntityContext: map[string]interface{}{"dl_state": "CA"},
-			EntityID:      "entityID1",
-			EntityType:    "entityType1",
-			FlagID:        int64(100),
-		}, s)
-
-		assert.NotNil(t, vID)
-		assert.NotEmpty(t, log)
-		assert.False(t, evalNextSegment)
-	})
-
-	t.Run("test constraint evaluation error", func(t *testing.T) {
-		s := entity.GenFixtureSegment()
-		s.RolloutPercent = uint(100)
-		vID, log, evalNextSegment := evalSegment(100, models.EvalContext{
-			EnableDebug:   true,
-			EntityContext: map[string]interface{}{},
-			EntityID:      "entityID1",
-			EntityType:    "entityType1",
-			FlagID:        int64(100),
-		}, s)
-
-		assert.Nil(t, vID)
-		assert.NotEmpty(t, log)
-		assert.True(t, evalNextSegment)
-	})
-
-	t.Run("test constraint not match", func(t *testing.T) {
-		s := entity.GenFixtureSegment()
-		s.RolloutPercent = uint(100)
-		vID, log, evalNextSegment := evalSegment(100, models.EvalContext{
-			EnableDebug:   true,
-			EntityContext: map[string]interface{}{"dl_state": "NY"},
-			EntityID:      "entityID1",
-			EntityType:    "entityType1",
-			FlagID:        int64(100),
-		}, s)
-
-		assert.Nil(t, vID)
-		assert.NotEmpty(t, log)
-		assert.True(t, evalNextSegment)
-	})
-
-	t.Run("test evalContext wrong format", func(t *testing.T) {
-		s := entity.GenFixtureSegment()
-		s.RolloutPercent = uint(100)
-		vID, log, evalNextSegment := evalSegment(100, models.EvalContext{
-			EnableDebug:   true,
-			EntityContext: nil,
-			EntityID:      "entityID1",
-			EntityType:    "entityType1",
-			FlagID:        int64(100),
-		}, s)
-
-		assert.Nil(t, vID)
-		assert.NotEmpty(t, log)
-		assert.True(t, evalNextSegment)
-	})
-
-	t.Run("test float comparison - 9990403>=9990404 evals to be false", func(t *testing.T) {
-		s := entity.GenFixtureSegment()
-		s.RolloutPercent = uint(100)
-		s.Constraints = []entity.Constraint{
-			{
-				Model:     gorm.Model{ID: 500},
-				SegmentID: 200,
-				Property:  "foo",
-				Operator:  models.ConstraintOperatorGTE,
-				Value:     `9990404`,
-			},
-		}
-		s.PrepareEvaluation()
-
-		vID, log, evalNextSegment := evalSegment(100, models.EvalContext{
-			EnableDebug:   true,
-			EntityContext: map[string]interface{}{"foo": float64(9990403)},
-			EntityID:      "entityID1",
-			EntityType:    "entityType1",
-			FlagID:        int64(100),
-		}, s)
-
-		assert.Nil(t, vID)
-		assert.NotZero(t, log)
-		assert.True(t, evalNextSegment)
-	})
-
-	t.Run("test float comparison - 9990404>=9990403 evals to be true", func(t *testing.T) {
-		s := entity.GenFixtureSegment()
-		s.RolloutPercent = uint(100)
-		s.Constraints = []entity.Constraint{
-			{
-				Model:     gorm.Model{ID: 500},
-				SegmentID: 200,
-				Property:  "foo",
-				Operator:  models.ConstraintOperatorGTE,
-				Value:     `9990403`,
-			},
-		}
-		s.PrepareEvaluation()
-
-		vID, log, evalNextSegment := evalSegment(100, models.EvalContext{
-			EnableDebug:   true,
-			EntityContext: map[string]interface{}{"foo": float64(9990404)},
-			EntityID:      "entityID1",
-			EntityType:    "entityType1",
-			FlagID:        int64(100),
-		}, s)
-
-		assert.NotZero(t, vID)
-		assert.NotZero(t, log)
-		assert.False(t, evalNextSegment)
-	})
-}
-
 func TestEvalFlag(t *testing.T) {
 	defer gostub.StubFunc(&logEvalResult).Reset()
 
@@ -173,6 +47,18 @@ func TestEvalFlag(t *testing.T) {
 		assert.Contains(t, result.FlagTags, "tag2")
 	})
 
+	t.Run("test valueType of the flag", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.ValueType = entity.ValueTypeObject
+		f.PrepareEvaluation()
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]interface{}{"dl_state": "CA"},
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		})
+		assert.Equal(t, entity.ValueTypeObject, result.ValueType)
+	})
+
 	t.Run("test happy code path with flagKey", func(t *testing.T) {
 		defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
 		result := EvalFlag(models.EvalContext{
@@ -256,7 +142,7 @@ func TestEvalFlag(t *testing.T) {
 		f := entity.GenFixtureFlag()
 		f.Segments = append(f.Segments, entity.GenFixtureSegment())
 		f.Segments[0].Constraints = []entity.Constraint{}
-		f.Segments[0].RolloutPercent = uint(0)
+		f.Segments[0].RolloutPercent = float64(0)
 
 		f.PrepareEvaluation()
 		ec := &EvalCache{
@@ -368,6 +254,327 @@ func TestEvalFlag(t *testing.T) {
 	})
 }
 
+func TestEvalFlagWithPrerequisites(t *testing.T) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	prerequisiteFlag := entity.GenFixtureFlag()
+	genEvalCache := func(prerequisites entity.Prerequisites) *EvalCache {
+		f := entity.GenFixtureFlag()
+		f.ID = 101
+		f.Key = "flag_key_101"
+		f.Segments[0].Prerequisites = prerequisites
+		f.PrepareEvaluation()
+		return &EvalCache{
+			cache: &cacheContainer{
+				idCache:  map[string]*entity.Flag{"100": &prerequisiteFlag, "101": &f},
+				keyCache: map[string]*entity.Flag{prerequisiteFlag.Key: &prerequisiteFlag, f.Key: &f},
+			},
+		}
+	}
+	evalContext := models.EvalContext{
+		EnableDebug:   true,
+		EntityContext: map[string]interface{}{"dl_state": "CA"},
+		EntityID:      "entityID1",
+		EntityType:    "entityType1",
+		FlagID:        int64(101),
+	}
+
+	defer gostub.StubFunc(&GetEvalCache, genEvalCache(nil)).Reset()
+	prerequisiteVariantKey := EvalFlag(models.EvalContext{
+		EntityContext: evalContext.EntityContext,
+		EntityID:      evalContext.EntityID,
+		FlagID:        int64(100),
+	}).VariantKey
+	assert.NotEmpty(t, prerequisiteVariantKey)
+
+	t.Run("prerequisites met", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(entity.Prerequisites{
+			{FlagKey: "flag_key_100", VariantKeys: []string{prerequisiteVariantKey}},
+		})).Reset()
+		result := EvalFlag(evalContext)
+		assert.NotZero(t, result.VariantID)
+		assert.Equal(t, int64(101), result.FlagID)
+		assert.Contains(t, result.EvalDebugLog.SegmentDebugLogs[0].Msg, "prerequisites met: flag_key_100="+prerequisiteVariantKey)
+	})
+
+	t.Run("prerequisites not met", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(entity.Prerequisites{
+			{FlagKey: "flag_key_100", VariantKeys: []string{"not_a_variant"}},
+		})).Reset()
+		result := EvalFlag(evalContext)
+		assert.Zero(t, result.VariantID)
+		assert.Contains(t, result.EvalDebugLog.SegmentDebugLogs[0].Msg, "prerequisites not met")
+	})
+
+	t.Run("prerequisite flag not found", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(entity.Prerequisites{
+			{FlagKey: "flag_key_999", VariantKeys: []string{"control"}},
+		})).Reset()
+		result := EvalFlag(evalContext)
+		assert.Zero(t, result.VariantID)
+		assert.Contains(t, result.EvalDebugLog.SegmentDebugLogs[0].Msg, "flagKey flag_key_999 not found")
+	})
+
+	t.Run("sticky prerequisite of a non-sticky flag", func(t *testing.T) {
+		ec := genEvalCache(entity.Prerequisites{
+			{FlagKey: "flag_key_100", VariantKeys: []string{"control", "treatment"}},
+		})
+		sticky := *ec.cache.keyCache["flag_key_100"]
+		sticky.StickyAssignments = true
+		sticky.AssignmentSalt = "salt"
+		ec.cache.idCache["100"] = &sticky
+		ec.cache.keyCache["flag_key_100"] = &sticky
+		defer gostub.StubFunc(&GetEvalCache, ec).Reset()
+		store := evaluator.NewMemoryAssignmentStore()
+		defer gostub.StubFunc(&GetAssignmentStore, store).Reset()
+
+		EvalFlag(evalContext)
+		vID, ok := store.GetAssignment(100, "salt", "entityID1")
+		assert.True(t, ok)
+		assert.NotZero(t, vID)
+	})
+
+	t.Run("prerequisite cycle is cut at max depth", func(t *testing.T) {
+		ec := genEvalCache(entity.Prerequisites{
+			{FlagKey: "flag_key_101", VariantKeys: []string{"control", "treatment"}},
+		})
+		defer gostub.StubFunc(&GetEvalCache, ec).Reset()
+		result := EvalFlag(evalContext)
+		assert.Zero(t, result.VariantID)
+	})
+}
+
+func TestEvalFlagWithOverrides(t *testing.T) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	now := time.Date(2025, 11, 28, 0, 0, 0, 0, time.UTC)
+	defer gostub.StubFunc(&entity.Now, now).Reset()
+	expired := now.Add(-time.Hour)
+
+	f := entity.GenFixtureFlag()
+	f.Overrides = []entity.VariantOverride{
+		{Model: gorm.Model{ID: 1}, FlagID: 100, EntityID: "qa_user", VariantID: 301},
+		{Model: gorm.Model{ID: 2}, FlagID: 100, EntityID: "qa_user", EntityType: "tester", VariantID: 300},
+		{Model: gorm.Model{ID: 3}, FlagID: 100, EntityID: "former_qa_user", VariantID: 301, ExpiresAt: &expired},
+	}
+	f.PrepareEvaluation()
+	defer gostub.StubFunc(&GetEvalCache, &EvalCache{
+		cache: &cacheContainer{
+			idCache:  map[string]*entity.Flag{"100": &f},
+			keyCache: map[string]*entity.Flag{f.Key: &f},
+		},
+	}).Reset()
+
+	t.Run("override takes precedence over the segments", func(t *testing.T) {
+		// the entity doesn't match the constraint of the segment
+		result := EvalFlag(models.EvalContext{
+			EntityContext: map[string]interface{}{"dl_state": "NY"},
+			EntityID:      "qa_user",
+			FlagID:        int64(100),
+		})
+		assert.Equal(t, int64(301), result.VariantID)
+		assert.Equal(t, "treatment", result.VariantKey)
+		assert.Zero(t, result.SegmentID)
+		assert.Contains(t, result.EvalDebugLog.Msg, "from the override 1 of entityID qa_user")
+	})
+
+	t.Run("override of the entityType takes precedence", func(t *testing.T) {
+		result := EvalFlag(models.EvalContext{
+			EntityContext: map[string]interface{}{"dl_state": "NY"},
+			EntityID:      "qa_user",
+			EntityType:    "tester",
+			FlagID:        int64(100),
+		})
+		assert.Equal(t, int64(300), result.VariantID)
+		assert.Equal(t, "control", result.VariantKey)
+	})
+
+	t.Run("expired override is ignored", func(t *testing.T) {
+		result := EvalFlag(models.EvalContext{
+			EntityContext: map[string]interface{}{"dl_state": "NY"},
+			EntityID:      "former_qa_user",
+			FlagID:        int64(100),
+		})
+		assert.Zero(t, result.VariantID)
+		assert.Empty(t, result.EvalDebugLog.Msg)
+	})
+}
+
+func TestEvalFlagWithDefaultVariant(t *testing.T) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	genEvalCache := func(modify func(f *entity.Flag)) *EvalCache {
+		f := entity.GenFixtureFlag()
+		f.DefaultVariantID = 300
+		modify(&f)
+		f.PrepareEvaluation()
+		return &EvalCache{
+			cache: &cacheContainer{
+				idCache:  map[string]*entity.Flag{"100": &f},
+				keyCache: map[string]*entity.Flag{f.Key: &f},
+			},
+		}
+	}
+	evalContext := models.EvalContext{
+		EntityContext: map[string]interface{}{"dl_state": "CA"},
+		EntityID:      "entityID1",
+		FlagID:        int64(100),
+	}
+
+	t.Run("disabled flag", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) { f.Enabled = false })).Reset()
+		result := EvalFlag(evalContext)
+		assert.Equal(t, int64(300), result.VariantID)
+		assert.Equal(t, "control", result.VariantKey)
+		assert.Equal(t, "flagID 100 is not enabled, default variantID 300 of the flag", result.EvalDebugLog.Msg)
+	})
+
+	t.Run("flag without segments", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) { f.Segments = nil })).Reset()
+		result := EvalFlag(evalContext)
+		assert.Equal(t, int64(300), result.VariantID)
+	})
+
+	t.Run("no segment matches", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) {})).Reset()
+		ctx := evalContext
+		ctx.EntityContext = map[string]interface{}{"dl_state": "NY"}
+		result := EvalFlag(ctx)
+		assert.Equal(t, int64(300), result.VariantID)
+		assert.Equal(t, "default variantID 300 of the flag", result.EvalDebugLog.Msg)
+	})
+
+	t.Run("rollout miss gets the default variant of the segment", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) {
+			f.Segments[0].RolloutPercent = 0
+			f.Segments[0].DefaultVariantID = 301
+		})).Reset()
+		ctx := evalContext
+		ctx.EnableDebug = true
+		result := EvalFlag(ctx)
+		assert.Equal(t, int64(301), result.VariantID)
+		assert.Equal(t, "treatment", result.VariantKey)
+		assert.Equal(t, int64(200), result.SegmentID)
+	})
+
+	t.Run("rollout miss without the default variant of the segment", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) {
+			f.Segments[0].RolloutPercent = 0
+		})).Reset()
+		result := EvalFlag(evalContext)
+		assert.Equal(t, int64(300), result.VariantID)
+	})
+}
+
+func TestEvalFlagReason(t *testing.T) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	genEvalCache := func(modify func(f *entity.Flag)) *EvalCache {
+		f := entity.GenFixtureFlag()
+		modify(&f)
+		f.PrepareEvaluation()
+		return &EvalCache{
+			cache: &cacheContainer{
+				idCache:  map[string]*entity.Flag{"100": &f},
+				keyCache: map[string]*entity.Flag{f.Key: &f},
+			},
+		}
+	}
+	evalContext := models.EvalContext{
+		EntityContext: map[string]interface{}{"dl_state": "CA"},
+		EntityID:      "entityID1",
+		FlagID:        int64(100),
+	}
+
+	t.Run("MATCHED", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) {
+			f.Segments[0].Rank = 3
+		})).Reset()
+		result := EvalFlag(evalContext)
+		assert.Equal(t, models.EvalResultReasonMATCHED, result.Reason)
+		assert.Equal(t, util.Int64Ptr(3), result.SegmentRank)
+		assert.NotZero(t, result.VariantID)
+	})
+
+	t.Run("FLAG_NOT_FOUND", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) {})).Reset()
+		ctx := evalContext
+		ctx.FlagID = 999
+		result := EvalFlag(ctx)
+		assert.Equal(t, models.EvalResultReasonFLAGNOTFOUND, result.Reason)
+		assert.Nil(t, result.SegmentRank)
+	})
+
+	t.Run("FLAG_DISABLED", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) { f.Enabled = false })).Reset()
+		result := EvalFlag(evalContext)
+		assert.Equal(t, models.EvalResultReasonFLAGDISABLED, result.Reason)
+	})
+
+	t.Run("NO_SEGMENTS", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) { f.Segments = nil })).Reset()
+		result := EvalFlag(evalContext)
+		assert.Equal(t, models.EvalResultReasonNOSEGMENTS, result.Reason)
+	})
+
+	t.Run("NO_MATCH", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) {})).Reset()
+		ctx := evalContext
+		ctx.EntityContext = map[string]interface{}{"dl_state": "NY"}
+		result := EvalFlag(ctx)
+		assert.Equal(t, models.EvalResultReasonNOMATCH, result.Reason)
+		assert.Nil(t, result.SegmentRank)
+	})
+
+	t.Run("ROLLOUT_EXCLUDED", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) {
+			f.Segments[0].RolloutPercent = 0
+		})).Reset()
+		result := EvalFlag(evalContext)
+		assert.Equal(t, models.EvalResultReasonROLLOUTEXCLUDED, result.Reason)
+		assert.Equal(t, util.Int64Ptr(0), result.SegmentRank)
+		assert.Zero(t, result.VariantID)
+	})
+
+	t.Run("OVERRIDE", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) {
+			f.Overrides = []entity.VariantOverride{
+				{Model: gorm.Model{ID: 1}, FlagID: 100, EntityID: "entityID1", VariantID: 301},
+			}
+		})).Reset()
+		result := EvalFlag(evalContext)
+		assert.Equal(t, models.EvalResultReasonOVERRIDE, result.Reason)
+		assert.Nil(t, result.SegmentRank)
+	})
+
+	t.Run("OVERRIDE without segments", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) {
+			f.Segments = nil
+			f.Overrides = []entity.VariantOverride{
+				{Model: gorm.Model{ID: 1}, FlagID: 100, EntityID: "entityID1", VariantID: 301},
+			}
+		})).Reset()
+		result := EvalFlag(evalContext)
+		assert.Equal(t, models.EvalResultReasonOVERRIDE, result.Reason)
+		assert.Equal(t, "treatment", result.VariantKey)
+
+		c := evalContext
+		c.EntityID = "entityID2"
+		result = EvalFlag(c)
+		assert.Equal(t, models.EvalResultReasonNOSEGMENTS, result.Reason)
+		assert.Zero(t, result.VariantID)
+	})
+
+	t.Run("ERROR", func(t *testing.T) {
+		defer gostub.StubFunc(&GetEvalCache, genEvalCache(func(f *entity.Flag) {})).Reset()
+		ctx := evalContext
+		ctx.EntityContext = "dl_state=CA"
+		result := EvalFlag(ctx)
+		assert.Equal(t, models.EvalResultReasonERROR, result.Reason)
+		assert.Zero(t, result.VariantID)
+	})
+}
+
 func TestEvalFlagDistribution(t *testing.T) {
 	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
 
@@ -503,24 +710,114 @@ func TestPostEvaluation(t *testing.T) {
 }
 
 func TestPostEvaluationBatch(t *testing.T) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+
+	genEntities := func(n int) []*models.EvaluationEntity {
+		entities := []*models.EvaluationEntity{}
+		for i := 0; i < n; i++ {
+			entities = append(entities, &models.EvaluationEntity{
+				EntityContext: map[string]interface{}{"dl_state": "CA"},
+				EntityID:      fmt.Sprintf("entityID%d", i),
+				EntityType:    "entityType1",
+			})
+		}
+		return entities
+	}
+
 	t.Run("test happy code path", func(t *testing.T) {
-		defer gostub.StubFunc(&EvalFlag, &models.EvalResult{}).Reset()
 		e := NewEval()
 		resp := e.PostEvaluationBatch(evaluation.PostEvaluationBatchParams{
 			Body: &models.EvaluationBatchRequest{
 				EnableDebug: true,
-				Entities: []*models.EvaluationEntity{
-					{
-						EntityContext: map[string]interface{}{"dl_state": "CA", "state": "NY"},
-						EntityID:      "entityID1",
-						EntityType:    "entityType1",
-					},
-				},
-				FlagIDs:  []int64{100, 200},
-				FlagKeys: []string{"flag_key_1", "flag_key_2"},
+				Entities:    genEntities(1),
+				FlagIDs:     []int64{100, 200},
+				FlagKeys:    []string{"flag_key_1", "flag_key_2"},
 			},
 		})
-		assert.NotNil(t, resp)
+		results := resp.(*evaluation.PostEvaluationBatchOK).Payload.EvaluationResults
+		assert.Len(t, results, 4)
+		assert.Equal(t, models.EvalResultReasonMATCHED, results[0].Reason)
+		assert.Equal(t, int64(200), results[1].FlagID)
+		assert.Equal(t, models.EvalResultReasonFLAGNOTFOUND, results[1].Reason)
+		assert.Equal(t, "flag_key_1", results[2].FlagKey)
+		assert.Equal(t, "flag_key_2", results[3].FlagKey)
+	})
+
+	t.Run("test flags selected multiple times are evaluated once", func(t *testing.T) {
+		e := NewEval()
+		resp := e.PostEvaluationBatch(evaluation.PostEvaluationBatchParams{
+			Body: &models.EvaluationBatchRequest{
+				Entities: genEntities(2),
+				FlagTags: []string{"tag1", "tag2"},
+				FlagIDs:  []int64{100, 200, 200},
+				FlagKeys: []string{"flag_key_100", "flag_key_1", "flag_key_1"},
+			},
+		})
+		results := resp.(*evaluation.PostEvaluationBatchOK).Payload.EvaluationResults
+		assert.Len(t, results, 6)
+		for _, r := range results[:3] {
+			assert.Equal(t, "entityID0", r.EvalContext.EntityID)
+		}
+		assert.Equal(t, int64(100), results[0].FlagID)
+		assert.Equal(t, []string{"tag1", "tag2"}, results[0].EvalContext.FlagTags)
+		assert.Equal(t, int64(200), results[1].FlagID)
+		assert.Equal(t, "flag_key_1", results[2].FlagKey)
+	})
+
+	t.Run("test results keep the order of the entities", func(t *testing.T) {
+		defer gostub.Stub(&config.Config.EvalBatchConcurrency, 4).Reset()
+		e := NewEval()
+		resp := e.PostEvaluationBatch(evaluation.PostEvaluationBatchParams{
+			Body: &models.EvaluationBatchRequest{
+				Entities: genEntities(50),
+				FlagIDs:  []int64{100},
+			},
+		})
+		results := resp.(*evaluation.PostEvaluationBatchOK).Payload.EvaluationResults
+		assert.Len(t, results, 50)
+		for i, r := range results {
+			assert.Equal(t, fmt.Sprintf("entityID%d", i), r.EvalContext.EntityID)
+		}
+	})
+
+	t.Run("test KEYED responseFormat", func(t *testing.T) {
+		e := NewEval()
+		resp := e.PostEvaluationBatch(evaluation.PostEvaluationBatchParams{
+			Body: &models.EvaluationBatchRequest{
+				Entities:       genEntities(2),
+				FlagIDs:        []int64{100, 200},
+				ResponseFormat: util.StringPtr(models.EvaluationBatchRequestResponseFormatKEYED),
+			},
+		})
+		payload := resp.(*evaluation.PostEvaluationBatchOK).Payload
+		assert.Empty(t, payload.EvaluationResults)
+		assert.Len(t, payload.KeyedEvaluationResults, 2)
+		assert.Equal(t, models.EvalResultReasonMATCHED, payload.KeyedEvaluationResults["entityID1"]["flag_key_100"].Reason)
+		assert.Equal(t, models.EvalResultReasonFLAGNOTFOUND, payload.KeyedEvaluationResults["entityID1"]["200"].Reason)
+	})
+
+	t.Run("test KEYED responseFormat requires unique entityIDs", func(t *testing.T) {
+		e := NewEval()
+		entities := append(genEntities(1), genEntities(1)...)
+		resp := e.PostEvaluationBatch(evaluation.PostEvaluationBatchParams{
+			Body: &models.EvaluationBatchRequest{
+				Entities:       entities,
+				FlagIDs:        []int64{100},
+				ResponseFormat: util.StringPtr(models.EvaluationBatchRequestResponseFormatKEYED),
+			},
+		})
+		assert.NotZero(t, resp.(*evaluation.PostEvaluationBatchDefault).Payload)
+
+		entities[0].EntityID = ""
+		resp = e.PostEvaluationBatch(evaluation.PostEvaluationBatchParams{
+			Body: &models.EvaluationBatchRequest{
+				Entities:       entities,
+				FlagIDs:        []int64{100},
+				ResponseFormat: util.StringPtr(models.EvaluationBatchRequestResponseFormatKEYED),
+			},
+		})
+		assert.NotZero(t, resp.(*evaluation.PostEvaluationBatchDefault).Payload)
 	})
 }
 
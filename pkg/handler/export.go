@@ -38,9 +38,16 @@ var exportSQLiteFile = func(excludeSnapshots *bool) (file io.ReadCloser, done fu
 	}
 	defer sqlDB.Close()
 
+	// audiences go before flags, as the segments of flags reference them
+	if err := exportAudiences(tmpDB); err != nil {
+		return nil, done, err
+	}
 	if err := exportFlags(tmpDB); err != nil {
 		return nil, done, err
 	}
+	if err := exportIDLists(tmpDB); err != nil {
+		return nil, done, err
+	}
 	if excludeSnapshots == nil || !*excludeSnapshots {
 		if err := exportFlagSnapshots(tmpDB); err != nil {
 			return nil, done, err
@@ -72,6 +79,43 @@ var exportFlags = func(tmpDB *gorm.DB) error {
 	return nil
 }
 
+var exportAudiences = func(tmpDB *gorm.DB) error {
+	var as []entity.Audience
+	if err := entity.PreloadAudienceConstraints(getDB()).Find(&as).Error; err != nil {
+		return err
+	}
+	for _, a := range as {
+		if err := tmpDB.Create(&a).Error; err != nil {
+			return err
+		}
+	}
+	logrus.WithField("count", len(as)).Debugf("export audiences")
+	return nil
+}
+
+var exportIDLists = func(tmpDB *gorm.DB) error {
+	var ls []entity.IDList
+	if err := getDB().Find(&ls).Error; err != nil {
+		return err
+	}
+	for _, l := range ls {
+		if err := tmpDB.Create(&l).Error; err != nil {
+			return err
+		}
+	}
+	var entries []entity.IDListEntry
+	if err := getDB().Find(&entries).Error; err != nil {
+		return err
+	}
+	if len(entries) != 0 {
+		if err := tmpDB.CreateInBatches(entries, 1000).Error; err != nil {
+			return err
+		}
+	}
+	logrus.WithField("count", len(ls)).Debugf("export ID lists")
+	return nil
+}
+
 var exportFlagSnapshots = func(tmpDB *gorm.DB) error {
 	var snapshots []entity.FlagSnapshot
 	if err := getDB().Find(&snapshots).Error; err != nil {
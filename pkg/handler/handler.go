@@ -1,23 +1,33 @@
 package handler
 
 import (
+	"fmt"
+
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/openflagr/flagr/pkg/config"
 	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/util"
 	"github.com/openflagr/flagr/swagger_gen/models"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/audience"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/constraint"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/distribution"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/export"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/flag"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/health"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/id_list"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/ofrep"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/rollout_ramp"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/scheduled_change"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/segment"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/tag"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/variant"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/variant_override"
+	"github.com/sirupsen/logrus"
 )
 
-var getDB = entity.GetDB
+var getDB = GetDB
 
 // Setup initialize all the handler functions
 func Setup(api *operations.FlagrAPI) {
@@ -31,10 +41,12 @@ func Setup(api *operations.FlagrAPI) {
 	setupEvaluation(api)
 	setupCRUD(api)
 	setupExport(api)
+	setupScheduler()
 }
 
 func setupCRUD(api *operations.FlagrAPI) {
 	c := NewCRUD()
+	entity.FlagSnapshotSaved = logFlagSnapshotUpdate
 	// flags
 	api.FlagFindFlagsHandler = flag.FindFlagsHandlerFunc(c.FindFlags)
 	api.FlagCreateFlagHandler = flag.CreateFlagHandlerFunc(c.CreateFlag)
@@ -42,6 +54,7 @@ func setupCRUD(api *operations.FlagrAPI) {
 	api.FlagPutFlagHandler = flag.PutFlagHandlerFunc(c.PutFlag)
 	api.FlagDeleteFlagHandler = flag.DeleteFlagHandlerFunc(c.DeleteFlag)
 	api.FlagRestoreFlagHandler = flag.RestoreFlagHandlerFunc(c.RestoreFlag)
+	api.FlagResetFlagAssignmentSaltHandler = flag.ResetFlagAssignmentSaltHandlerFunc(c.ResetFlagAssignmentSalt)
 	api.FlagSetFlagEnabledHandler = flag.SetFlagEnabledHandlerFunc(c.SetFlagEnabledState)
 	api.FlagGetFlagSnapshotsHandler = flag.GetFlagSnapshotsHandlerFunc(c.GetFlagSnapshots)
 	api.FlagGetFlagEntityTypesHandler = flag.GetFlagEntityTypesHandlerFunc(c.GetFlagEntityTypes)
@@ -59,6 +72,40 @@ func setupCRUD(api *operations.FlagrAPI) {
 	api.SegmentDeleteSegmentHandler = segment.DeleteSegmentHandlerFunc(c.DeleteSegment)
 	api.SegmentPutSegmentsReorderHandler = segment.PutSegmentsReorderHandlerFunc(c.PutSegmentsReorder)
 
+	// audiences
+	api.AudienceCreateAudienceHandler = audience.CreateAudienceHandlerFunc(c.CreateAudience)
+	api.AudienceFindAudiencesHandler = audience.FindAudiencesHandlerFunc(c.FindAudiences)
+	api.AudienceGetAudienceHandler = audience.GetAudienceHandlerFunc(c.GetAudience)
+	api.AudiencePutAudienceHandler = audience.PutAudienceHandlerFunc(c.PutAudience)
+	api.AudienceDeleteAudienceHandler = audience.DeleteAudienceHandlerFunc(c.DeleteAudience)
+	api.AudienceFindAudienceFlagsHandler = audience.FindAudienceFlagsHandlerFunc(c.FindAudienceFlags)
+
+	// ID lists
+	api.IDListCreateIDListHandler = id_list.CreateIDListHandlerFunc(c.CreateIDList)
+	api.IDListFindIDListsHandler = id_list.FindIDListsHandlerFunc(c.FindIDLists)
+	api.IDListGetIDListHandler = id_list.GetIDListHandlerFunc(c.GetIDList)
+	api.IDListDeleteIDListHandler = id_list.DeleteIDListHandlerFunc(c.DeleteIDList)
+	api.IDListReplaceIDListEntriesHandler = id_list.ReplaceIDListEntriesHandlerFunc(c.ReplaceIDListEntries)
+	api.IDListAppendIDListEntriesHandler = id_list.AppendIDListEntriesHandlerFunc(c.AppendIDListEntries)
+	api.IDListRemoveIDListEntriesHandler = id_list.RemoveIDListEntriesHandlerFunc(c.RemoveIDListEntries)
+
+	// variant overrides
+	api.VariantOverrideCreateVariantOverrideHandler = variant_override.CreateVariantOverrideHandlerFunc(c.CreateVariantOverride)
+	api.VariantOverrideFindVariantOverridesHandler = variant_override.FindVariantOverridesHandlerFunc(c.FindVariantOverrides)
+	api.VariantOverrideDeleteVariantOverrideHandler = variant_override.DeleteVariantOverrideHandlerFunc(c.DeleteVariantOverride)
+
+	// scheduled changes
+	api.ScheduledChangeCreateScheduledChangeHandler = scheduled_change.CreateScheduledChangeHandlerFunc(c.CreateScheduledChange)
+	api.ScheduledChangeFindScheduledChangesHandler = scheduled_change.FindScheduledChangesHandlerFunc(c.FindScheduledChanges)
+	api.ScheduledChangeCancelScheduledChangeHandler = scheduled_change.CancelScheduledChangeHandlerFunc(c.CancelScheduledChange)
+
+	// rollout ramps
+	api.RolloutRampCreateRolloutRampHandler = rollout_ramp.CreateRolloutRampHandlerFunc(c.CreateRolloutRamp)
+	api.RolloutRampFindRolloutRampsHandler = rollout_ramp.FindRolloutRampsHandlerFunc(c.FindRolloutRamps)
+	api.RolloutRampPauseRolloutRampHandler = rollout_ramp.PauseRolloutRampHandlerFunc(c.PauseRolloutRamp)
+	api.RolloutRampResumeRolloutRampHandler = rollout_ramp.ResumeRolloutRampHandlerFunc(c.ResumeRolloutRamp)
+	api.RolloutRampAbortRolloutRampHandler = rollout_ramp.AbortRolloutRampHandlerFunc(c.AbortRolloutRamp)
+
 	// constraints
 	api.ConstraintCreateConstraintHandler = constraint.CreateConstraintHandlerFunc(c.CreateConstraint)
 	api.ConstraintFindConstraintsHandler = constraint.FindConstraintsHandlerFunc(c.FindConstraints)
@@ -76,6 +123,22 @@ func setupCRUD(api *operations.FlagrAPI) {
 	api.VariantDeleteVariantHandler = variant.DeleteVariantHandlerFunc(c.DeleteVariant)
 }
 
+// logFlagSnapshotUpdate counts the flag snapshot updates in statsd
+func logFlagSnapshotUpdate(flagID uint, updatedBy string) {
+	if config.Global.StatsdClient == nil {
+		return
+	}
+
+	config.Global.StatsdClient.Incr(
+		"flag.snapshot.updated",
+		[]string{
+			fmt.Sprintf("FlagID:%d", flagID),
+			fmt.Sprintf("UpdatedBy:%s", util.SafeStringWithDefault(updatedBy, "null")),
+		},
+		float64(1),
+	)
+}
+
 func setupEvaluation(api *operations.FlagrAPI) {
 	ec := GetEvalCache()
 	ec.Start()
@@ -83,11 +146,30 @@ func setupEvaluation(api *operations.FlagrAPI) {
 	e := NewEval()
 	api.EvaluationPostEvaluationHandler = evaluation.PostEvaluationHandlerFunc(e.PostEvaluation)
 	api.EvaluationPostEvaluationBatchHandler = evaluation.PostEvaluationBatchHandlerFunc(e.PostEvaluationBatch)
+	api.EvaluationPostEvaluationDryRunHandler = evaluation.PostEvaluationDryRunHandlerFunc(e.PostEvaluationDryRun)
+	api.EvaluationPostEvaluationSimulationHandler = evaluation.PostEvaluationSimulationHandlerFunc(e.PostEvaluationSimulation)
+	api.EvaluationPostEvaluationBootstrapHandler = evaluation.PostEvaluationBootstrapHandlerFunc(e.PostEvaluationBootstrap)
+	api.EvaluationGetEvaluationChangesHandler = evaluation.GetEvaluationChangesHandlerFunc(e.GetEvaluationChanges)
+	api.OfrepPostOfrepEvaluateFlagHandler = ofrep.PostOfrepEvaluateFlagHandlerFunc(e.PostOfrepEvaluateFlag)
+	api.OfrepPostOfrepEvaluateFlagsHandler = ofrep.PostOfrepEvaluateFlagsHandlerFunc(e.PostOfrepEvaluateFlags)
+	if !config.Config.EvalOnlyMode {
+		// the flag snapshots are only in the database
+		api.EvaluationPostEvaluationSnapshotHandler = evaluation.PostEvaluationSnapshotHandlerFunc(e.PostEvaluationSnapshot)
+	}
+
+	// Try GetAssignmentStore to catch the unsupported store and start its background jobs
+	GetAssignmentStore()
 
 	if config.Config.RecorderEnabled {
 		// Try GetDataRecorder to catch fatal errors before we start the evaluation api
 		GetDataRecorder()
 	}
+
+	if config.Config.GRPCEnabled {
+		if err := startEvalGRPCServer(api); err != nil {
+			logrus.WithField("err", err).Error("failed to start the gRPC evaluation server")
+		}
+	}
 }
 
 func setupHealth(api *operations.FlagrAPI) {
@@ -98,6 +180,12 @@ func setupHealth(api *operations.FlagrAPI) {
 	)
 }
 
+func setupScheduler() {
+	if config.Config.SchedulerEnabled {
+		NewScheduler().Start()
+	}
+}
+
 func setupExport(api *operations.FlagrAPI) {
 	api.ExportGetExportSqliteHandler = export.GetExportSqliteHandlerFunc(exportSQLiteHandler)
 	api.ExportGetExportEvalCacheJSONHandler = export.GetExportEvalCacheJSONHandlerFunc(exportEvalCacheJSONHandler)
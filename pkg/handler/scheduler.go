@@ -0,0 +1,253 @@
+package handler
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openflagr/flagr/pkg/config"
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// SchedulerSubject is the subject of the flag snapshots saved by the scheduler
+const SchedulerSubject = "scheduler"
+
+// Scheduler applies the due scheduled changes and rollout ramp steps of flags in the background
+type Scheduler struct {
+	interval time.Duration
+}
+
+// NewScheduler creates the Scheduler
+func NewScheduler() *Scheduler {
+	return &Scheduler{interval: config.Config.SchedulerInterval}
+}
+
+// Start starts the polling of the due scheduled changes and rollout ramp steps
+func (s *Scheduler) Start() {
+	go func() {
+		for range time.Tick(s.interval) {
+			s.applyDueChanges()
+			s.advanceDueRolloutRamps()
+		}
+	}()
+}
+
+// applyDueChanges applies the pending changes whose executeAt has passed in order,
+// and returns the number of the changes applied by this replica
+func (s *Scheduler) applyDueChanges() int {
+	scs := []entity.ScheduledChange{}
+	err := getDB().
+		Where("status = ? AND execute_at <= ?", models.ScheduledChangeStatusPENDING, entity.Now()).
+		Order("execute_at, id").
+		Find(&scs).
+		Error
+	if err != nil {
+		logrus.WithField("err", err).Error("failed to find the due scheduled changes")
+		return 0
+	}
+
+	applied := 0
+	for i := range scs {
+		ok, err := applyScheduledChange(&scs[i])
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"err":               err,
+				"flagID":            scs[i].FlagID,
+				"scheduledChangeID": scs[i].ID,
+			}).Error("failed to apply the scheduled change")
+		}
+		if ok {
+			applied++
+		}
+	}
+	return applied
+}
+
+// applyScheduledChange claims the change and applies it in a transaction. The claim is
+// a conditional update of the pending status, so with multiple replicas only the one
+// that claims it first applies the change, and the others skip it. It returns false if
+// the change is not applied by this call.
+var applyScheduledChange = func(sc *entity.ScheduledChange) (bool, error) {
+	if err := validateScheduledChange(sc); err != nil {
+		return false, failScheduledChange(sc, err)
+	}
+
+	tx := getDB().Begin()
+	res := tx.
+		Model(&entity.ScheduledChange{}).
+		Where("id = ? AND status = ?", sc.ID, models.ScheduledChangeStatusPENDING).
+		Updates(map[string]interface{}{
+			"status":     models.ScheduledChangeStatusAPPLIED,
+			"applied_at": entity.Now(),
+		})
+	if res.Error != nil {
+		tx.Rollback()
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		// applied by another replica, or canceled
+		tx.Rollback()
+		return false, nil
+	}
+
+	if err := applyScheduledChangeAction(tx, sc); err != nil {
+		tx.Rollback()
+		return false, failScheduledChange(sc, err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	entity.SaveFlagSnapshot(getDB(), sc.FlagID, SchedulerSubject)
+	return true, nil
+}
+
+func applyScheduledChangeAction(tx *gorm.DB, sc *entity.ScheduledChange) error {
+	switch sc.Action {
+	case models.ScheduledChangeActionENABLEFLAG, models.ScheduledChangeActionDISABLEFLAG:
+		return tx.
+			Model(&entity.Flag{}).
+			Where("id = ?", sc.FlagID).
+			Update("enabled", sc.Action == models.ScheduledChangeActionENABLEFLAG).
+			Error
+	case models.ScheduledChangeActionSETROLLOUTPERCENT:
+		return tx.
+			Model(&entity.Segment{}).
+			Where("id = ?", sc.SegmentID).
+			Update("rollout_percent", sc.RolloutPercent).
+			Error
+	case models.ScheduledChangeActionSETDISTRIBUTIONS:
+		if err := tx.Where("segment_id = ?", sc.SegmentID).Delete(&entity.Distribution{}).Error; err != nil {
+			return err
+		}
+		for _, d := range sc.Distributions.ToDistributions(sc.SegmentID) {
+			if err := tx.Create(&d).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("not supported action of scheduled change: %s", sc.Action)
+	}
+}
+
+// failScheduledChange marks the pending change as failed with the reason
+func failScheduledChange(sc *entity.ScheduledChange, reason error) error {
+	err := getDB().
+		Model(&entity.ScheduledChange{}).
+		Where("id = ? AND status = ?", sc.ID, models.ScheduledChangeStatusPENDING).
+		Updates(map[string]interface{}{
+			"status": models.ScheduledChangeStatusFAILED,
+			"error":  reason.Error(),
+		}).
+		Error
+	if err != nil {
+		return err
+	}
+	return reason
+}
+
+// advanceDueRolloutRamps applies the next step of the active rollout ramps whose nextStepAt
+// has passed, and returns the number of the steps applied by this replica
+func (s *Scheduler) advanceDueRolloutRamps() int {
+	rs := []entity.RolloutRamp{}
+	err := getDB().
+		Where("status = ? AND next_step_at <= ?", models.RolloutRampStatusACTIVE, entity.Now()).
+		Order("next_step_at, id").
+		Find(&rs).
+		Error
+	if err != nil {
+		logrus.WithField("err", err).Error("failed to find the due rollout ramps")
+		return 0
+	}
+
+	advanced := 0
+	for i := range rs {
+		ok, err := advanceRolloutRamp(&rs[i])
+		if err != nil {
+			logrus.WithFields(logrus.Fields{
+				"err":           err,
+				"flagID":        rs[i].FlagID,
+				"segmentID":     rs[i].SegmentID,
+				"rolloutRampID": rs[i].ID,
+			}).Error("failed to advance the rollout ramp")
+		}
+		if ok {
+			advanced++
+		}
+	}
+	return advanced
+}
+
+// advanceRolloutRamp claims the next step of the ramp and applies its rollout percent to
+// the segment in a transaction. Like the scheduled changes, the claim is a conditional
+// update of the status and the current step, so each step is applied only once across
+// replicas. The next step is due one interval after this step is applied.
+var advanceRolloutRamp = func(r *entity.RolloutRamp) (bool, error) {
+	if err := validateRolloutRamp(r); err != nil {
+		return false, failRolloutRamp(r, err)
+	}
+	if r.CurrentStep >= len(r.Steps) {
+		return false, failRolloutRamp(r, fmt.Errorf("rollout ramp has no step %d", r.CurrentStep+1))
+	}
+
+	status := models.RolloutRampStatusACTIVE
+	if r.CurrentStep+1 == len(r.Steps) {
+		status = models.RolloutRampStatusCOMPLETED
+	}
+
+	tx := getDB().Begin()
+	res := tx.
+		Model(&entity.RolloutRamp{}).
+		Where("id = ? AND status = ? AND current_step = ?", r.ID, models.RolloutRampStatusACTIVE, r.CurrentStep).
+		Updates(map[string]interface{}{
+			"status":       status,
+			"current_step": r.CurrentStep + 1,
+			"next_step_at": entity.Now().Add(r.StepInterval),
+		})
+	if res.Error != nil {
+		tx.Rollback()
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		// advanced by another replica, or paused or aborted
+		tx.Rollback()
+		return false, nil
+	}
+
+	err := tx.
+		Model(&entity.Segment{}).
+		Where("id = ?", r.SegmentID).
+		Update("rollout_percent", r.Steps[r.CurrentStep]).
+		Error
+	if err != nil {
+		tx.Rollback()
+		return false, failRolloutRamp(r, err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	entity.SaveFlagSnapshot(getDB(), r.FlagID, SchedulerSubject)
+	return true, nil
+}
+
+// failRolloutRamp marks the active ramp as failed with the reason
+func failRolloutRamp(r *entity.RolloutRamp, reason error) error {
+	err := getDB().
+		Model(&entity.RolloutRamp{}).
+		Where("id = ? AND status = ?", r.ID, models.RolloutRampStatusACTIVE).
+		Updates(map[string]interface{}{
+			"status": models.RolloutRampStatusFAILED,
+			"error":  reason.Error(),
+		}).
+		Error
+	if err != nil {
+		return err
+	}
+	return reason
+}
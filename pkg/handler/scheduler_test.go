@@ -0,0 +1,190 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/swagger_gen/models"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedulerApplyDueChanges(t *testing.T) {
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	midnight := time.Date(2025, 11, 28, 0, 0, 0, 0, time.UTC)
+	scs := []entity.ScheduledChange{
+		{FlagID: 100, ExecuteAt: midnight, Action: models.ScheduledChangeActionDISABLEFLAG},
+		{FlagID: 100, ExecuteAt: midnight, Action: models.ScheduledChangeActionSETROLLOUTPERCENT, SegmentID: 200, RolloutPercent: 30},
+		{
+			FlagID: 100, ExecuteAt: midnight, Action: models.ScheduledChangeActionSETDISTRIBUTIONS, SegmentID: 200,
+			Distributions: entity.ScheduledDistributions{
+				{VariantID: 300, VariantKey: "control", Percent: 10},
+				{VariantID: 301, VariantKey: "treatment", Percent: 90},
+			},
+		},
+		{FlagID: 100, ExecuteAt: midnight.Add(time.Hour), Action: models.ScheduledChangeActionENABLEFLAG},
+	}
+	for i := range scs {
+		scs[i].Status = models.ScheduledChangeStatusPENDING
+		db.Create(&scs[i])
+	}
+
+	s := &Scheduler{}
+
+	t.Run("nothing is due", func(t *testing.T) {
+		defer gostub.StubFunc(&entity.Now, midnight.Add(-time.Second)).Reset()
+		assert.Equal(t, 0, s.applyDueChanges())
+	})
+
+	t.Run("apply the due changes", func(t *testing.T) {
+		defer gostub.StubFunc(&entity.Now, midnight.Add(time.Second)).Reset()
+		assert.Equal(t, 3, s.applyDueChanges())
+
+		f := &entity.Flag{}
+		db.First(f, 100)
+		assert.False(t, f.Enabled)
+		assert.Equal(t, SchedulerSubject, f.UpdatedBy)
+
+		seg := &entity.Segment{}
+		entity.PreloadConstraintsDistribution(db).First(seg, 200)
+		assert.Equal(t, float64(30), seg.RolloutPercent)
+		assert.Len(t, seg.Distributions, 2)
+		assert.Equal(t, float64(10), seg.Distributions[0].Percent)
+
+		snapshots := []entity.FlagSnapshot{}
+		db.Where("flag_id = ?", 100).Find(&snapshots)
+		assert.Len(t, snapshots, 3)
+		for _, snapshot := range snapshots {
+			assert.Equal(t, SchedulerSubject, snapshot.UpdatedBy)
+		}
+
+		applied := []entity.ScheduledChange{}
+		db.Where("status = ?", models.ScheduledChangeStatusAPPLIED).Find(&applied)
+		assert.Len(t, applied, 3)
+		assert.NotNil(t, applied[0].AppliedAt)
+	})
+
+	t.Run("a change is only applied once", func(t *testing.T) {
+		defer gostub.StubFunc(&entity.Now, midnight.Add(time.Second)).Reset()
+		assert.Equal(t, 0, s.applyDueChanges())
+
+		// another replica holding a stale copy of the change skips it
+		ok, err := applyScheduledChange(&scs[0])
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("a change failing the validation is marked as failed", func(t *testing.T) {
+		defer gostub.StubFunc(&entity.Now, midnight.Add(2*time.Hour)).Reset()
+		db.Delete(&entity.Flag{}, 100)
+		assert.Equal(t, 0, s.applyDueChanges())
+
+		sc := &entity.ScheduledChange{}
+		db.First(sc, scs[3].ID)
+		assert.Equal(t, models.ScheduledChangeStatusFAILED, sc.Status)
+		assert.Contains(t, sc.Error, "error finding flagID 100")
+	})
+}
+
+func TestSchedulerAdvanceDueRolloutRamps(t *testing.T) {
+	db := entity.PopulateTestDB(entity.GenFixtureFlag())
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	start := time.Date(2025, 11, 28, 0, 0, 0, 0, time.UTC)
+	r := &entity.RolloutRamp{
+		FlagID:       100,
+		SegmentID:    200,
+		Steps:        entity.RolloutRampSteps{1, 50, 100},
+		StepInterval: 24 * time.Hour,
+		NextStepAt:   start,
+		Status:       models.RolloutRampStatusACTIVE,
+	}
+	db.Create(r)
+
+	s := &Scheduler{}
+	rolloutPercent := func() float64 {
+		seg := &entity.Segment{}
+		db.First(seg, 200)
+		return seg.RolloutPercent
+	}
+
+	t.Run("nothing is due", func(t *testing.T) {
+		defer gostub.StubFunc(&entity.Now, start.Add(-time.Second)).Reset()
+		assert.Equal(t, 0, s.advanceDueRolloutRamps())
+		assert.Equal(t, float64(100), rolloutPercent())
+	})
+
+	t.Run("apply the first step", func(t *testing.T) {
+		defer gostub.StubFunc(&entity.Now, start.Add(time.Second)).Reset()
+		assert.Equal(t, 1, s.advanceDueRolloutRamps())
+		assert.Equal(t, float64(1), rolloutPercent())
+
+		// the next step is not due yet
+		assert.Equal(t, 0, s.advanceDueRolloutRamps())
+
+		// another replica holding a stale copy of the ramp skips the step
+		ok, err := advanceRolloutRamp(r)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+
+		snapshots := []entity.FlagSnapshot{}
+		db.Where("flag_id = ?", 100).Find(&snapshots)
+		assert.Len(t, snapshots, 1)
+		assert.Equal(t, SchedulerSubject, snapshots[0].UpdatedBy)
+	})
+
+	t.Run("apply the rest steps until it's completed", func(t *testing.T) {
+		now := start.Add(time.Second)
+		for _, expected := range []float64{50, 100} {
+			now = now.Add(24 * time.Hour)
+			stubs := gostub.StubFunc(&entity.Now, now)
+			assert.Equal(t, 1, s.advanceDueRolloutRamps())
+			assert.Equal(t, expected, rolloutPercent())
+			stubs.Reset()
+		}
+
+		ramp := &entity.RolloutRamp{}
+		db.First(ramp, r.ID)
+		assert.Equal(t, models.RolloutRampStatusCOMPLETED, ramp.Status)
+		assert.Equal(t, 3, ramp.CurrentStep)
+
+		defer gostub.StubFunc(&entity.Now, now.Add(48*time.Hour)).Reset()
+		assert.Equal(t, 0, s.advanceDueRolloutRamps())
+	})
+
+	t.Run("a ramp failing the validation is marked as failed", func(t *testing.T) {
+		defer gostub.StubFunc(&entity.Now, start.Add(time.Hour)).Reset()
+		failing := &entity.RolloutRamp{
+			FlagID:       100,
+			SegmentID:    999,
+			Steps:        entity.RolloutRampSteps{50, 100},
+			StepInterval: time.Hour,
+			NextStepAt:   start,
+			Status:       models.RolloutRampStatusACTIVE,
+		}
+		db.Create(failing)
+		assert.Equal(t, 0, s.advanceDueRolloutRamps())
+
+		ramp := &entity.RolloutRamp{}
+		db.First(ramp, failing.ID)
+		assert.Equal(t, models.RolloutRampStatusFAILED, ramp.Status)
+		assert.Contains(t, ramp.Error, "error finding segmentID 999")
+	})
+}
@@ -1,22 +1,29 @@
 package handler
 
 import (
+	"math"
+	"strings"
+	"time"
+
 	"github.com/openflagr/flagr/pkg/entity"
+	"github.com/openflagr/flagr/pkg/mapper/entity_restapi/e2r"
 	"github.com/openflagr/flagr/pkg/util"
+	"github.com/openflagr/flagr/swagger_gen/models"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/distribution"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/variant"
 )
 
 var validatePutDistributions = func(params distribution.PutDistributionsParams) *Error {
+	// sum up in basis points to avoid floating point errors
 	sum := int64(0)
 	for _, d := range params.Body.Distributions {
 		if d.Percent == nil {
 			return NewError(400, "the percent of distribution %v is empty", d.ID)
 		}
-		sum += *d.Percent
+		sum += int64(math.Round(*d.Percent * 100))
 	}
-	if sum != 100 {
-		return NewError(400, "the sum of distributions' percent %v is not 100", sum)
+	if sum != 10000 {
+		return NewError(400, "the sum of distributions' percent %v is not 100", float64(sum)/100)
 	}
 
 	f := &entity.Flag{}
@@ -25,6 +32,12 @@ var validatePutDistributions = func(params distribution.PutDistributionsParams)
 	}
 	f.Preload(getDB())
 
+	for _, d := range params.Body.Distributions {
+		if err := entity.ValidatePercent(f.HashAlgorithm, *d.Percent); err != nil {
+			return NewError(400, "invalid percent of distribution %v. reason: %s", d.ID, err)
+		}
+	}
+
 	vMap := make(map[uint]string)
 	vIDs := []uint{}
 	for _, v := range f.Variants {
@@ -53,12 +66,30 @@ var validateDeleteVariant = func(params variant.DeleteVariantParams) *Error {
 	}
 	f.Preload(getDB())
 
+	// the checks run before the zero-percent distributions are deleted, so that a rejected
+	// request doesn't change the flag
+	if f.DefaultVariantID == util.SafeUint(params.VariantID) {
+		return NewError(400, "error deleting variant %v. it's the default variant of the flag", params.VariantID)
+	}
+	for _, s := range f.Segments {
+		if s.DefaultVariantID == util.SafeUint(params.VariantID) {
+			return NewError(400, "error deleting variant %v. it's the default variant of segment %v", params.VariantID, s.ID)
+		}
+		for _, d := range s.Distributions {
+			if d.VariantID == util.SafeUint(params.VariantID) && d.Percent != float64(0) {
+				return NewError(400, "error deleting variant %v. distribution %v still has non-zero distribution %v", params.VariantID, d.ID, d.Percent)
+			}
+		}
+	}
+	for _, o := range f.Overrides {
+		if o.VariantID == util.SafeUint(params.VariantID) {
+			return NewError(400, "error deleting variant %v. override %v of entityID %s still uses it", params.VariantID, o.ID, o.EntityID)
+		}
+	}
+
 	for _, s := range f.Segments {
 		for _, d := range s.Distributions {
 			if d.VariantID == util.SafeUint(params.VariantID) {
-				if d.Percent != uint(0) {
-					return NewError(400, "error deleting variant %v. distribution %v still has non-zero distribution %v", params.VariantID, d.ID, d.Percent)
-				}
 				if err := getDB().Delete(&entity.Distribution{}, d.ID).Error; err != nil {
 					return NewError(500, "error deleting distribution %v. reason: %s", d.ID, err)
 				}
@@ -80,3 +111,332 @@ var validatePutVariantForDistributions = func(v *entity.Variant) *Error {
 	}
 	return nil
 }
+
+// validateDefaultVariant validates that the default variant belongs to the flag, 0 means no default variant
+var validateDefaultVariant = func(flagID int64, variantID int64) *Error {
+	if variantID == 0 {
+		return nil
+	}
+	v := &entity.Variant{}
+	if err := getDB().Where("id = ? AND flag_id = ?", variantID, flagID).First(v).Error; err != nil {
+		return NewError(400, "error finding default variantID %v under flagID %v. reason %s", variantID, flagID, err)
+	}
+	return nil
+}
+
+var validateRolloutPercent = func(flagID int64, rolloutPercent float64) *Error {
+	f := &entity.Flag{}
+	if err := getDB().First(f, flagID).Error; err != nil {
+		return NewError(404, "error finding flagID %v. reason %s", flagID, err)
+	}
+	if err := entity.ValidatePercent(f.HashAlgorithm, rolloutPercent); err != nil {
+		return NewError(400, "invalid rolloutPercent. reason: %s", err)
+	}
+	return nil
+}
+
+var validatePutFlagHashAlgorithm = func(f *entity.Flag, hashAlgorithm string) *Error {
+	if err := entity.ValidateHashAlgorithm(hashAlgorithm); err != nil {
+		return NewError(400, "%s", err)
+	}
+
+	ss := []entity.Segment{}
+	if err := entity.PreloadConstraintsDistribution(getDB()).Where(entity.Segment{FlagID: f.ID}).Find(&ss).Error; err != nil {
+		return NewError(500, "error finding segments of flagID %v. reason: %s", f.ID, err)
+	}
+	for _, s := range ss {
+		if err := entity.ValidatePercent(hashAlgorithm, s.RolloutPercent); err != nil {
+			return NewError(400, "segment %v doesn't fit the hashAlgorithm. reason: %s", s.ID, err)
+		}
+		for _, d := range s.Distributions {
+			if err := entity.ValidatePercent(hashAlgorithm, d.Percent); err != nil {
+				return NewError(400, "distribution %v doesn't fit the hashAlgorithm. reason: %s", d.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validatePutFlagValueType validates the value type and the value schema, and that the
+// attachments of all the variants of the flag match them
+var validatePutFlagValueType = func(f *entity.Flag, valueType string, valueSchema string) *Error {
+	if err := entity.ValidateValueType(valueType); err != nil {
+		return NewError(400, "%s", err)
+	}
+	if _, err := entity.ParseValueSchema(valueSchema); err != nil {
+		return NewError(400, "%s", err)
+	}
+
+	vs := []entity.Variant{}
+	if err := getDB().Where(entity.Variant{FlagID: f.ID}).Order("id").Find(&vs).Error; err != nil {
+		return NewError(500, "error finding variants of flagID %v. reason: %s", f.ID, err)
+	}
+	typed := &entity.Flag{ValueType: valueType, ValueSchema: valueSchema}
+	for _, v := range vs {
+		if err := typed.ValidateAttachment(v.Attachment); err != nil {
+			return NewError(400, "variant %s doesn't fit the valueType. reason: %s", v.Key, err)
+		}
+	}
+	return nil
+}
+
+// validateVariantAttachment validates that the attachment matches the value type and the value schema of the flag
+var validateVariantAttachment = func(flagID int64, a entity.Attachment) *Error {
+	f := &entity.Flag{}
+	if err := getDB().First(f, flagID).Error; err != nil {
+		return NewError(404, "error finding flagID %v. reason %s", flagID, err)
+	}
+	if err := f.ValidateAttachment(a); err != nil {
+		return NewError(400, "%s", err)
+	}
+	return nil
+}
+
+// validateSegmentPrerequisites validates that the prerequisite flags and variants exist,
+// and the prerequisites don't introduce a cycle back to the flag
+var validateSegmentPrerequisites = func(flagID int64, ps entity.Prerequisites) *Error {
+	if len(ps) == 0 {
+		return nil
+	}
+
+	f := &entity.Flag{}
+	if err := getDB().First(f, flagID).Error; err != nil {
+		return NewError(404, "error finding flagID %v. reason %s", flagID, err)
+	}
+
+	for _, p := range ps {
+		if err := p.Validate(); err != nil {
+			return NewError(400, "%s", err)
+		}
+
+		pf := &entity.Flag{}
+		if err := getDB().Where(entity.Flag{Key: p.FlagKey}).First(pf).Error; err != nil {
+			return NewError(400, "error finding prerequisite flagKey %s. reason %s", p.FlagKey, err)
+		}
+		vs := []entity.Variant{}
+		if err := getDB().Where(entity.Variant{FlagID: pf.ID}).Find(&vs).Error; err != nil {
+			return NewError(500, "error finding variants of prerequisite flagKey %s. reason %s", p.FlagKey, err)
+		}
+		vKeys := make(map[string]bool, len(vs))
+		for _, v := range vs {
+			vKeys[v.Key] = true
+		}
+		for _, k := range p.VariantKeys {
+			if !vKeys[k] {
+				return NewError(400, "error finding variantKey %s of prerequisite flagKey %s", k, p.FlagKey)
+			}
+		}
+	}
+
+	// walk through the prerequisites of the prerequisite flags to detect cycles
+	visited := map[string]bool{}
+	queue := ps.FlagKeys()
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		if key == f.Key {
+			return NewError(400, "prerequisite cycle detected. flagKey %s depends on itself", f.Key)
+		}
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+
+		ss := []entity.Segment{}
+		err := getDB().
+			Joins("JOIN flags ON flags.id = segments.flag_id AND flags.deleted_at IS NULL").
+			Where("flags.key = ?", key).
+			Find(&ss).
+			Error
+		if err != nil {
+			return NewError(500, "error finding segments of flagKey %s. reason %s", key, err)
+		}
+		for _, s := range ss {
+			queue = append(queue, s.Prerequisites.FlagKeys()...)
+		}
+	}
+	return nil
+}
+
+// validateFlagNotPrerequisite validates that the segments of the other flags don't require the
+// flag, so that renaming or deleting it doesn't break their prerequisites
+var validateFlagNotPrerequisite = func(f *entity.Flag) *Error {
+	fs, err := entity.FindFlagsByPrerequisite(getDB(), f.Key)
+	if err != nil {
+		return NewError(500, "error finding the flags requiring flagKey %s. reason %s", f.Key, err)
+	}
+	keys := []string{}
+	for _, pf := range fs {
+		if pf.ID != f.ID {
+			keys = append(keys, pf.Key)
+		}
+	}
+	if len(keys) != 0 {
+		return NewError(400, "flagKey %s is a prerequisite of the segments of the flags %s", f.Key, strings.Join(keys, ", "))
+	}
+	return nil
+}
+
+// validateSegmentAudiences validates that the audiences referenced by the segment exist
+var validateSegmentAudiences = func(audienceIDs []int64) *Error {
+	if len(audienceIDs) == 0 {
+		return nil
+	}
+
+	ids := make(map[int64]bool, len(audienceIDs))
+	for _, id := range audienceIDs {
+		if ids[id] {
+			return NewError(400, "duplicate audienceID %v", id)
+		}
+		ids[id] = true
+	}
+
+	var count int64
+	if err := getDB().Model(&entity.Audience{}).Where("id IN (?)", audienceIDs).Count(&count).Error; err != nil {
+		return NewError(500, "error finding audienceIDs %v. reason %s", audienceIDs, err)
+	}
+	if int(count) != len(audienceIDs) {
+		return NewError(400, "error finding audienceIDs %v, some of them don't exist", audienceIDs)
+	}
+	return nil
+}
+
+// validateScheduledChange validates the scheduled change against the current state of the flag.
+// It's checked both when the change is created and right before the scheduler applies it.
+var validateScheduledChange = func(sc *entity.ScheduledChange) *Error {
+	f := &entity.Flag{}
+	if err := getDB().First(f, sc.FlagID).Error; err != nil {
+		return NewError(404, "error finding flagID %v. reason %s", sc.FlagID, err)
+	}
+
+	switch sc.Action {
+	case models.ScheduledChangeActionENABLEFLAG, models.ScheduledChangeActionDISABLEFLAG:
+		return nil
+	case models.ScheduledChangeActionSETROLLOUTPERCENT, models.ScheduledChangeActionSETDISTRIBUTIONS:
+		s := &entity.Segment{}
+		if err := getDB().Where("id = ? AND flag_id = ?", sc.SegmentID, sc.FlagID).First(s).Error; err != nil {
+			return NewError(400, "error finding segmentID %v under flagID %v. reason %s", sc.SegmentID, sc.FlagID, err)
+		}
+	default:
+		return NewError(400, "not supported action of scheduled change: %s", sc.Action)
+	}
+
+	if sc.Action == models.ScheduledChangeActionSETROLLOUTPERCENT {
+		return validateRolloutPercent(int64(sc.FlagID), sc.RolloutPercent)
+	}
+	return validatePutDistributions(distribution.PutDistributionsParams{
+		FlagID:    int64(sc.FlagID),
+		SegmentID: int64(sc.SegmentID),
+		Body: &models.PutDistributionsRequest{
+			Distributions: e2r.MapDistributions(sc.Distributions.ToDistributions(sc.SegmentID)),
+		},
+	})
+}
+
+// validateRolloutRamp validates the rollout ramp against the current state of the flag.
+// It's checked both when the ramp is created and right before the scheduler applies a step.
+var validateRolloutRamp = func(r *entity.RolloutRamp) *Error {
+	f := &entity.Flag{}
+	if err := getDB().First(f, r.FlagID).Error; err != nil {
+		return NewError(404, "error finding flagID %v. reason %s", r.FlagID, err)
+	}
+	s := &entity.Segment{}
+	if err := getDB().Where("id = ? AND flag_id = ?", r.SegmentID, r.FlagID).First(s).Error; err != nil {
+		return NewError(404, "error finding segmentID %v under flagID %v. reason %s", r.SegmentID, r.FlagID, err)
+	}
+	if r.StepInterval <= 0 {
+		return NewError(400, "invalid stepIntervalSeconds %v", int64(r.StepInterval/time.Second))
+	}
+	if err := r.Steps.Validate(f.HashAlgorithm); err != nil {
+		return NewError(400, "%s", err)
+	}
+	return nil
+}
+
+// validateDryRunFlag validates the flag definition of a dry run the same way as the
+// flag is validated when it's saved piece by piece, without the database
+var validateDryRunFlag = func(f *entity.Flag) *Error {
+	if err := entity.ValidateHashAlgorithm(f.HashAlgorithm); err != nil {
+		return NewError(400, "%s", err)
+	}
+	if err := entity.ValidateValueType(f.ValueType); err != nil {
+		return NewError(400, "%s", err)
+	}
+	if _, err := entity.ParseValueSchema(f.ValueSchema); err != nil {
+		return NewError(400, "%s", err)
+	}
+
+	vMap := make(map[uint]string)
+	for _, v := range f.Variants {
+		if v.ID == 0 {
+			return NewError(400, "variant %s has no id", v.Key)
+		}
+		if _, ok := vMap[v.ID]; ok {
+			return NewError(400, "duplicate variantID %v", v.ID)
+		}
+		if err := v.Validate(); err != nil {
+			return NewError(400, "invalid variant %s. reason: %s", v.Key, err)
+		}
+		if err := f.ValidateAttachment(v.Attachment); err != nil {
+			return NewError(400, "variant %s doesn't fit the valueType. reason: %s", v.Key, err)
+		}
+		vMap[v.ID] = v.Key
+	}
+	if _, ok := vMap[f.DefaultVariantID]; f.DefaultVariantID != 0 && !ok {
+		return NewError(400, "error finding the default variantID %v under this flag", f.DefaultVariantID)
+	}
+
+	for _, s := range f.Segments {
+		if err := entity.ValidatePercent(f.HashAlgorithm, s.RolloutPercent); err != nil {
+			return NewError(400, "invalid rolloutPercent of segment %v. reason: %s", s.ID, err)
+		}
+		if _, ok := vMap[s.DefaultVariantID]; s.DefaultVariantID != 0 && !ok {
+			return NewError(400, "error finding the default variantID %v of segment %v under this flag", s.DefaultVariantID, s.ID)
+		}
+		if err := s.ConstraintGroups.Validate(); err != nil {
+			return NewError(400, "invalid constraint groups of segment %v. reason: %s", s.ID, err)
+		}
+		for _, c := range s.Constraints {
+			if err := c.Validate(); err != nil {
+				return NewError(400, "invalid constraint of segment %v. reason: %s", s.ID, err)
+			}
+		}
+		if len(s.Distributions) == 0 {
+			continue
+		}
+		// sum up in basis points to avoid floating point errors
+		sum := int64(0)
+		for _, d := range s.Distributions {
+			if err := entity.ValidatePercent(f.HashAlgorithm, d.Percent); err != nil {
+				return NewError(400, "invalid percent of distribution %v. reason: %s", d.ID, err)
+			}
+			k, ok := vMap[d.VariantID]
+			if !ok {
+				return NewError(400, "error finding variantID %v of segment %v under this flag", d.VariantID, s.ID)
+			}
+			if k != d.VariantKey {
+				return NewError(400, "error matching variantID %v with variantKey %s. expecting %s", d.VariantID, d.VariantKey, k)
+			}
+			sum += int64(math.Round(d.Percent * 100))
+		}
+		if sum != 10000 {
+			return NewError(400, "the sum of distributions' percent %v of segment %v is not 100", float64(sum)/100, s.ID)
+		}
+	}
+	return nil
+}
+
+// validateKeyedBatchEntities validates that the entityIDs can be the keys of the results
+var validateKeyedBatchEntities = func(entities []*models.EvaluationEntity) *Error {
+	seen := make(map[string]bool, len(entities))
+	for _, e := range entities {
+		if e.EntityID == "" {
+			return NewError(400, "entityID is required for the KEYED responseFormat")
+		}
+		if seen[e.EntityID] {
+			return NewError(400, "duplicate entityID %s for the KEYED responseFormat", e.EntityID)
+		}
+		seen[e.EntityID] = true
+	}
+	return nil
+}
@@ -38,7 +38,7 @@ func TestValidatePutDistributions(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    util.StringPtr("segment1"),
-			RolloutPercent: util.Int64Ptr(int64(100)),
+			RolloutPercent: util.Float64Ptr(float64(100)),
 		},
 	})
 	c.CreateVariant(variant.CreateVariantParams{
@@ -55,7 +55,7 @@ func TestValidatePutDistributions(t *testing.T) {
 			Body: &models.PutDistributionsRequest{
 				Distributions: []*models.Distribution{
 					{
-						Percent:    util.Int64Ptr(int64(100)),
+						Percent:    util.Float64Ptr(float64(100)),
 						VariantID:  util.Int64Ptr(int64(1)),
 						VariantKey: util.StringPtr("control"),
 					},
@@ -91,7 +91,7 @@ func TestValidatePutDistributions(t *testing.T) {
 			Body: &models.PutDistributionsRequest{
 				Distributions: []*models.Distribution{
 					{
-						Percent:    util.Int64Ptr(int64(100)),
+						Percent:    util.Float64Ptr(float64(100)),
 						VariantID:  util.Int64Ptr(int64(1)),
 						VariantKey: util.StringPtr("control"),
 					},
@@ -109,7 +109,7 @@ func TestValidatePutDistributions(t *testing.T) {
 			Body: &models.PutDistributionsRequest{
 				Distributions: []*models.Distribution{
 					{
-						Percent:    util.Int64Ptr(int64(100)),
+						Percent:    util.Float64Ptr(float64(100)),
 						VariantID:  util.Int64Ptr(int64(999999)),
 						VariantKey: util.StringPtr("control"),
 					},
@@ -127,7 +127,7 @@ func TestValidatePutDistributions(t *testing.T) {
 			Body: &models.PutDistributionsRequest{
 				Distributions: []*models.Distribution{
 					{
-						Percent:    util.Int64Ptr(int64(100)),
+						Percent:    util.Float64Ptr(float64(100)),
 						VariantID:  util.Int64Ptr(int64(1)),
 						VariantKey: util.StringPtr("treatment"),
 					},
@@ -137,6 +137,39 @@ func TestValidatePutDistributions(t *testing.T) {
 		err := validatePutDistributions(param)
 		assert.NotZero(t, err)
 	})
+
+	t.Run("fractional percents depend on the flag's hashAlgorithm", func(t *testing.T) {
+		c.CreateVariant(variant.CreateVariantParams{
+			FlagID: int64(1),
+			Body: &models.CreateVariantRequest{
+				Key: util.StringPtr("treatment"),
+			},
+		})
+		param := distribution.PutDistributionsParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body: &models.PutDistributionsRequest{
+				Distributions: []*models.Distribution{
+					{
+						Percent:    util.Float64Ptr(99.99),
+						VariantID:  util.Int64Ptr(int64(1)),
+						VariantKey: util.StringPtr("control"),
+					},
+					{
+						Percent:    util.Float64Ptr(0.01),
+						VariantID:  util.Int64Ptr(int64(2)),
+						VariantKey: util.StringPtr("treatment"),
+					},
+				},
+			},
+		}
+		err := validatePutDistributions(param)
+		assert.NotZero(t, err)
+
+		db.Model(&entity.Flag{}).Where("id = ?", 1).Update("hash_algorithm", models.FlagHashAlgorithmMurmur3)
+		err = validatePutDistributions(param)
+		assert.Nil(t, err)
+	})
 }
 
 func TestValidateDeleteVariant(t *testing.T) {
@@ -160,7 +193,7 @@ func TestValidateDeleteVariant(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    util.StringPtr("segment1"),
-			RolloutPercent: util.Int64Ptr(int64(100)),
+			RolloutPercent: util.Float64Ptr(float64(100)),
 		},
 	})
 	c.CreateVariant(variant.CreateVariantParams{
@@ -181,12 +214,12 @@ func TestValidateDeleteVariant(t *testing.T) {
 		Body: &models.PutDistributionsRequest{
 			Distributions: []*models.Distribution{
 				{
-					Percent:    util.Int64Ptr(int64(100)),
+					Percent:    util.Float64Ptr(float64(100)),
 					VariantID:  util.Int64Ptr(int64(1)),
 					VariantKey: util.StringPtr("control"),
 				},
 				{
-					Percent:    util.Int64Ptr(int64(0)),
+					Percent:    util.Float64Ptr(float64(0)),
 					VariantID:  util.Int64Ptr(int64(2)),
 					VariantKey: util.StringPtr("treatment"),
 				},
@@ -194,6 +227,43 @@ func TestValidateDeleteVariant(t *testing.T) {
 		},
 	})
 
+	countDistributions := func(variantID uint) int64 {
+		var n int64
+		db.Model(&entity.Distribution{}).Where("variant_id = ?", variantID).Count(&n)
+		return n
+	}
+
+	t.Run("try to delete the default variant of the flag", func(t *testing.T) {
+		db.Model(&entity.Flag{}).Where("id = ?", 1).Update("default_variant_id", 2)
+		defer db.Model(&entity.Flag{}).Where("id = ?", 1).Update("default_variant_id", 0)
+
+		err := validateDeleteVariant(variant.DeleteVariantParams{FlagID: int64(1), VariantID: int64(2)})
+		assert.NotNil(t, err)
+		assert.Equal(t, 400, err.StatusCode)
+		assert.Equal(t, int64(1), countDistributions(2))
+	})
+
+	t.Run("try to delete the default variant of a segment", func(t *testing.T) {
+		db.Model(&entity.Segment{}).Where("id = ?", 1).Update("default_variant_id", 2)
+		defer db.Model(&entity.Segment{}).Where("id = ?", 1).Update("default_variant_id", 0)
+
+		err := validateDeleteVariant(variant.DeleteVariantParams{FlagID: int64(1), VariantID: int64(2)})
+		assert.NotNil(t, err)
+		assert.Equal(t, 400, err.StatusCode)
+		assert.Equal(t, int64(1), countDistributions(2))
+	})
+
+	t.Run("try to delete a variant used by an override", func(t *testing.T) {
+		o := &entity.VariantOverride{FlagID: 1, EntityID: "qa_user", VariantID: 2}
+		db.Create(o)
+		defer db.Unscoped().Delete(o)
+
+		err := validateDeleteVariant(variant.DeleteVariantParams{FlagID: int64(1), VariantID: int64(2)})
+		assert.NotNil(t, err)
+		assert.Equal(t, 400, err.StatusCode)
+		assert.Equal(t, int64(1), countDistributions(2))
+	})
+
 	t.Run("happy code path - try to delete a variant with 0 percent distribution", func(t *testing.T) {
 		param := variant.DeleteVariantParams{
 			FlagID:    int64(1),
@@ -234,7 +304,7 @@ func TestValidatePutVariantForDistributions(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    util.StringPtr("segment1"),
-			RolloutPercent: util.Int64Ptr(int64(100)),
+			RolloutPercent: util.Float64Ptr(float64(100)),
 		},
 	})
 	c.CreateVariant(variant.CreateVariantParams{
@@ -255,12 +325,12 @@ func TestValidatePutVariantForDistributions(t *testing.T) {
 		Body: &models.PutDistributionsRequest{
 			Distributions: []*models.Distribution{
 				{
-					Percent:    util.Int64Ptr(int64(100)),
+					Percent:    util.Float64Ptr(float64(100)),
 					VariantID:  util.Int64Ptr(int64(1)),
 					VariantKey: util.StringPtr("control"),
 				},
 				{
-					Percent:    util.Int64Ptr(int64(0)),
+					Percent:    util.Float64Ptr(float64(0)),
 					VariantID:  util.Int64Ptr(int64(2)),
 					VariantKey: util.StringPtr("treatment"),
 				},
@@ -294,3 +364,158 @@ func TestValidatePutVariantForDistributions(t *testing.T) {
 		db.Error = nil
 	})
 }
+
+func TestValidateSegmentPrerequisites(t *testing.T) {
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	for _, key := range []string{"flag_a", "flag_b"} {
+		c.CreateFlag(flag.CreateFlagParams{
+			Body: &models.CreateFlagRequest{
+				Description: util.StringPtr(key),
+				Key:         key,
+			},
+		})
+	}
+	c.CreateVariant(variant.CreateVariantParams{
+		FlagID: int64(1),
+		Body: &models.CreateVariantRequest{
+			Key: util.StringPtr("on"),
+		},
+	})
+	c.CreateVariant(variant.CreateVariantParams{
+		FlagID: int64(2),
+		Body: &models.CreateVariantRequest{
+			Key: util.StringPtr("on"),
+		},
+	})
+
+	t.Run("happy code path", func(t *testing.T) {
+		err := validateSegmentPrerequisites(int64(2), entity.Prerequisites{
+			{FlagKey: "flag_a", VariantKeys: []string{"on"}},
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("non-existing prerequisite flag", func(t *testing.T) {
+		err := validateSegmentPrerequisites(int64(2), entity.Prerequisites{
+			{FlagKey: "flag_c", VariantKeys: []string{"on"}},
+		})
+		assert.NotZero(t, err)
+	})
+
+	t.Run("non-existing prerequisite variant", func(t *testing.T) {
+		err := validateSegmentPrerequisites(int64(2), entity.Prerequisites{
+			{FlagKey: "flag_a", VariantKeys: []string{"off"}},
+		})
+		assert.NotZero(t, err)
+	})
+
+	t.Run("self reference", func(t *testing.T) {
+		err := validateSegmentPrerequisites(int64(1), entity.Prerequisites{
+			{FlagKey: "flag_a", VariantKeys: []string{"on"}},
+		})
+		assert.NotZero(t, err)
+	})
+
+	t.Run("cycle through another flag", func(t *testing.T) {
+		res := c.CreateSegment(segment.CreateSegmentParams{
+			FlagID: int64(2),
+			Body: &models.CreateSegmentRequest{
+				Description:    util.StringPtr("depends on flag_a"),
+				RolloutPercent: util.Float64Ptr(float64(100)),
+				Prerequisites: []*models.Prerequisite{
+					{FlagKey: util.StringPtr("flag_a"), VariantKeys: []string{"on"}},
+				},
+			},
+		})
+		assert.NotZero(t, res.(*segment.CreateSegmentOK).Payload.ID)
+
+		err := validateSegmentPrerequisites(int64(1), entity.Prerequisites{
+			{FlagKey: "flag_b", VariantKeys: []string{"on"}},
+		})
+		assert.NotZero(t, err)
+	})
+}
+
+func TestValidateFlagNotPrerequisite(t *testing.T) {
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	for _, key := range []string{"flag_a", "flag_b"} {
+		c.CreateFlag(flag.CreateFlagParams{
+			Body: &models.CreateFlagRequest{
+				Description: util.StringPtr(key),
+				Key:         key,
+			},
+		})
+	}
+	c.CreateVariant(variant.CreateVariantParams{
+		FlagID: int64(1),
+		Body: &models.CreateVariantRequest{
+			Key: util.StringPtr("on"),
+		},
+	})
+	res := c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(2),
+		Body: &models.CreateSegmentRequest{
+			Description:    util.StringPtr("depends on flag_a"),
+			RolloutPercent: util.Float64Ptr(float64(100)),
+			Prerequisites: []*models.Prerequisite{
+				{FlagKey: util.StringPtr("flag_a"), VariantKeys: []string{"on"}},
+			},
+		},
+	})
+	segmentID := res.(*segment.CreateSegmentOK).Payload.ID
+
+	t.Run("rename a prerequisite flag", func(t *testing.T) {
+		res := c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body:   &models.PutFlagRequest{Key: util.StringPtr("flag_a_renamed")},
+		})
+		assert.IsType(t, &flag.PutFlagDefault{}, res)
+		assert.Contains(t, *res.(*flag.PutFlagDefault).Payload.Message, "flag_b")
+
+		// the description can still be changed
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body:   &models.PutFlagRequest{Key: util.StringPtr("flag_a"), Description: util.StringPtr("flag a")},
+		})
+		assert.Equal(t, "flag a", *res.(*flag.PutFlagOK).Payload.Description)
+	})
+
+	t.Run("delete a prerequisite flag", func(t *testing.T) {
+		res := c.DeleteFlag(flag.DeleteFlagParams{FlagID: int64(1)})
+		assert.IsType(t, &flag.DeleteFlagDefault{}, res)
+		assert.Contains(t, *res.(*flag.DeleteFlagDefault).Payload.Message, "flag_b")
+		assert.NoError(t, db.First(&entity.Flag{}, 1).Error)
+	})
+
+	t.Run("rename and delete the flag requiring it", func(t *testing.T) {
+		res := c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(2),
+			Body:   &models.PutFlagRequest{Key: util.StringPtr("flag_b_renamed")},
+		})
+		assert.Equal(t, "flag_b_renamed", res.(*flag.PutFlagOK).Payload.Key)
+		c.DeleteSegment(segment.DeleteSegmentParams{FlagID: int64(2), SegmentID: segmentID})
+
+		res = c.DeleteFlag(flag.DeleteFlagParams{FlagID: int64(1)})
+		assert.IsType(t, &flag.DeleteFlagOK{}, res)
+	})
+}
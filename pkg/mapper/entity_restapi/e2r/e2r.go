@@ -20,6 +20,18 @@ func MapFlag(e *entity.Flag) (*models.Flag, error) {
 	r.CreatedBy = e.CreatedBy
 	r.DataRecordsEnabled = util.BoolPtr(e.DataRecordsEnabled)
 	r.EntityType = e.EntityType
+	r.BucketBy = e.BucketBy
+	r.HashAlgorithm = util.StringPtr(util.SafeStringWithDefault(e.HashAlgorithm, entity.DefaultHashAlgorithm))
+	r.DefaultVariantID = util.Int64Ptr(int64(e.DefaultVariantID))
+	r.ValueType = e.ValueType
+	if e.ValueSchema != "" {
+		if err := json.Unmarshal([]byte(e.ValueSchema), &r.ValueSchema); err != nil {
+			return nil, err
+		}
+	}
+	r.ClientVisible = e.ClientVisible
+	r.StickyAssignments = e.StickyAssignments
+	r.AssignmentSalt = e.AssignmentSalt
 	r.Description = util.StringPtr(e.Description)
 	r.Notes = e.Notes
 	r.Enabled = util.BoolPtr(e.Enabled)
@@ -83,9 +95,14 @@ func MapSegment(e *entity.Segment) *models.Segment {
 	r.ID = int64(e.ID)
 	r.Description = util.StringPtr(e.Description)
 	r.Rank = util.Int64Ptr(int64(e.Rank))
-	r.RolloutPercent = util.Int64Ptr(int64(e.RolloutPercent))
+	r.RolloutPercent = util.Float64Ptr(e.RolloutPercent)
+	r.BucketBy = e.BucketBy
 	r.Constraints = MapConstraints(e.Constraints)
 	r.Distributions = MapDistributions(e.Distributions)
+	r.Prerequisites = MapPrerequisites(e.Prerequisites)
+	r.AudienceIDs = MapAudienceIDs(e.Audiences)
+	r.ConstraintGroups = MapConstraintGroups(e.ConstraintGroups)
+	r.DefaultVariantID = util.Int64Ptr(int64(e.DefaultVariantID))
 	return r
 }
 
@@ -98,6 +115,73 @@ func MapSegments(e []entity.Segment) []*models.Segment {
 	return ret
 }
 
+// MapPrerequisite maps prerequisite
+func MapPrerequisite(e *entity.Prerequisite) *models.Prerequisite {
+	r := &models.Prerequisite{
+		FlagKey:     util.StringPtr(e.FlagKey),
+		VariantKeys: e.VariantKeys,
+	}
+	return r
+}
+
+// MapPrerequisites maps prerequisites
+func MapPrerequisites(e entity.Prerequisites) []*models.Prerequisite {
+	ret := make([]*models.Prerequisite, len(e))
+	for i, p := range e {
+		ret[i] = MapPrerequisite(&p)
+	}
+	return ret
+}
+
+// MapAudienceIDs maps the audiences referenced by a segment to their IDs
+func MapAudienceIDs(e []entity.Audience) []int64 {
+	ret := make([]int64, len(e))
+	for i, a := range e {
+		ret[i] = int64(a.ID)
+	}
+	return ret
+}
+
+// MapAudience maps audience
+func MapAudience(e *entity.Audience) *models.Audience {
+	r := &models.Audience{}
+	r.ID = int64(e.ID)
+	r.Key = util.StringPtr(e.Key)
+	r.Description = util.StringPtr(e.Description)
+	r.Constraints = MapConstraints(e.Constraints)
+	r.ConstraintGroups = MapConstraintGroups(e.ConstraintGroups)
+	return r
+}
+
+// MapAudiences maps audiences
+func MapAudiences(e []entity.Audience) []*models.Audience {
+	ret := make([]*models.Audience, len(e))
+	for i, a := range e {
+		ret[i] = MapAudience(&a)
+	}
+	return ret
+}
+
+// MapIDList maps ID list
+func MapIDList(e *entity.IDList) *models.IDList {
+	r := &models.IDList{}
+	r.ID = int64(e.ID)
+	r.Key = util.StringPtr(e.Key)
+	r.Description = util.StringPtr(e.Description)
+	r.EntryCount = e.EntryCount
+	r.UpdatedAt = strfmt.DateTime(e.UpdatedAt)
+	return r
+}
+
+// MapIDLists maps ID lists
+func MapIDLists(e []entity.IDList) []*models.IDList {
+	ret := make([]*models.IDList, len(e))
+	for i, l := range e {
+		ret[i] = MapIDList(&l)
+	}
+	return ret
+}
+
 // MapTagEntity maps tag entity
 func MapTag(e *entity.Tag) *models.Tag {
 	r := &models.Tag{}
@@ -134,11 +218,37 @@ func MapConstraints(e []entity.Constraint) []*models.Constraint {
 	return ret
 }
 
+// MapConstraintGroup maps constraint group
+func MapConstraintGroup(e *entity.ConstraintGroup) *models.ConstraintGroup {
+	r := &models.ConstraintGroup{}
+	r.Operator = util.StringPtr(e.Operator)
+	r.Negate = e.Negate
+	r.Constraints = make([]*models.CreateConstraintRequest, len(e.Constraints))
+	for i, c := range e.Constraints {
+		r.Constraints[i] = &models.CreateConstraintRequest{
+			Property: util.StringPtr(c.Property),
+			Operator: util.StringPtr(c.Operator),
+			Value:    util.StringPtr(c.Value),
+		}
+	}
+	r.Groups = MapConstraintGroups(e.Groups)
+	return r
+}
+
+// MapConstraintGroups maps constraint groups
+func MapConstraintGroups(e entity.ConstraintGroups) []*models.ConstraintGroup {
+	ret := make([]*models.ConstraintGroup, len(e))
+	for i, g := range e {
+		ret[i] = MapConstraintGroup(&g)
+	}
+	return ret
+}
+
 // MapDistribution maps to a distribution
 func MapDistribution(e *entity.Distribution) *models.Distribution {
 	r := &models.Distribution{
 		ID:         int64(e.ID),
-		Percent:    util.Int64Ptr(int64(e.Percent)),
+		Percent:    util.Float64Ptr(e.Percent),
 		VariantID:  util.Int64Ptr(int64(e.VariantID)),
 		VariantKey: util.StringPtr(e.VariantKey),
 	}
@@ -154,6 +264,96 @@ func MapDistributions(e []entity.Distribution) []*models.Distribution {
 	return ret
 }
 
+// MapScheduledChange maps scheduled change
+func MapScheduledChange(e *entity.ScheduledChange) *models.ScheduledChange {
+	executeAt := strfmt.DateTime(e.ExecuteAt)
+	r := &models.ScheduledChange{
+		ID:             int64(e.ID),
+		FlagID:         util.Int64Ptr(int64(e.FlagID)),
+		ExecuteAt:      &executeAt,
+		Action:         util.StringPtr(e.Action),
+		SegmentID:      int64(e.SegmentID),
+		RolloutPercent: e.RolloutPercent,
+		Distributions:  MapDistributions(e.Distributions.ToDistributions(e.SegmentID)),
+		Status:         util.StringPtr(e.Status),
+		CreatedBy:      e.CreatedBy,
+		Error:          e.Error,
+	}
+	if e.AppliedAt != nil {
+		appliedAt := strfmt.DateTime(*e.AppliedAt)
+		r.AppliedAt = &appliedAt
+	}
+	return r
+}
+
+// MapScheduledChanges maps scheduled changes
+func MapScheduledChanges(e []entity.ScheduledChange) []*models.ScheduledChange {
+	ret := make([]*models.ScheduledChange, len(e))
+	for i, c := range e {
+		ret[i] = MapScheduledChange(&c)
+	}
+	return ret
+}
+
+// MapVariantOverride maps variant override, the variantKey is the key of its variant
+func MapVariantOverride(e *entity.VariantOverride, variantKey string) *models.VariantOverride {
+	r := &models.VariantOverride{
+		ID:         int64(e.ID),
+		EntityID:   util.StringPtr(e.EntityID),
+		EntityType: e.EntityType,
+		VariantID:  util.Int64Ptr(int64(e.VariantID)),
+		VariantKey: variantKey,
+		CreatedBy:  e.CreatedBy,
+	}
+	if e.ExpiresAt != nil {
+		expiresAt := strfmt.DateTime(*e.ExpiresAt)
+		r.ExpiresAt = &expiresAt
+	}
+	return r
+}
+
+// MapVariantOverrides maps variant overrides of the flag with the variants
+func MapVariantOverrides(e []entity.VariantOverride, variants []entity.Variant) []*models.VariantOverride {
+	keys := make(map[uint]string, len(variants))
+	for _, v := range variants {
+		keys[v.ID] = v.Key
+	}
+	ret := make([]*models.VariantOverride, len(e))
+	for i, o := range e {
+		ret[i] = MapVariantOverride(&o, keys[o.VariantID])
+	}
+	return ret
+}
+
+// MapRolloutRamp maps rollout ramp
+func MapRolloutRamp(e *entity.RolloutRamp) *models.RolloutRamp {
+	r := &models.RolloutRamp{
+		ID:                  int64(e.ID),
+		FlagID:              util.Int64Ptr(int64(e.FlagID)),
+		SegmentID:           util.Int64Ptr(int64(e.SegmentID)),
+		Steps:               e.Steps,
+		StepIntervalSeconds: util.Int64Ptr(int64(e.StepInterval / time.Second)),
+		CurrentStep:         util.Int64Ptr(int64(e.CurrentStep)),
+		Status:              util.StringPtr(e.Status),
+		CreatedBy:           e.CreatedBy,
+		Error:               e.Error,
+	}
+	if e.Status == models.RolloutRampStatusACTIVE {
+		nextStepAt := strfmt.DateTime(e.NextStepAt)
+		r.NextStepAt = &nextStepAt
+	}
+	return r
+}
+
+// MapRolloutRamps maps rollout ramps
+func MapRolloutRamps(e []entity.RolloutRamp) []*models.RolloutRamp {
+	ret := make([]*models.RolloutRamp, len(e))
+	for i, r := range e {
+		ret[i] = MapRolloutRamp(&r)
+	}
+	return ret
+}
+
 // MapVariant maps variant
 func MapVariant(e *entity.Variant) *models.Variant {
 	r := &models.Variant{
@@ -1,6 +1,7 @@
 package r2e
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/davecgh/go-spew/spew"
@@ -24,11 +25,101 @@ func MapDistribution(r *models.Distribution, segmentID uint) entity.Distribution
 		SegmentID:  segmentID,
 		VariantID:  uint(*r.VariantID),
 		VariantKey: util.SafeString(r.VariantKey),
-		Percent:    uint(*r.Percent),
+		Percent:    *r.Percent,
 	}
 	return e
 }
 
+// MapScheduledDistributions maps the distributions of a scheduled change
+func MapScheduledDistributions(r []*models.Distribution) entity.ScheduledDistributions {
+	e := make(entity.ScheduledDistributions, len(r))
+	for i, d := range r {
+		e[i] = entity.ScheduledDistribution{
+			VariantID:  util.SafeUint(d.VariantID),
+			VariantKey: util.SafeString(d.VariantKey),
+			Percent:    *d.Percent,
+		}
+	}
+	return e
+}
+
+// MapRolloutRampSteps maps the rollout percents of the steps
+func MapRolloutRampSteps(r []*float64) entity.RolloutRampSteps {
+	e := make(entity.RolloutRampSteps, len(r))
+	for i, p := range r {
+		if p != nil {
+			e[i] = *p
+		}
+	}
+	return e
+}
+
+// MapPrerequisites maps prerequisites
+func MapPrerequisites(r []*models.Prerequisite) entity.Prerequisites {
+	e := make(entity.Prerequisites, len(r))
+	for i, p := range r {
+		e[i] = entity.Prerequisite{
+			FlagKey:     util.SafeString(p.FlagKey),
+			VariantKeys: p.VariantKeys,
+		}
+	}
+	return e
+}
+
+// MapConstraints maps the constraint requests, e.g. the constraints of an audience
+func MapConstraints(r []*models.CreateConstraintRequest) entity.ConstraintArray {
+	e := make(entity.ConstraintArray, len(r))
+	for i, c := range r {
+		e[i] = entity.Constraint{
+			Property: util.SafeString(c.Property),
+			Operator: util.SafeString(c.Operator),
+			Value:    util.SafeString(c.Value),
+		}
+	}
+	return e
+}
+
+// MapConstraintGroups maps constraint groups
+func MapConstraintGroups(r []*models.ConstraintGroup) entity.ConstraintGroups {
+	e := make(entity.ConstraintGroups, len(r))
+	for i, g := range r {
+		e[i] = entity.ConstraintGroup{
+			Operator: util.SafeString(g.Operator),
+			Negate:   g.Negate,
+			Groups:   MapConstraintGroups(g.Groups),
+		}
+		for _, c := range g.Constraints {
+			e[i].Constraints = append(e[i].Constraints, entity.GroupConstraint{
+				Property: util.SafeString(c.Property),
+				Operator: util.SafeString(c.Operator),
+				Value:    util.SafeString(c.Value),
+			})
+		}
+	}
+	return e
+}
+
+// MapAudienceIDs maps the audience IDs referenced by a segment
+func MapAudienceIDs(r []int64) []entity.Audience {
+	e := make([]entity.Audience, len(r))
+	for i, id := range r {
+		e[i].ID = uint(id)
+	}
+	return e
+}
+
+// MapValueSchema maps the JSON Schema of the flag value, an empty object clears it
+func MapValueSchema(r interface{}) (string, error) {
+	if m, ok := r.(map[string]interface{}); ok && len(m) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		return "", fmt.Errorf("invalid valueSchema. reason: %s", err)
+	}
+	return string(b), nil
+}
+
 // MapAttachment maps attachment
 func MapAttachment(a interface{}) (entity.Attachment, error) {
 	e := entity.Attachment{}
@@ -42,3 +133,80 @@ func MapAttachment(a interface{}) (entity.Attachment, error) {
 	}
 	return e, nil
 }
+
+// MapFlag maps a full flag definition validated by the swagger model, e.g. for the dry
+// run of the evaluation
+func MapFlag(r *models.Flag) (*entity.Flag, error) {
+	e := &entity.Flag{
+		Key:                r.Key,
+		Description:        util.SafeString(r.Description),
+		Enabled:            *r.Enabled,
+		DataRecordsEnabled: *r.DataRecordsEnabled,
+		EntityType:         r.EntityType,
+		BucketBy:           r.BucketBy,
+		HashAlgorithm:      util.SafeString(r.HashAlgorithm),
+		DefaultVariantID:   util.SafeUint(r.DefaultVariantID),
+		ValueType:          r.ValueType,
+		ClientVisible:      r.ClientVisible,
+		StickyAssignments:  r.StickyAssignments,
+		AssignmentSalt:     r.AssignmentSalt,
+		Notes:              r.Notes,
+	}
+	e.ID = uint(r.ID)
+	if r.ValueSchema != nil {
+		s, err := MapValueSchema(r.ValueSchema)
+		if err != nil {
+			return nil, err
+		}
+		e.ValueSchema = s
+	}
+	for _, t := range r.Tags {
+		tag := entity.Tag{Value: util.SafeString(t.Value)}
+		tag.ID = uint(t.ID)
+		e.Tags = append(e.Tags, tag)
+	}
+	for _, v := range r.Variants {
+		a, err := MapAttachment(v.Attachment)
+		if err != nil {
+			return nil, err
+		}
+		variant := entity.Variant{FlagID: e.ID, Key: util.SafeString(v.Key), Attachment: a}
+		variant.ID = uint(v.ID)
+		e.Variants = append(e.Variants, variant)
+	}
+	for _, s := range r.Segments {
+		e.Segments = append(e.Segments, MapSegment(s, e.ID))
+	}
+	return e, nil
+}
+
+// MapSegment maps a full segment definition of the flag
+func MapSegment(r *models.Segment, flagID uint) entity.Segment {
+	e := entity.Segment{
+		FlagID:           flagID,
+		Description:      util.SafeString(r.Description),
+		Rank:             util.SafeUint(r.Rank),
+		RolloutPercent:   *r.RolloutPercent,
+		BucketBy:         r.BucketBy,
+		Prerequisites:    MapPrerequisites(r.Prerequisites),
+		Audiences:        MapAudienceIDs(r.AudienceIDs),
+		ConstraintGroups: MapConstraintGroups(r.ConstraintGroups),
+		DefaultVariantID: util.SafeUint(r.DefaultVariantID),
+	}
+	e.ID = uint(r.ID)
+	for _, c := range r.Constraints {
+		constraint := entity.Constraint{
+			SegmentID: e.ID,
+			Property:  util.SafeString(c.Property),
+			Operator:  util.SafeString(c.Operator),
+			Value:     util.SafeString(c.Value),
+		}
+		constraint.ID = uint(c.ID)
+		e.Constraints = append(e.Constraints, constraint)
+	}
+	e.Distributions = MapDistributions(r.Distributions, e.ID)
+	for i, d := range r.Distributions {
+		e.Distributions[i].ID = uint(d.ID)
+	}
+	return e
+}
@@ -0,0 +1,1226 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v4.25.3
+// source: evaluation.proto
+
+package flagrpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TagsOperator determines how the flag tags are used to select the flags.
+type TagsOperator int32
+
+const (
+	// ANY selects the flags with any of the tags.
+	TagsOperator_ANY TagsOperator = 0
+	// ALL selects the flags with all the tags.
+	TagsOperator_ALL TagsOperator = 1
+)
+
+// Enum value maps for TagsOperator.
+var (
+	TagsOperator_name = map[int32]string{
+		0: "ANY",
+		1: "ALL",
+	}
+	TagsOperator_value = map[string]int32{
+		"ANY": 0,
+		"ALL": 1,
+	}
+)
+
+func (x TagsOperator) Enum() *TagsOperator {
+	p := new(TagsOperator)
+	*p = x
+	return p
+}
+
+func (x TagsOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagsOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_evaluation_proto_enumTypes[0].Descriptor()
+}
+
+func (TagsOperator) Type() protoreflect.EnumType {
+	return &file_evaluation_proto_enumTypes[0]
+}
+
+func (x TagsOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagsOperator.Descriptor instead.
+func (TagsOperator) EnumDescriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{0}
+}
+
+type EvalContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId      string           `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	EntityType    string           `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityContext *structpb.Struct `protobuf:"bytes,3,opt,name=entity_context,json=entityContext,proto3" json:"entity_context,omitempty"`
+	EnableDebug   bool             `protobuf:"varint,4,opt,name=enable_debug,json=enableDebug,proto3" json:"enable_debug,omitempty"`
+	// flag_id or flag_key selects the flag, flag_id wins if both are set. EvaluateAll selects
+	// the flags by the tags.
+	FlagId  int64  `protobuf:"varint,5,opt,name=flag_id,json=flagId,proto3" json:"flag_id,omitempty"`
+	FlagKey string `protobuf:"bytes,6,opt,name=flag_key,json=flagKey,proto3" json:"flag_key,omitempty"`
+}
+
+func (x *EvalContext) Reset() {
+	*x = EvalContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvalContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvalContext) ProtoMessage() {}
+
+func (x *EvalContext) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvalContext.ProtoReflect.Descriptor instead.
+func (*EvalContext) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EvalContext) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *EvalContext) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *EvalContext) GetEntityContext() *structpb.Struct {
+	if x != nil {
+		return x.EntityContext
+	}
+	return nil
+}
+
+func (x *EvalContext) GetEnableDebug() bool {
+	if x != nil {
+		return x.EnableDebug
+	}
+	return false
+}
+
+func (x *EvalContext) GetFlagId() int64 {
+	if x != nil {
+		return x.FlagId
+	}
+	return 0
+}
+
+func (x *EvalContext) GetFlagKey() string {
+	if x != nil {
+		return x.FlagKey
+	}
+	return ""
+}
+
+type EvalResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlagId            int64            `protobuf:"varint,1,opt,name=flag_id,json=flagId,proto3" json:"flag_id,omitempty"`
+	FlagKey           string           `protobuf:"bytes,2,opt,name=flag_key,json=flagKey,proto3" json:"flag_key,omitempty"`
+	FlagSnapshotId    int64            `protobuf:"varint,3,opt,name=flag_snapshot_id,json=flagSnapshotId,proto3" json:"flag_snapshot_id,omitempty"`
+	FlagTags          []string         `protobuf:"bytes,4,rep,name=flag_tags,json=flagTags,proto3" json:"flag_tags,omitempty"`
+	SegmentId         int64            `protobuf:"varint,5,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	VariantId         int64            `protobuf:"varint,6,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	VariantKey        string           `protobuf:"bytes,7,opt,name=variant_key,json=variantKey,proto3" json:"variant_key,omitempty"`
+	VariantAttachment *structpb.Struct `protobuf:"bytes,8,opt,name=variant_attachment,json=variantAttachment,proto3" json:"variant_attachment,omitempty"`
+	EvalContext       *EvalContext     `protobuf:"bytes,9,opt,name=eval_context,json=evalContext,proto3" json:"eval_context,omitempty"`
+	// timestamp is in RFC3339 format.
+	Timestamp    string        `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	EvalDebugLog *EvalDebugLog `protobuf:"bytes,11,opt,name=eval_debug_log,json=evalDebugLog,proto3" json:"eval_debug_log,omitempty"`
+	ValueType    string        `protobuf:"bytes,12,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+	// reason is the reason of the result, e.g. MATCHED, the same as the REST API.
+	Reason string `protobuf:"bytes,13,opt,name=reason,proto3" json:"reason,omitempty"`
+	// segment_rank is the rank of the matched segment, -1 if no segment is matched.
+	SegmentRank int64 `protobuf:"varint,14,opt,name=segment_rank,json=segmentRank,proto3" json:"segment_rank,omitempty"`
+}
+
+func (x *EvalResult) Reset() {
+	*x = EvalResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvalResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvalResult) ProtoMessage() {}
+
+func (x *EvalResult) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvalResult.ProtoReflect.Descriptor instead.
+func (*EvalResult) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EvalResult) GetFlagId() int64 {
+	if x != nil {
+		return x.FlagId
+	}
+	return 0
+}
+
+func (x *EvalResult) GetFlagKey() string {
+	if x != nil {
+		return x.FlagKey
+	}
+	return ""
+}
+
+func (x *EvalResult) GetFlagSnapshotId() int64 {
+	if x != nil {
+		return x.FlagSnapshotId
+	}
+	return 0
+}
+
+func (x *EvalResult) GetFlagTags() []string {
+	if x != nil {
+		return x.FlagTags
+	}
+	return nil
+}
+
+func (x *EvalResult) GetSegmentId() int64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *EvalResult) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *EvalResult) GetVariantKey() string {
+	if x != nil {
+		return x.VariantKey
+	}
+	return ""
+}
+
+func (x *EvalResult) GetVariantAttachment() *structpb.Struct {
+	if x != nil {
+		return x.VariantAttachment
+	}
+	return nil
+}
+
+func (x *EvalResult) GetEvalContext() *EvalContext {
+	if x != nil {
+		return x.EvalContext
+	}
+	return nil
+}
+
+func (x *EvalResult) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *EvalResult) GetEvalDebugLog() *EvalDebugLog {
+	if x != nil {
+		return x.EvalDebugLog
+	}
+	return nil
+}
+
+func (x *EvalResult) GetValueType() string {
+	if x != nil {
+		return x.ValueType
+	}
+	return ""
+}
+
+func (x *EvalResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *EvalResult) GetSegmentRank() int64 {
+	if x != nil {
+		return x.SegmentRank
+	}
+	return 0
+}
+
+type EvalDebugLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Msg              string             `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	SegmentDebugLogs []*SegmentDebugLog `protobuf:"bytes,2,rep,name=segment_debug_logs,json=segmentDebugLogs,proto3" json:"segment_debug_logs,omitempty"`
+}
+
+func (x *EvalDebugLog) Reset() {
+	*x = EvalDebugLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvalDebugLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvalDebugLog) ProtoMessage() {}
+
+func (x *EvalDebugLog) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvalDebugLog.ProtoReflect.Descriptor instead.
+func (*EvalDebugLog) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EvalDebugLog) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *EvalDebugLog) GetSegmentDebugLogs() []*SegmentDebugLog {
+	if x != nil {
+		return x.SegmentDebugLogs
+	}
+	return nil
+}
+
+type SegmentDebugLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SegmentId        int64              `protobuf:"varint,1,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	Msg              string             `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	ConstraintTraces []*ConstraintTrace `protobuf:"bytes,3,rep,name=constraint_traces,json=constraintTraces,proto3" json:"constraint_traces,omitempty"`
+}
+
+func (x *SegmentDebugLog) Reset() {
+	*x = SegmentDebugLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentDebugLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentDebugLog) ProtoMessage() {}
+
+func (x *SegmentDebugLog) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentDebugLog.ProtoReflect.Descriptor instead.
+func (*SegmentDebugLog) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SegmentDebugLog) GetSegmentId() int64 {
+	if x != nil {
+		return x.SegmentId
+	}
+	return 0
+}
+
+func (x *SegmentDebugLog) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SegmentDebugLog) GetConstraintTraces() []*ConstraintTrace {
+	if x != nil {
+		return x.ConstraintTraces
+	}
+	return nil
+}
+
+// ConstraintTrace is the result of a constraint of the segment, the same as the REST API.
+type ConstraintTrace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Property string `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	// value is the expected value of the constraint.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// actual is the value of the property in the entity context.
+	Actual *structpb.Value `protobuf:"bytes,4,opt,name=actual,proto3" json:"actual,omitempty"`
+	// result is one of MATCH, NOT_MATCH, MISSING_PROPERTY, WRONG_TYPE and ERROR.
+	Result string `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`
+	Msg    string `protobuf:"bytes,6,opt,name=msg,proto3" json:"msg,omitempty"`
+	// group is the path of the constraint group of the constraint, e.g. constraintGroups[0].groups[1].
+	Group string `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`
+	// audience_key is the key of the audience of the constraint.
+	AudienceKey string `protobuf:"bytes,8,opt,name=audience_key,json=audienceKey,proto3" json:"audience_key,omitempty"`
+}
+
+func (x *ConstraintTrace) Reset() {
+	*x = ConstraintTrace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConstraintTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConstraintTrace) ProtoMessage() {}
+
+func (x *ConstraintTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConstraintTrace.ProtoReflect.Descriptor instead.
+func (*ConstraintTrace) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConstraintTrace) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+func (x *ConstraintTrace) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ConstraintTrace) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ConstraintTrace) GetActual() *structpb.Value {
+	if x != nil {
+		return x.Actual
+	}
+	return nil
+}
+
+func (x *ConstraintTrace) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ConstraintTrace) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ConstraintTrace) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *ConstraintTrace) GetAudienceKey() string {
+	if x != nil {
+		return x.AudienceKey
+	}
+	return ""
+}
+
+type EvaluationEntity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId      string           `protobuf:"bytes,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	EntityType    string           `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityContext *structpb.Struct `protobuf:"bytes,3,opt,name=entity_context,json=entityContext,proto3" json:"entity_context,omitempty"`
+}
+
+func (x *EvaluationEntity) Reset() {
+	*x = EvaluationEntity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluationEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluationEntity) ProtoMessage() {}
+
+func (x *EvaluationEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluationEntity.ProtoReflect.Descriptor instead.
+func (*EvaluationEntity) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EvaluationEntity) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *EvaluationEntity) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *EvaluationEntity) GetEntityContext() *structpb.Struct {
+	if x != nil {
+		return x.EntityContext
+	}
+	return nil
+}
+
+type EvaluateBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entities    []*EvaluationEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	EnableDebug bool                `protobuf:"varint,2,opt,name=enable_debug,json=enableDebug,proto3" json:"enable_debug,omitempty"`
+	// the flags are selected by the tags, the IDs and the keys, a flag selected more
+	// than once is only evaluated once.
+	FlagIds          []int64      `protobuf:"varint,3,rep,packed,name=flag_ids,json=flagIds,proto3" json:"flag_ids,omitempty"`
+	FlagKeys         []string     `protobuf:"bytes,4,rep,name=flag_keys,json=flagKeys,proto3" json:"flag_keys,omitempty"`
+	FlagTags         []string     `protobuf:"bytes,5,rep,name=flag_tags,json=flagTags,proto3" json:"flag_tags,omitempty"`
+	FlagTagsOperator TagsOperator `protobuf:"varint,6,opt,name=flag_tags_operator,json=flagTagsOperator,proto3,enum=flagr.v1.TagsOperator" json:"flag_tags_operator,omitempty"`
+}
+
+func (x *EvaluateBatchRequest) Reset() {
+	*x = EvaluateBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateBatchRequest) ProtoMessage() {}
+
+func (x *EvaluateBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateBatchRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateBatchRequest) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EvaluateBatchRequest) GetEntities() []*EvaluationEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *EvaluateBatchRequest) GetEnableDebug() bool {
+	if x != nil {
+		return x.EnableDebug
+	}
+	return false
+}
+
+func (x *EvaluateBatchRequest) GetFlagIds() []int64 {
+	if x != nil {
+		return x.FlagIds
+	}
+	return nil
+}
+
+func (x *EvaluateBatchRequest) GetFlagKeys() []string {
+	if x != nil {
+		return x.FlagKeys
+	}
+	return nil
+}
+
+func (x *EvaluateBatchRequest) GetFlagTags() []string {
+	if x != nil {
+		return x.FlagTags
+	}
+	return nil
+}
+
+func (x *EvaluateBatchRequest) GetFlagTagsOperator() TagsOperator {
+	if x != nil {
+		return x.FlagTagsOperator
+	}
+	return TagsOperator_ANY
+}
+
+type EvaluateBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// evaluation_results are in the order of the entities.
+	EvaluationResults []*EvalResult `protobuf:"bytes,1,rep,name=evaluation_results,json=evaluationResults,proto3" json:"evaluation_results,omitempty"`
+}
+
+func (x *EvaluateBatchResponse) Reset() {
+	*x = EvaluateBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateBatchResponse) ProtoMessage() {}
+
+func (x *EvaluateBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateBatchResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateBatchResponse) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EvaluateBatchResponse) GetEvaluationResults() []*EvalResult {
+	if x != nil {
+		return x.EvaluationResults
+	}
+	return nil
+}
+
+type EvaluateAllRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entity      *EvaluationEntity `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	EnableDebug bool              `protobuf:"varint,2,opt,name=enable_debug,json=enableDebug,proto3" json:"enable_debug,omitempty"`
+	// flag_tags selects the flags by the tags, all the enabled flags are evaluated if it's empty.
+	FlagTags         []string     `protobuf:"bytes,3,rep,name=flag_tags,json=flagTags,proto3" json:"flag_tags,omitempty"`
+	FlagTagsOperator TagsOperator `protobuf:"varint,4,opt,name=flag_tags_operator,json=flagTagsOperator,proto3,enum=flagr.v1.TagsOperator" json:"flag_tags_operator,omitempty"`
+}
+
+func (x *EvaluateAllRequest) Reset() {
+	*x = EvaluateAllRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateAllRequest) ProtoMessage() {}
+
+func (x *EvaluateAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateAllRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateAllRequest) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EvaluateAllRequest) GetEntity() *EvaluationEntity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *EvaluateAllRequest) GetEnableDebug() bool {
+	if x != nil {
+		return x.EnableDebug
+	}
+	return false
+}
+
+func (x *EvaluateAllRequest) GetFlagTags() []string {
+	if x != nil {
+		return x.FlagTags
+	}
+	return nil
+}
+
+func (x *EvaluateAllRequest) GetFlagTagsOperator() TagsOperator {
+	if x != nil {
+		return x.FlagTagsOperator
+	}
+	return TagsOperator_ANY
+}
+
+type EvaluateAllResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// evaluation_results are in the order of the flag IDs.
+	EvaluationResults []*EvalResult `protobuf:"bytes,1,rep,name=evaluation_results,json=evaluationResults,proto3" json:"evaluation_results,omitempty"`
+}
+
+func (x *EvaluateAllResponse) Reset() {
+	*x = EvaluateAllResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_evaluation_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluateAllResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateAllResponse) ProtoMessage() {}
+
+func (x *EvaluateAllResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_evaluation_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateAllResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateAllResponse) Descriptor() ([]byte, []int) {
+	return file_evaluation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EvaluateAllResponse) GetEvaluationResults() []*EvalResult {
+	if x != nil {
+		return x.EvaluationResults
+	}
+	return nil
+}
+
+var File_evaluation_proto protoreflect.FileDescriptor
+
+var file_evaluation_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x08, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x01, 0x0a, 0x0b, 0x45,
+	0x76, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x0e, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0d, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x66,
+	0x6c, 0x61, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x6c,
+	0x61, 0x67, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x67, 0x4b, 0x65, 0x79, 0x4a,
+	0x04, 0x08, 0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x22, 0x9e, 0x04, 0x0a, 0x0a,
+	0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x6c,
+	0x61, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x6c, 0x61,
+	0x67, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x28,
+	0x0a, 0x10, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x66, 0x6c, 0x61, 0x67, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x61, 0x67,
+	0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6c, 0x61,
+	0x67, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x46, 0x0a, 0x12, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x11, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x0c,
+	0x65, 0x76, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76,
+	0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x0b, 0x65, 0x76, 0x61, 0x6c, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x3c, 0x0a, 0x0e, 0x65, 0x76, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x62,
+	0x75, 0x67, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66,
+	0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x4c, 0x6f, 0x67, 0x52, 0x0c, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c,
+	0x6f, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x22, 0x69, 0x0a, 0x0c,
+	0x45, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x6f, 0x67, 0x12, 0x10, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x47,
+	0x0a, 0x12, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f,
+	0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x61,
+	0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x4c, 0x6f, 0x67, 0x52, 0x10, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x44, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x6f, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x46, 0x0a, 0x11,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x54, 0x72,
+	0x61, 0x63, 0x65, 0x73, 0x22, 0xf2, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x73, 0x67,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x90, 0x01, 0x0a, 0x10, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x0e,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0d, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x8c, 0x02, 0x0a,
+	0x14, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x12, 0x19, 0x0a, 0x08, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x67, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66,
+	0x6c, 0x61, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x6c, 0x61, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x61, 0x67,
+	0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6c, 0x61,
+	0x67, 0x54, 0x61, 0x67, 0x73, 0x12, 0x44, 0x0a, 0x12, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x74, 0x61,
+	0x67, 0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x67,
+	0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x10, 0x66, 0x6c, 0x61, 0x67, 0x54,
+	0x61, 0x67, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x5c, 0x0a, 0x15, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x12, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x11, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x12, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x32, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x5f,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x6c, 0x61, 0x67,
+	0x54, 0x61, 0x67, 0x73, 0x12, 0x44, 0x0a, 0x12, 0x66, 0x6c, 0x61, 0x67, 0x5f, 0x74, 0x61, 0x67,
+	0x73, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x67, 0x73,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x10, 0x66, 0x6c, 0x61, 0x67, 0x54, 0x61,
+	0x67, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x13, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x12, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x11, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x2a, 0x20, 0x0a, 0x0c, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x32, 0xe3, 0x01, 0x0a, 0x0a, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x08, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x1a, 0x14, 0x2e, 0x66, 0x6c, 0x61,
+	0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x50, 0x0a, 0x0d, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x1e, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x41, 0x6c,
+	0x6c, 0x12, 0x1c, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2e,
+	0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65,
+	0x6e, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x5f, 0x67, 0x65, 0x6e, 0x2f, 0x66, 0x6c, 0x61, 0x67, 0x72, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_evaluation_proto_rawDescOnce sync.Once
+	file_evaluation_proto_rawDescData = file_evaluation_proto_rawDesc
+)
+
+func file_evaluation_proto_rawDescGZIP() []byte {
+	file_evaluation_proto_rawDescOnce.Do(func() {
+		file_evaluation_proto_rawDescData = protoimpl.X.CompressGZIP(file_evaluation_proto_rawDescData)
+	})
+	return file_evaluation_proto_rawDescData
+}
+
+var file_evaluation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_evaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_evaluation_proto_goTypes = []interface{}{
+	(TagsOperator)(0),             // 0: flagr.v1.TagsOperator
+	(*EvalContext)(nil),           // 1: flagr.v1.EvalContext
+	(*EvalResult)(nil),            // 2: flagr.v1.EvalResult
+	(*EvalDebugLog)(nil),          // 3: flagr.v1.EvalDebugLog
+	(*SegmentDebugLog)(nil),       // 4: flagr.v1.SegmentDebugLog
+	(*ConstraintTrace)(nil),       // 5: flagr.v1.ConstraintTrace
+	(*EvaluationEntity)(nil),      // 6: flagr.v1.EvaluationEntity
+	(*EvaluateBatchRequest)(nil),  // 7: flagr.v1.EvaluateBatchRequest
+	(*EvaluateBatchResponse)(nil), // 8: flagr.v1.EvaluateBatchResponse
+	(*EvaluateAllRequest)(nil),    // 9: flagr.v1.EvaluateAllRequest
+	(*EvaluateAllResponse)(nil),   // 10: flagr.v1.EvaluateAllResponse
+	(*structpb.Struct)(nil),       // 11: google.protobuf.Struct
+	(*structpb.Value)(nil),        // 12: google.protobuf.Value
+}
+var file_evaluation_proto_depIdxs = []int32{
+	11, // 0: flagr.v1.EvalContext.entity_context:type_name -> google.protobuf.Struct
+	11, // 1: flagr.v1.EvalResult.variant_attachment:type_name -> google.protobuf.Struct
+	1,  // 2: flagr.v1.EvalResult.eval_context:type_name -> flagr.v1.EvalContext
+	3,  // 3: flagr.v1.EvalResult.eval_debug_log:type_name -> flagr.v1.EvalDebugLog
+	4,  // 4: flagr.v1.EvalDebugLog.segment_debug_logs:type_name -> flagr.v1.SegmentDebugLog
+	5,  // 5: flagr.v1.SegmentDebugLog.constraint_traces:type_name -> flagr.v1.ConstraintTrace
+	12, // 6: flagr.v1.ConstraintTrace.actual:type_name -> google.protobuf.Value
+	11, // 7: flagr.v1.EvaluationEntity.entity_context:type_name -> google.protobuf.Struct
+	6,  // 8: flagr.v1.EvaluateBatchRequest.entities:type_name -> flagr.v1.EvaluationEntity
+	0,  // 9: flagr.v1.EvaluateBatchRequest.flag_tags_operator:type_name -> flagr.v1.TagsOperator
+	2,  // 10: flagr.v1.EvaluateBatchResponse.evaluation_results:type_name -> flagr.v1.EvalResult
+	6,  // 11: flagr.v1.EvaluateAllRequest.entity:type_name -> flagr.v1.EvaluationEntity
+	0,  // 12: flagr.v1.EvaluateAllRequest.flag_tags_operator:type_name -> flagr.v1.TagsOperator
+	2,  // 13: flagr.v1.EvaluateAllResponse.evaluation_results:type_name -> flagr.v1.EvalResult
+	1,  // 14: flagr.v1.Evaluation.Evaluate:input_type -> flagr.v1.EvalContext
+	7,  // 15: flagr.v1.Evaluation.EvaluateBatch:input_type -> flagr.v1.EvaluateBatchRequest
+	9,  // 16: flagr.v1.Evaluation.EvaluateAll:input_type -> flagr.v1.EvaluateAllRequest
+	2,  // 17: flagr.v1.Evaluation.Evaluate:output_type -> flagr.v1.EvalResult
+	8,  // 18: flagr.v1.Evaluation.EvaluateBatch:output_type -> flagr.v1.EvaluateBatchResponse
+	10, // 19: flagr.v1.Evaluation.EvaluateAll:output_type -> flagr.v1.EvaluateAllResponse
+	17, // [17:20] is the sub-list for method output_type
+	14, // [14:17] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_evaluation_proto_init() }
+func file_evaluation_proto_init() {
+	if File_evaluation_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_evaluation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvalContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvalResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvalDebugLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentDebugLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConstraintTrace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluationEntity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateAllRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_evaluation_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluateAllResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_evaluation_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_evaluation_proto_goTypes,
+		DependencyIndexes: file_evaluation_proto_depIdxs,
+		EnumInfos:         file_evaluation_proto_enumTypes,
+		MessageInfos:      file_evaluation_proto_msgTypes,
+	}.Build()
+	File_evaluation_proto = out.File
+	file_evaluation_proto_rawDesc = nil
+	file_evaluation_proto_goTypes = nil
+	file_evaluation_proto_depIdxs = nil
+}
@@ -0,0 +1,257 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Audience audience
+//
+// swagger:model audience
+type Audience struct {
+
+	// groups of constraints with AND/OR/NOT nesting, they are joined with AND together with the constraints
+	ConstraintGroups []*ConstraintGroup `json:"constraintGroups"`
+
+	// constraints
+	Constraints []*Constraint `json:"constraints"`
+
+	// description
+	// Required: true
+	Description *string `json:"description"`
+
+	// id
+	// Read Only: true
+	// Minimum: 1
+	ID int64 `json:"id,omitempty"`
+
+	// unique key representation of the audience
+	// Required: true
+	// Min Length: 1
+	Key *string `json:"key"`
+}
+
+// Validate validates this audience
+func (m *Audience) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateConstraintGroups(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateConstraints(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDescription(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Audience) validateConstraintGroups(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConstraintGroups) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ConstraintGroups); i++ {
+		if swag.IsZero(m.ConstraintGroups[i]) { // not required
+			continue
+		}
+
+		if m.ConstraintGroups[i] != nil {
+			if err := m.ConstraintGroups[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Audience) validateConstraints(formats strfmt.Registry) error {
+	if swag.IsZero(m.Constraints) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Constraints); i++ {
+		if swag.IsZero(m.Constraints[i]) { // not required
+			continue
+		}
+
+		if m.Constraints[i] != nil {
+			if err := m.Constraints[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraints" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraints" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Audience) validateDescription(formats strfmt.Registry) error {
+
+	if err := validate.Required("description", "body", m.Description); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Audience) validateID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("id", "body", m.ID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Audience) validateKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("key", "body", m.Key); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("key", "body", *m.Key, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this audience based on the context it is used
+func (m *Audience) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateConstraintGroups(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateConstraints(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Audience) contextValidateConstraintGroups(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ConstraintGroups); i++ {
+
+		if m.ConstraintGroups[i] != nil {
+
+			if swag.IsZero(m.ConstraintGroups[i]) { // not required
+				return nil
+			}
+
+			if err := m.ConstraintGroups[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Audience) contextValidateConstraints(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Constraints); i++ {
+
+		if m.Constraints[i] != nil {
+
+			if swag.IsZero(m.Constraints[i]) { // not required
+				return nil
+			}
+
+			if err := m.Constraints[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraints" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraints" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Audience) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "id", "body", int64(m.ID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Audience) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Audience) UnmarshalBinary(b []byte) error {
+	var res Audience
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -28,7 +28,7 @@ type Constraint struct {
 	// operator
 	// Required: true
 	// Min Length: 1
-	// Enum: ["EQ","NEQ","LT","LTE","GT","GTE","EREG","NEREG","IN","NOTIN","CONTAINS","NOTCONTAINS"]
+	// Enum: ["EQ","NEQ","LT","LTE","GT","GTE","EREG","NEREG","IN","NOTIN","CONTAINS","NOTCONTAINS","SEMVER_EQ","SEMVER_GT","SEMVER_GTE","SEMVER_LT","SEMVER_LTE","BEFORE","AFTER","BETWEEN","IN_LIST","NOT_IN_LIST"]
 	Operator *string `json:"operator"`
 
 	// property
@@ -84,7 +84,7 @@ var constraintTypeOperatorPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["EQ","NEQ","LT","LTE","GT","GTE","EREG","NEREG","IN","NOTIN","CONTAINS","NOTCONTAINS"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["EQ","NEQ","LT","LTE","GT","GTE","EREG","NEREG","IN","NOTIN","CONTAINS","NOTCONTAINS","SEMVER_EQ","SEMVER_GT","SEMVER_GTE","SEMVER_LT","SEMVER_LTE","BEFORE","AFTER","BETWEEN","IN_LIST","NOT_IN_LIST"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -129,6 +129,36 @@ const (
 
 	// ConstraintOperatorNOTCONTAINS captures enum value "NOTCONTAINS"
 	ConstraintOperatorNOTCONTAINS string = "NOTCONTAINS"
+
+	// ConstraintOperatorSEMVEREQ captures enum value "SEMVER_EQ"
+	ConstraintOperatorSEMVEREQ string = "SEMVER_EQ"
+
+	// ConstraintOperatorSEMVERGT captures enum value "SEMVER_GT"
+	ConstraintOperatorSEMVERGT string = "SEMVER_GT"
+
+	// ConstraintOperatorSEMVERGTE captures enum value "SEMVER_GTE"
+	ConstraintOperatorSEMVERGTE string = "SEMVER_GTE"
+
+	// ConstraintOperatorSEMVERLT captures enum value "SEMVER_LT"
+	ConstraintOperatorSEMVERLT string = "SEMVER_LT"
+
+	// ConstraintOperatorSEMVERLTE captures enum value "SEMVER_LTE"
+	ConstraintOperatorSEMVERLTE string = "SEMVER_LTE"
+
+	// ConstraintOperatorBEFORE captures enum value "BEFORE"
+	ConstraintOperatorBEFORE string = "BEFORE"
+
+	// ConstraintOperatorAFTER captures enum value "AFTER"
+	ConstraintOperatorAFTER string = "AFTER"
+
+	// ConstraintOperatorBETWEEN captures enum value "BETWEEN"
+	ConstraintOperatorBETWEEN string = "BETWEEN"
+
+	// ConstraintOperatorINLIST captures enum value "IN_LIST"
+	ConstraintOperatorINLIST string = "IN_LIST"
+
+	// ConstraintOperatorNOTINLIST captures enum value "NOT_IN_LIST"
+	ConstraintOperatorNOTINLIST string = "NOT_IN_LIST"
 )
 
 // prop value enum
@@ -0,0 +1,240 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ConstraintGroup a group of constraints and nested groups joined with the operator, the result is negated if negate is true
+//
+// swagger:model constraintGroup
+type ConstraintGroup struct {
+
+	// constraints
+	Constraints []*CreateConstraintRequest `json:"constraints"`
+
+	// groups
+	Groups []*ConstraintGroup `json:"groups"`
+
+	// negate
+	Negate bool `json:"negate,omitempty"`
+
+	// operator
+	// Required: true
+	// Enum: ["AND","OR"]
+	Operator *string `json:"operator"`
+}
+
+// Validate validates this constraint group
+func (m *ConstraintGroup) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateConstraints(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGroups(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOperator(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ConstraintGroup) validateConstraints(formats strfmt.Registry) error {
+	if swag.IsZero(m.Constraints) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Constraints); i++ {
+		if swag.IsZero(m.Constraints[i]) { // not required
+			continue
+		}
+
+		if m.Constraints[i] != nil {
+			if err := m.Constraints[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraints" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraints" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ConstraintGroup) validateGroups(formats strfmt.Registry) error {
+	if swag.IsZero(m.Groups) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Groups); i++ {
+		if swag.IsZero(m.Groups[i]) { // not required
+			continue
+		}
+
+		if m.Groups[i] != nil {
+			if err := m.Groups[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("groups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("groups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var constraintGroupTypeOperatorPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AND","OR"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		constraintGroupTypeOperatorPropEnum = append(constraintGroupTypeOperatorPropEnum, v)
+	}
+}
+
+const (
+
+	// ConstraintGroupOperatorAND captures enum value "AND"
+	ConstraintGroupOperatorAND string = "AND"
+
+	// ConstraintGroupOperatorOR captures enum value "OR"
+	ConstraintGroupOperatorOR string = "OR"
+)
+
+// prop value enum
+func (m *ConstraintGroup) validateOperatorEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, constraintGroupTypeOperatorPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ConstraintGroup) validateOperator(formats strfmt.Registry) error {
+
+	if err := validate.Required("operator", "body", m.Operator); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateOperatorEnum("operator", "body", *m.Operator); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this constraint group based on the context it is used
+func (m *ConstraintGroup) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateConstraints(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateGroups(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ConstraintGroup) contextValidateConstraints(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Constraints); i++ {
+
+		if m.Constraints[i] != nil {
+
+			if swag.IsZero(m.Constraints[i]) { // not required
+				return nil
+			}
+
+			if err := m.Constraints[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraints" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraints" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ConstraintGroup) contextValidateGroups(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Groups); i++ {
+
+		if m.Groups[i] != nil {
+
+			if swag.IsZero(m.Groups[i]) { // not required
+				return nil
+			}
+
+			if err := m.Groups[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("groups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("groups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ConstraintGroup) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ConstraintGroup) UnmarshalBinary(b []byte) error {
+	var res ConstraintGroup
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
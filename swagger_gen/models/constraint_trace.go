@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ConstraintTrace constraint trace
+//
+// swagger:model constraintTrace
+type ConstraintTrace struct {
+
+	// the value of the property in the entity context
+	Actual interface{} `json:"actual,omitempty"`
+
+	// the key of the audience of the constraint, empty for the constraints of the segment
+	AudienceKey string `json:"audienceKey,omitempty"`
+
+	// the path of the constraint group of the constraint, e.g. constraintGroups[0].groups[1], empty for the flat constraints
+	Group string `json:"group,omitempty"`
+
+	// msg
+	Msg string `json:"msg,omitempty"`
+
+	// operator
+	Operator string `json:"operator,omitempty"`
+
+	// property
+	Property string `json:"property,omitempty"`
+
+	// MISSING_PROPERTY if the property is missing in the entity context, and WRONG_TYPE if the property cannot be evaluated by the operator, e.g. a string compared with a number
+	// Enum: ["MATCH","NOT_MATCH","MISSING_PROPERTY","WRONG_TYPE","ERROR"]
+	Result string `json:"result,omitempty"`
+
+	// the expected value of the constraint
+	Value string `json:"value,omitempty"`
+}
+
+// Validate validates this constraint trace
+func (m *ConstraintTrace) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateResult(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var constraintTraceTypeResultPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["MATCH","NOT_MATCH","MISSING_PROPERTY","WRONG_TYPE","ERROR"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		constraintTraceTypeResultPropEnum = append(constraintTraceTypeResultPropEnum, v)
+	}
+}
+
+const (
+
+	// ConstraintTraceResultMATCH captures enum value "MATCH"
+	ConstraintTraceResultMATCH string = "MATCH"
+
+	// ConstraintTraceResultNOTMATCH captures enum value "NOT_MATCH"
+	ConstraintTraceResultNOTMATCH string = "NOT_MATCH"
+
+	// ConstraintTraceResultMISSINGPROPERTY captures enum value "MISSING_PROPERTY"
+	ConstraintTraceResultMISSINGPROPERTY string = "MISSING_PROPERTY"
+
+	// ConstraintTraceResultWRONGTYPE captures enum value "WRONG_TYPE"
+	ConstraintTraceResultWRONGTYPE string = "WRONG_TYPE"
+
+	// ConstraintTraceResultERROR captures enum value "ERROR"
+	ConstraintTraceResultERROR string = "ERROR"
+)
+
+// prop value enum
+func (m *ConstraintTrace) validateResultEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, constraintTraceTypeResultPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ConstraintTrace) validateResult(formats strfmt.Registry) error {
+	if swag.IsZero(m.Result) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateResultEnum("result", "body", m.Result); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this constraint trace based on context it is used
+func (m *ConstraintTrace) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ConstraintTrace) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ConstraintTrace) UnmarshalBinary(b []byte) error {
+	var res ConstraintTrace
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,209 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CreateAudienceRequest create audience request
+//
+// swagger:model createAudienceRequest
+type CreateAudienceRequest struct {
+
+	// groups of constraints with AND/OR/NOT nesting, they are joined with AND together with the constraints
+	ConstraintGroups []*ConstraintGroup `json:"constraintGroups"`
+
+	// constraints
+	Constraints []*CreateConstraintRequest `json:"constraints"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// unique key representation of the audience
+	// Required: true
+	// Min Length: 1
+	Key *string `json:"key"`
+}
+
+// Validate validates this create audience request
+func (m *CreateAudienceRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateConstraintGroups(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateConstraints(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateAudienceRequest) validateConstraintGroups(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConstraintGroups) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ConstraintGroups); i++ {
+		if swag.IsZero(m.ConstraintGroups[i]) { // not required
+			continue
+		}
+
+		if m.ConstraintGroups[i] != nil {
+			if err := m.ConstraintGroups[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CreateAudienceRequest) validateConstraints(formats strfmt.Registry) error {
+	if swag.IsZero(m.Constraints) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Constraints); i++ {
+		if swag.IsZero(m.Constraints[i]) { // not required
+			continue
+		}
+
+		if m.Constraints[i] != nil {
+			if err := m.Constraints[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraints" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraints" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CreateAudienceRequest) validateKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("key", "body", m.Key); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("key", "body", *m.Key, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this create audience request based on the context it is used
+func (m *CreateAudienceRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateConstraintGroups(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateConstraints(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateAudienceRequest) contextValidateConstraintGroups(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ConstraintGroups); i++ {
+
+		if m.ConstraintGroups[i] != nil {
+
+			if swag.IsZero(m.ConstraintGroups[i]) { // not required
+				return nil
+			}
+
+			if err := m.ConstraintGroups[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CreateAudienceRequest) contextValidateConstraints(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Constraints); i++ {
+
+		if m.Constraints[i] != nil {
+
+			if swag.IsZero(m.Constraints[i]) { // not required
+				return nil
+			}
+
+			if err := m.Constraints[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraints" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraints" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CreateAudienceRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CreateAudienceRequest) UnmarshalBinary(b []byte) error {
+	var res CreateAudienceRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
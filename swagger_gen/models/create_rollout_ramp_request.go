@@ -0,0 +1,136 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CreateRolloutRampRequest create rollout ramp request
+//
+// swagger:model createRolloutRampRequest
+type CreateRolloutRampRequest struct {
+
+	// when to apply the first step, it's applied right away if it's not set
+	// Format: date-time
+	StartAt strfmt.DateTime `json:"startAt,omitempty"`
+
+	// the time between two steps
+	// Required: true
+	// Minimum: 1
+	StepIntervalSeconds *int64 `json:"stepIntervalSeconds"`
+
+	// the increasing rollout percents of the steps, e.g. [1, 5, 25, 50, 100]
+	// Required: true
+	// Min Items: 1
+	Steps []*float64 `json:"steps"`
+}
+
+// Validate validates this create rollout ramp request
+func (m *CreateRolloutRampRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStartAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStepIntervalSeconds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSteps(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateRolloutRampRequest) validateStartAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("startAt", "body", "date-time", m.StartAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateRolloutRampRequest) validateStepIntervalSeconds(formats strfmt.Registry) error {
+
+	if err := validate.Required("stepIntervalSeconds", "body", m.StepIntervalSeconds); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("stepIntervalSeconds", "body", *m.StepIntervalSeconds, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateRolloutRampRequest) validateSteps(formats strfmt.Registry) error {
+
+	if err := validate.Required("steps", "body", m.Steps); err != nil {
+		return err
+	}
+
+	iStepsSize := int64(len(m.Steps))
+
+	if err := validate.MinItems("steps", "body", iStepsSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Steps); i++ {
+		if swag.IsZero(m.Steps[i]) { // not required
+			continue
+		}
+
+		if err := validate.Minimum("steps"+"."+strconv.Itoa(i), "body", *m.Steps[i], 0, false); err != nil {
+			return err
+		}
+
+		if err := validate.Maximum("steps"+"."+strconv.Itoa(i), "body", *m.Steps[i], 100, false); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validates this create rollout ramp request based on context it is used
+func (m *CreateRolloutRampRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CreateRolloutRampRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CreateRolloutRampRequest) UnmarshalBinary(b []byte) error {
+	var res CreateRolloutRampRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
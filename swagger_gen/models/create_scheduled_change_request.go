@@ -0,0 +1,248 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CreateScheduledChangeRequest create scheduled change request
+//
+// swagger:model createScheduledChangeRequest
+type CreateScheduledChangeRequest struct {
+
+	// action
+	// Required: true
+	// Enum: ["ENABLE_FLAG","DISABLE_FLAG","SET_ROLLOUT_PERCENT","SET_DISTRIBUTIONS"]
+	Action *string `json:"action"`
+
+	// the distributions of SET_DISTRIBUTIONS
+	Distributions []*Distribution `json:"distributions"`
+
+	// when to apply the change, it needs to be in the future
+	// Required: true
+	// Format: date-time
+	ExecuteAt *strfmt.DateTime `json:"executeAt"`
+
+	// the rollout percent of SET_ROLLOUT_PERCENT
+	// Maximum: 100
+	// Minimum: 0
+	RolloutPercent *float64 `json:"rolloutPercent,omitempty"`
+
+	// the segment of SET_ROLLOUT_PERCENT and SET_DISTRIBUTIONS
+	// Minimum: 1
+	SegmentID int64 `json:"segmentID,omitempty"`
+}
+
+// Validate validates this create scheduled change request
+func (m *CreateScheduledChangeRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDistributions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExecuteAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRolloutPercent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSegmentID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var createScheduledChangeRequestTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["ENABLE_FLAG","DISABLE_FLAG","SET_ROLLOUT_PERCENT","SET_DISTRIBUTIONS"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createScheduledChangeRequestTypeActionPropEnum = append(createScheduledChangeRequestTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// CreateScheduledChangeRequestActionENABLEFLAG captures enum value "ENABLE_FLAG"
+	CreateScheduledChangeRequestActionENABLEFLAG string = "ENABLE_FLAG"
+
+	// CreateScheduledChangeRequestActionDISABLEFLAG captures enum value "DISABLE_FLAG"
+	CreateScheduledChangeRequestActionDISABLEFLAG string = "DISABLE_FLAG"
+
+	// CreateScheduledChangeRequestActionSETROLLOUTPERCENT captures enum value "SET_ROLLOUT_PERCENT"
+	CreateScheduledChangeRequestActionSETROLLOUTPERCENT string = "SET_ROLLOUT_PERCENT"
+
+	// CreateScheduledChangeRequestActionSETDISTRIBUTIONS captures enum value "SET_DISTRIBUTIONS"
+	CreateScheduledChangeRequestActionSETDISTRIBUTIONS string = "SET_DISTRIBUTIONS"
+)
+
+// prop value enum
+func (m *CreateScheduledChangeRequest) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, createScheduledChangeRequestTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *CreateScheduledChangeRequest) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", *m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateScheduledChangeRequest) validateDistributions(formats strfmt.Registry) error {
+	if swag.IsZero(m.Distributions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Distributions); i++ {
+		if swag.IsZero(m.Distributions[i]) { // not required
+			continue
+		}
+
+		if m.Distributions[i] != nil {
+			if err := m.Distributions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("distributions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("distributions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CreateScheduledChangeRequest) validateExecuteAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("executeAt", "body", m.ExecuteAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("executeAt", "body", "date-time", m.ExecuteAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateScheduledChangeRequest) validateRolloutPercent(formats strfmt.Registry) error {
+	if swag.IsZero(m.RolloutPercent) { // not required
+		return nil
+	}
+
+	if err := validate.Minimum("rolloutPercent", "body", *m.RolloutPercent, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.Maximum("rolloutPercent", "body", *m.RolloutPercent, 100, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateScheduledChangeRequest) validateSegmentID(formats strfmt.Registry) error {
+	if swag.IsZero(m.SegmentID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("segmentID", "body", m.SegmentID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this create scheduled change request based on the context it is used
+func (m *CreateScheduledChangeRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDistributions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateScheduledChangeRequest) contextValidateDistributions(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Distributions); i++ {
+
+		if m.Distributions[i] != nil {
+
+			if swag.IsZero(m.Distributions[i]) { // not required
+				return nil
+			}
+
+			if err := m.Distributions[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("distributions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("distributions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CreateScheduledChangeRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CreateScheduledChangeRequest) UnmarshalBinary(b []byte) error {
+	var res CreateScheduledChangeRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
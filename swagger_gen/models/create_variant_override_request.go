@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CreateVariantOverrideRequest create variant override request
+//
+// swagger:model createVariantOverrideRequest
+type CreateVariantOverrideRequest struct {
+
+	// entity ID
+	// Required: true
+	// Max Length: 255
+	// Min Length: 1
+	EntityID *string `json:"entityID"`
+
+	// entity type
+	EntityType string `json:"entityType,omitempty"`
+
+	// expires at
+	// Format: date-time
+	ExpiresAt *strfmt.DateTime `json:"expiresAt,omitempty"`
+
+	// variant ID
+	// Required: true
+	// Minimum: 1
+	VariantID *int64 `json:"variantID"`
+}
+
+// Validate validates this create variant override request
+func (m *CreateVariantOverrideRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEntityID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVariantID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateVariantOverrideRequest) validateEntityID(formats strfmt.Registry) error {
+
+	if err := validate.Required("entityID", "body", m.EntityID); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("entityID", "body", *m.EntityID, 1); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("entityID", "body", *m.EntityID, 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateVariantOverrideRequest) validateExpiresAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expiresAt", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateVariantOverrideRequest) validateVariantID(formats strfmt.Registry) error {
+
+	if err := validate.Required("variantID", "body", m.VariantID); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("variantID", "body", *m.VariantID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this create variant override request based on context it is used
+func (m *CreateVariantOverrideRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CreateVariantOverrideRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CreateVariantOverrideRequest) UnmarshalBinary(b []byte) error {
+	var res CreateVariantOverrideRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -28,7 +28,7 @@ type Distribution struct {
 	// Required: true
 	// Maximum: 100
 	// Minimum: 0
-	Percent *int64 `json:"percent"`
+	Percent *float64 `json:"percent"`
 
 	// variant ID
 	// Required: true
@@ -85,11 +85,11 @@ func (m *Distribution) validatePercent(formats strfmt.Registry) error {
 		return err
 	}
 
-	if err := validate.MinimumInt("percent", "body", *m.Percent, 0, false); err != nil {
+	if err := validate.Minimum("percent", "body", *m.Percent, 0, false); err != nil {
 		return err
 	}
 
-	if err := validate.MaximumInt("percent", "body", *m.Percent, 100, false); err != nil {
+	if err := validate.Maximum("percent", "body", *m.Percent, 100, false); err != nil {
 		return err
 	}
 
@@ -7,10 +7,12 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // EvalResult eval result
@@ -36,12 +38,22 @@ type EvalResult struct {
 	// flagTags. flagTags looks up flags by tag. Either works.
 	FlagTags []string `json:"flagTags,omitempty"`
 
+	// why the entity got the result, it's always set regardless of enableDebug. FLAG_NOT_FOUND, FLAG_DISABLED and NO_SEGMENTS are about the flag. NO_MATCH means the entity matched no segment, and ERROR means no segment matched and the evaluation of a segment failed, e.g. an invalid entityContext or a missing audience. ROLLOUT_EXCLUDED means the entity matched a segment but is not in its rollout. MATCHED means the entity got the variant of the matched segment, and OVERRIDE means the variant is from a variant override. STICKY means the variant is the sticky assignment of the entity.
+	// Enum: ["FLAG_NOT_FOUND","FLAG_DISABLED","NO_SEGMENTS","NO_MATCH","ROLLOUT_EXCLUDED","MATCHED","OVERRIDE","STICKY","ERROR"]
+	Reason string `json:"reason,omitempty"`
+
 	// segment ID
 	SegmentID int64 `json:"segmentID,omitempty"`
 
+	// the rank of the matched segment, it's only set for MATCHED and ROLLOUT_EXCLUDED
+	SegmentRank *int64 `json:"segmentRank,omitempty"`
+
 	// timestamp
 	Timestamp string `json:"timestamp,omitempty"`
 
+	// the declared value type of the flag, it's empty if the flag is untyped
+	ValueType string `json:"valueType,omitempty"`
+
 	// variant attachment
 	VariantAttachment interface{} `json:"variantAttachment,omitempty"`
 
@@ -64,6 +76,10 @@ func (m *EvalResult) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateReason(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -108,6 +124,69 @@ func (m *EvalResult) validateEvalDebugLog(formats strfmt.Registry) error {
 	return nil
 }
 
+var evalResultTypeReasonPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["FLAG_NOT_FOUND","FLAG_DISABLED","NO_SEGMENTS","NO_MATCH","ROLLOUT_EXCLUDED","MATCHED","OVERRIDE","STICKY","ERROR"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		evalResultTypeReasonPropEnum = append(evalResultTypeReasonPropEnum, v)
+	}
+}
+
+const (
+
+	// EvalResultReasonFLAGNOTFOUND captures enum value "FLAG_NOT_FOUND"
+	EvalResultReasonFLAGNOTFOUND string = "FLAG_NOT_FOUND"
+
+	// EvalResultReasonFLAGDISABLED captures enum value "FLAG_DISABLED"
+	EvalResultReasonFLAGDISABLED string = "FLAG_DISABLED"
+
+	// EvalResultReasonNOSEGMENTS captures enum value "NO_SEGMENTS"
+	EvalResultReasonNOSEGMENTS string = "NO_SEGMENTS"
+
+	// EvalResultReasonNOMATCH captures enum value "NO_MATCH"
+	EvalResultReasonNOMATCH string = "NO_MATCH"
+
+	// EvalResultReasonROLLOUTEXCLUDED captures enum value "ROLLOUT_EXCLUDED"
+	EvalResultReasonROLLOUTEXCLUDED string = "ROLLOUT_EXCLUDED"
+
+	// EvalResultReasonMATCHED captures enum value "MATCHED"
+	EvalResultReasonMATCHED string = "MATCHED"
+
+	// EvalResultReasonOVERRIDE captures enum value "OVERRIDE"
+	EvalResultReasonOVERRIDE string = "OVERRIDE"
+
+	// EvalResultReasonSTICKY captures enum value "STICKY"
+	EvalResultReasonSTICKY string = "STICKY"
+
+	// EvalResultReasonERROR captures enum value "ERROR"
+	EvalResultReasonERROR string = "ERROR"
+)
+
+// prop value enum
+func (m *EvalResult) validateReasonEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, evalResultTypeReasonPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *EvalResult) validateReason(formats strfmt.Registry) error {
+	if swag.IsZero(m.Reason) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateReasonEnum("reason", "body", m.Reason); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this eval result based on the context it is used
 func (m *EvalResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -33,17 +33,21 @@ type EvaluationBatchRequest struct {
 	// Min Items: 1
 	FlagIDs []int64 `json:"flagIDs"`
 
-	// flagKeys. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.
+	// flagKeys. Either flagIDs, flagKeys or flagTags works. A flag selected multiple times is only evaluated once.
 	// Min Items: 1
 	FlagKeys []string `json:"flagKeys"`
 
-	// flagTags. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.
+	// flagTags. Either flagIDs, flagKeys or flagTags works. A flag selected multiple times is only evaluated once.
 	// Min Items: 1
 	FlagTags []string `json:"flagTags"`
 
 	// determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.
 	// Enum: ["ANY","ALL"]
 	FlagTagsOperator *string `json:"flagTagsOperator,omitempty"`
+
+	// LIST returns the flat list of evaluationResults. KEYED returns keyedEvaluationResults, the results keyed by entityID and then flagKey, which requires unique and non-empty entityIDs.
+	// Enum: ["LIST","KEYED"]
+	ResponseFormat *string `json:"responseFormat,omitempty"`
 }
 
 // Validate validates this evaluation batch request
@@ -70,6 +74,10 @@ func (m *EvaluationBatchRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateResponseFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -217,6 +225,48 @@ func (m *EvaluationBatchRequest) validateFlagTagsOperator(formats strfmt.Registr
 	return nil
 }
 
+var evaluationBatchRequestTypeResponseFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LIST","KEYED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		evaluationBatchRequestTypeResponseFormatPropEnum = append(evaluationBatchRequestTypeResponseFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// EvaluationBatchRequestResponseFormatLIST captures enum value "LIST"
+	EvaluationBatchRequestResponseFormatLIST string = "LIST"
+
+	// EvaluationBatchRequestResponseFormatKEYED captures enum value "KEYED"
+	EvaluationBatchRequestResponseFormatKEYED string = "KEYED"
+)
+
+// prop value enum
+func (m *EvaluationBatchRequest) validateResponseFormatEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, evaluationBatchRequestTypeResponseFormatPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *EvaluationBatchRequest) validateResponseFormat(formats strfmt.Registry) error {
+	if swag.IsZero(m.ResponseFormat) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateResponseFormatEnum("responseFormat", "body", *m.ResponseFormat); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this evaluation batch request based on the context it is used
 func (m *EvaluationBatchRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
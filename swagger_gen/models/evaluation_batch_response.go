@@ -23,6 +23,9 @@ type EvaluationBatchResponse struct {
 	// evaluation results
 	// Required: true
 	EvaluationResults []*EvalResult `json:"evaluationResults"`
+
+	// the results keyed by entityID and then flagKey if the responseFormat is KEYED
+	KeyedEvaluationResults map[string]map[string]EvalResult `json:"keyedEvaluationResults,omitempty"`
 }
 
 // Validate validates this evaluation batch response
@@ -33,6 +36,10 @@ func (m *EvaluationBatchResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateKeyedEvaluationResults(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -66,6 +73,36 @@ func (m *EvaluationBatchResponse) validateEvaluationResults(formats strfmt.Regis
 	return nil
 }
 
+func (m *EvaluationBatchResponse) validateKeyedEvaluationResults(formats strfmt.Registry) error {
+	if swag.IsZero(m.KeyedEvaluationResults) { // not required
+		return nil
+	}
+
+	for k := range m.KeyedEvaluationResults {
+
+		for kk := range m.KeyedEvaluationResults[k] {
+
+			if err := validate.Required("keyedEvaluationResults"+"."+k+"."+kk, "body", m.KeyedEvaluationResults[k][kk]); err != nil {
+				return err
+			}
+			if val, ok := m.KeyedEvaluationResults[k][kk]; ok {
+				if err := val.Validate(formats); err != nil {
+					if ve, ok := err.(*errors.Validation); ok {
+						return ve.ValidateName("keyedEvaluationResults" + "." + k + "." + kk)
+					} else if ce, ok := err.(*errors.CompositeError); ok {
+						return ce.ValidateName("keyedEvaluationResults" + "." + k + "." + kk)
+					}
+					return err
+				}
+			}
+
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this evaluation batch response based on the context it is used
 func (m *EvaluationBatchResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -74,6 +111,10 @@ func (m *EvaluationBatchResponse) ContextValidate(ctx context.Context, formats s
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateKeyedEvaluationResults(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -105,6 +146,25 @@ func (m *EvaluationBatchResponse) contextValidateEvaluationResults(ctx context.C
 	return nil
 }
 
+func (m *EvaluationBatchResponse) contextValidateKeyedEvaluationResults(ctx context.Context, formats strfmt.Registry) error {
+
+	for k := range m.KeyedEvaluationResults {
+
+		for kk := range m.KeyedEvaluationResults[k] {
+
+			if val, ok := m.KeyedEvaluationResults[k][kk]; ok {
+				if err := val.ContextValidate(ctx, formats); err != nil {
+					return err
+				}
+			}
+
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *EvaluationBatchResponse) MarshalBinary() ([]byte, error) {
 	if m == nil {
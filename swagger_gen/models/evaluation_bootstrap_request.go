@@ -0,0 +1,141 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EvaluationBootstrapRequest evaluation bootstrap request
+//
+// swagger:model evaluationBootstrapRequest
+type EvaluationBootstrapRequest struct {
+
+	// only evaluates the flags marked as clientVisible
+	ClientVisibleOnly bool `json:"clientVisibleOnly,omitempty"`
+
+	// entity context
+	EntityContext interface{} `json:"entityContext,omitempty"`
+
+	// entity ID
+	EntityID string `json:"entityID,omitempty"`
+
+	// entity type
+	EntityType string `json:"entityType,omitempty"`
+
+	// only evaluates the flags with the flagTags if it's not empty
+	FlagTags []string `json:"flagTags"`
+
+	// ANY evaluates the flags with any of the flagTags, and ALL evaluates the flags with all of them
+	// Enum: ["ANY","ALL"]
+	FlagTagsOperator *string `json:"flagTagsOperator,omitempty"`
+}
+
+// Validate validates this evaluation bootstrap request
+func (m *EvaluationBootstrapRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFlagTags(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFlagTagsOperator(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EvaluationBootstrapRequest) validateFlagTags(formats strfmt.Registry) error {
+	if swag.IsZero(m.FlagTags) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.FlagTags); i++ {
+
+		if err := validate.MinLength("flagTags"+"."+strconv.Itoa(i), "body", m.FlagTags[i], 1); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+var evaluationBootstrapRequestTypeFlagTagsOperatorPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["ANY","ALL"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		evaluationBootstrapRequestTypeFlagTagsOperatorPropEnum = append(evaluationBootstrapRequestTypeFlagTagsOperatorPropEnum, v)
+	}
+}
+
+const (
+
+	// EvaluationBootstrapRequestFlagTagsOperatorANY captures enum value "ANY"
+	EvaluationBootstrapRequestFlagTagsOperatorANY string = "ANY"
+
+	// EvaluationBootstrapRequestFlagTagsOperatorALL captures enum value "ALL"
+	EvaluationBootstrapRequestFlagTagsOperatorALL string = "ALL"
+)
+
+// prop value enum
+func (m *EvaluationBootstrapRequest) validateFlagTagsOperatorEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, evaluationBootstrapRequestTypeFlagTagsOperatorPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *EvaluationBootstrapRequest) validateFlagTagsOperator(formats strfmt.Registry) error {
+	if swag.IsZero(m.FlagTagsOperator) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateFlagTagsOperatorEnum("flagTagsOperator", "body", *m.FlagTagsOperator); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this evaluation bootstrap request based on context it is used
+func (m *EvaluationBootstrapRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EvaluationBootstrapRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EvaluationBootstrapRequest) UnmarshalBinary(b []byte) error {
+	var res EvaluationBootstrapRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EvaluationDryRunRequest evaluation dry run request
+//
+// swagger:model evaluationDryRunRequest
+type EvaluationDryRunRequest struct {
+
+	// entities
+	// Required: true
+	// Min Items: 1
+	Entities []*EvaluationEntity `json:"entities"`
+
+	// the flag definition in the same format as the flag returned by GET /flags/{flagID}, including the IDs of the segments and the variants referenced by the distributions. The flag id is the salt of the rollouts, so it has to be set to get the same results as a saved flag. Audiences, ID lists and prerequisite flags are looked up from the saved ones.
+	// Required: true
+	Flag interface{} `json:"flag"`
+}
+
+// Validate validates this evaluation dry run request
+func (m *EvaluationDryRunRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEntities(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFlag(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EvaluationDryRunRequest) validateEntities(formats strfmt.Registry) error {
+
+	if err := validate.Required("entities", "body", m.Entities); err != nil {
+		return err
+	}
+
+	iEntitiesSize := int64(len(m.Entities))
+
+	if err := validate.MinItems("entities", "body", iEntitiesSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Entities); i++ {
+		if swag.IsZero(m.Entities[i]) { // not required
+			continue
+		}
+
+		if m.Entities[i] != nil {
+			if err := m.Entities[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("entities" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("entities" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *EvaluationDryRunRequest) validateFlag(formats strfmt.Registry) error {
+
+	if m.Flag == nil {
+		return errors.Required("flag", "body", nil)
+	}
+
+	return nil
+}
+
+// ContextValidate validate this evaluation dry run request based on the context it is used
+func (m *EvaluationDryRunRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateEntities(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EvaluationDryRunRequest) contextValidateEntities(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Entities); i++ {
+
+		if m.Entities[i] != nil {
+
+			if swag.IsZero(m.Entities[i]) { // not required
+				return nil
+			}
+
+			if err := m.Entities[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("entities" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("entities" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EvaluationDryRunRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EvaluationDryRunRequest) UnmarshalBinary(b []byte) error {
+	var res EvaluationDryRunRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,124 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EvaluationDryRunResponse evaluation dry run response
+//
+// swagger:model evaluationDryRunResponse
+type EvaluationDryRunResponse struct {
+
+	// evaluation results
+	// Required: true
+	EvaluationResults []*EvalResult `json:"evaluationResults"`
+}
+
+// Validate validates this evaluation dry run response
+func (m *EvaluationDryRunResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEvaluationResults(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EvaluationDryRunResponse) validateEvaluationResults(formats strfmt.Registry) error {
+
+	if err := validate.Required("evaluationResults", "body", m.EvaluationResults); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.EvaluationResults); i++ {
+		if swag.IsZero(m.EvaluationResults[i]) { // not required
+			continue
+		}
+
+		if m.EvaluationResults[i] != nil {
+			if err := m.EvaluationResults[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("evaluationResults" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("evaluationResults" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this evaluation dry run response based on the context it is used
+func (m *EvaluationDryRunResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateEvaluationResults(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EvaluationDryRunResponse) contextValidateEvaluationResults(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.EvaluationResults); i++ {
+
+		if m.EvaluationResults[i] != nil {
+
+			if swag.IsZero(m.EvaluationResults[i]) { // not required
+				return nil
+			}
+
+			if err := m.EvaluationResults[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("evaluationResults" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("evaluationResults" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EvaluationDryRunResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EvaluationDryRunResponse) UnmarshalBinary(b []byte) error {
+	var res EvaluationDryRunResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
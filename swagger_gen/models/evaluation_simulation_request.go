@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EvaluationSimulationRequest evaluation simulation request
+//
+// swagger:model evaluationSimulationRequest
+type EvaluationSimulationRequest struct {
+
+	// the current or proposed flag definition to simulate, in the same format as the flag of the evaluation dry run
+	Flag interface{} `json:"flag,omitempty"`
+
+	// the ID of the live flag to compare with. It's the id of the flag definition if it's not set, and the live flag itself is simulated if there's no flag definition.
+	// Minimum: 1
+	FlagID int64 `json:"flagID,omitempty"`
+
+	// the sample of entities. Each line of JSONL is an evaluationEntity, e.g. {"entityID": "1", "entityContext": {"state": "CA"}}. The header of CSV has the entityID column, the optional entityType column, and the properties of the entityContext, whose numbers and booleans are parsed as JSON. Every entity needs the entityID, so that it's bucketed the same way as in production.
+	// Required: true
+	// Min Length: 1
+	Sample *string `json:"sample"`
+
+	// sample format
+	// Enum: ["JSONL","CSV"]
+	SampleFormat *string `json:"sampleFormat,omitempty"`
+}
+
+// Validate validates this evaluation simulation request
+func (m *EvaluationSimulationRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFlagID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSample(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSampleFormat(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EvaluationSimulationRequest) validateFlagID(formats strfmt.Registry) error {
+	if swag.IsZero(m.FlagID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("flagID", "body", m.FlagID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EvaluationSimulationRequest) validateSample(formats strfmt.Registry) error {
+
+	if err := validate.Required("sample", "body", m.Sample); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("sample", "body", *m.Sample, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var evaluationSimulationRequestTypeSampleFormatPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["JSONL","CSV"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		evaluationSimulationRequestTypeSampleFormatPropEnum = append(evaluationSimulationRequestTypeSampleFormatPropEnum, v)
+	}
+}
+
+const (
+
+	// EvaluationSimulationRequestSampleFormatJSONL captures enum value "JSONL"
+	EvaluationSimulationRequestSampleFormatJSONL string = "JSONL"
+
+	// EvaluationSimulationRequestSampleFormatCSV captures enum value "CSV"
+	EvaluationSimulationRequestSampleFormatCSV string = "CSV"
+)
+
+// prop value enum
+func (m *EvaluationSimulationRequest) validateSampleFormatEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, evaluationSimulationRequestTypeSampleFormatPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *EvaluationSimulationRequest) validateSampleFormat(formats strfmt.Registry) error {
+	if swag.IsZero(m.SampleFormat) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSampleFormatEnum("sampleFormat", "body", *m.SampleFormat); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this evaluation simulation request based on context it is used
+func (m *EvaluationSimulationRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EvaluationSimulationRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EvaluationSimulationRequest) UnmarshalBinary(b []byte) error {
+	var res EvaluationSimulationRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
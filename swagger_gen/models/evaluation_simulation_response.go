@@ -0,0 +1,337 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EvaluationSimulationResponse evaluation simulation response
+//
+// swagger:model evaluationSimulationResponse
+type EvaluationSimulationResponse struct {
+
+	// the number of the entities getting a different variant key than from the live flag
+	// Required: true
+	Changed *int64 `json:"changed"`
+
+	// the live flag is found in the evaluation cache, otherwise changed and transitions are empty
+	// Required: true
+	LiveFlagFound *bool `json:"liveFlagFound"`
+
+	// the number of the entities without a variant
+	// Required: true
+	NoVariant *int64 `json:"noVariant"`
+
+	// the number of the entities keyed by the reason of their results
+	// Required: true
+	Reasons map[string]int64 `json:"reasons"`
+
+	// the segments of the flag in the order of their ranks
+	// Required: true
+	Segments []*SimulationSegment `json:"segments"`
+
+	// the number of the entities in the sample
+	// Required: true
+	Total *int64 `json:"total"`
+
+	// the changes of the variant keys from the live flag, the most frequent first
+	// Required: true
+	Transitions []*SimulationTransition `json:"transitions"`
+
+	// the variants of the flag
+	// Required: true
+	Variants []*SimulationVariant `json:"variants"`
+}
+
+// Validate validates this evaluation simulation response
+func (m *EvaluationSimulationResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateChanged(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLiveFlagFound(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNoVariant(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReasons(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSegments(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTransitions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVariants(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EvaluationSimulationResponse) validateChanged(formats strfmt.Registry) error {
+
+	if err := validate.Required("changed", "body", m.Changed); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EvaluationSimulationResponse) validateLiveFlagFound(formats strfmt.Registry) error {
+
+	if err := validate.Required("liveFlagFound", "body", m.LiveFlagFound); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EvaluationSimulationResponse) validateNoVariant(formats strfmt.Registry) error {
+
+	if err := validate.Required("noVariant", "body", m.NoVariant); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EvaluationSimulationResponse) validateReasons(formats strfmt.Registry) error {
+
+	if err := validate.Required("reasons", "body", m.Reasons); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EvaluationSimulationResponse) validateSegments(formats strfmt.Registry) error {
+
+	if err := validate.Required("segments", "body", m.Segments); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Segments); i++ {
+		if swag.IsZero(m.Segments[i]) { // not required
+			continue
+		}
+
+		if m.Segments[i] != nil {
+			if err := m.Segments[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("segments" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("segments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *EvaluationSimulationResponse) validateTotal(formats strfmt.Registry) error {
+
+	if err := validate.Required("total", "body", m.Total); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EvaluationSimulationResponse) validateTransitions(formats strfmt.Registry) error {
+
+	if err := validate.Required("transitions", "body", m.Transitions); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Transitions); i++ {
+		if swag.IsZero(m.Transitions[i]) { // not required
+			continue
+		}
+
+		if m.Transitions[i] != nil {
+			if err := m.Transitions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("transitions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("transitions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *EvaluationSimulationResponse) validateVariants(formats strfmt.Registry) error {
+
+	if err := validate.Required("variants", "body", m.Variants); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Variants); i++ {
+		if swag.IsZero(m.Variants[i]) { // not required
+			continue
+		}
+
+		if m.Variants[i] != nil {
+			if err := m.Variants[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("variants" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("variants" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this evaluation simulation response based on the context it is used
+func (m *EvaluationSimulationResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSegments(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTransitions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVariants(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EvaluationSimulationResponse) contextValidateSegments(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Segments); i++ {
+
+		if m.Segments[i] != nil {
+
+			if swag.IsZero(m.Segments[i]) { // not required
+				return nil
+			}
+
+			if err := m.Segments[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("segments" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("segments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *EvaluationSimulationResponse) contextValidateTransitions(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Transitions); i++ {
+
+		if m.Transitions[i] != nil {
+
+			if swag.IsZero(m.Transitions[i]) { // not required
+				return nil
+			}
+
+			if err := m.Transitions[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("transitions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("transitions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *EvaluationSimulationResponse) contextValidateVariants(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Variants); i++ {
+
+		if m.Variants[i] != nil {
+
+			if swag.IsZero(m.Variants[i]) { // not required
+				return nil
+			}
+
+			if err := m.Variants[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("variants" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("variants" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EvaluationSimulationResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EvaluationSimulationResponse) UnmarshalBinary(b []byte) error {
+	var res EvaluationSimulationResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
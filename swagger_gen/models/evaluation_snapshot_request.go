@@ -0,0 +1,196 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EvaluationSnapshotRequest evaluation snapshot request
+//
+// swagger:model evaluationSnapshotRequest
+type EvaluationSnapshotRequest struct {
+
+	// enable debug
+	EnableDebug bool `json:"enableDebug,omitempty"`
+
+	// entities
+	// Required: true
+	// Min Items: 1
+	Entities []*EvaluationEntity `json:"entities"`
+
+	// flag ID
+	// Required: true
+	// Minimum: 1
+	FlagID *int64 `json:"flagID"`
+
+	// the ID of the snapshot of the flag to evaluate. Either flagSnapshotID or timestamp works.
+	// Minimum: 1
+	FlagSnapshotID int64 `json:"flagSnapshotID,omitempty"`
+
+	// evaluates the latest snapshot of the flag saved at or before the timestamp, at the time of the timestamp
+	// Format: date-time
+	Timestamp *strfmt.DateTime `json:"timestamp,omitempty"`
+}
+
+// Validate validates this evaluation snapshot request
+func (m *EvaluationSnapshotRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEntities(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFlagID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFlagSnapshotID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimestamp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EvaluationSnapshotRequest) validateEntities(formats strfmt.Registry) error {
+
+	if err := validate.Required("entities", "body", m.Entities); err != nil {
+		return err
+	}
+
+	iEntitiesSize := int64(len(m.Entities))
+
+	if err := validate.MinItems("entities", "body", iEntitiesSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Entities); i++ {
+		if swag.IsZero(m.Entities[i]) { // not required
+			continue
+		}
+
+		if m.Entities[i] != nil {
+			if err := m.Entities[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("entities" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("entities" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *EvaluationSnapshotRequest) validateFlagID(formats strfmt.Registry) error {
+
+	if err := validate.Required("flagID", "body", m.FlagID); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("flagID", "body", *m.FlagID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EvaluationSnapshotRequest) validateFlagSnapshotID(formats strfmt.Registry) error {
+	if swag.IsZero(m.FlagSnapshotID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("flagSnapshotID", "body", m.FlagSnapshotID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EvaluationSnapshotRequest) validateTimestamp(formats strfmt.Registry) error {
+	if swag.IsZero(m.Timestamp) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("timestamp", "body", "date-time", m.Timestamp.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this evaluation snapshot request based on the context it is used
+func (m *EvaluationSnapshotRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateEntities(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EvaluationSnapshotRequest) contextValidateEntities(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Entities); i++ {
+
+		if m.Entities[i] != nil {
+
+			if swag.IsZero(m.Entities[i]) { // not required
+				return nil
+			}
+
+			if err := m.Entities[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("entities" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("entities" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EvaluationSnapshotRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EvaluationSnapshotRequest) UnmarshalBinary(b []byte) error {
+	var res EvaluationSnapshotRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
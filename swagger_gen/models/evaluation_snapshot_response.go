@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// EvaluationSnapshotResponse evaluation snapshot response
+//
+// swagger:model evaluationSnapshotResponse
+type EvaluationSnapshotResponse struct {
+
+	// the time the entities are evaluated at, i.e. the timestamp of the request, or the time of the snapshot found by flagSnapshotID. The built-in now property of the constraints and the expiry of the overrides are evaluated at this time.
+	// Required: true
+	// Format: date-time
+	EvaluatedAt *strfmt.DateTime `json:"evaluatedAt"`
+
+	// evaluation results
+	// Required: true
+	EvaluationResults []*EvalResult `json:"evaluationResults"`
+
+	// the ID of the evaluated snapshot
+	// Required: true
+	// Minimum: 1
+	FlagSnapshotID *int64 `json:"flagSnapshotID"`
+
+	// the parts of the evaluation that are not historical, i.e. the audiences, the ID lists and the prerequisite flags referenced by the snapshot are the current ones
+	Warnings []string `json:"warnings"`
+}
+
+// Validate validates this evaluation snapshot response
+func (m *EvaluationSnapshotResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEvaluatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEvaluationResults(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFlagSnapshotID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EvaluationSnapshotResponse) validateEvaluatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("evaluatedAt", "body", m.EvaluatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("evaluatedAt", "body", "date-time", m.EvaluatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *EvaluationSnapshotResponse) validateEvaluationResults(formats strfmt.Registry) error {
+
+	if err := validate.Required("evaluationResults", "body", m.EvaluationResults); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.EvaluationResults); i++ {
+		if swag.IsZero(m.EvaluationResults[i]) { // not required
+			continue
+		}
+
+		if m.EvaluationResults[i] != nil {
+			if err := m.EvaluationResults[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("evaluationResults" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("evaluationResults" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *EvaluationSnapshotResponse) validateFlagSnapshotID(formats strfmt.Registry) error {
+
+	if err := validate.Required("flagSnapshotID", "body", m.FlagSnapshotID); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("flagSnapshotID", "body", *m.FlagSnapshotID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this evaluation snapshot response based on the context it is used
+func (m *EvaluationSnapshotResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateEvaluationResults(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *EvaluationSnapshotResponse) contextValidateEvaluationResults(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.EvaluationResults); i++ {
+
+		if m.EvaluationResults[i] != nil {
+
+			if swag.IsZero(m.EvaluationResults[i]) { // not required
+				return nil
+			}
+
+			if err := m.EvaluationResults[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("evaluationResults" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("evaluationResults" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *EvaluationSnapshotResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *EvaluationSnapshotResponse) UnmarshalBinary(b []byte) error {
+	var res EvaluationSnapshotResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
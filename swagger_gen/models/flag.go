@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 
 	"github.com/go-openapi/errors"
@@ -20,6 +21,16 @@ import (
 // swagger:model flag
 type Flag struct {
 
+	// the salt of the sticky assignments, resetting it drops all the sticky assignments of the flag
+	// Read Only: true
+	AssignmentSalt string `json:"assignmentSalt,omitempty"`
+
+	// comma separated entityContext properties used as the bucketing key for rollouts, e.g. "org_id". If it's empty, entityID is used.
+	BucketBy string `json:"bucketBy,omitempty"`
+
+	// client visible flags are evaluated by the bootstrap evaluation with clientVisibleOnly and by OFREP
+	ClientVisible bool `json:"clientVisible,omitempty"`
+
 	// created by
 	CreatedBy string `json:"createdBy,omitempty"`
 
@@ -27,6 +38,10 @@ type Flag struct {
 	// Required: true
 	DataRecordsEnabled *bool `json:"dataRecordsEnabled"`
 
+	// the variant returned when the flag is disabled, has no segments, or no segment matches. 0 means no default variant.
+	// Minimum: 0
+	DefaultVariantID *int64 `json:"defaultVariantID,omitempty"`
+
 	// description
 	// Required: true
 	// Min Length: 1
@@ -39,6 +54,10 @@ type Flag struct {
 	// it will override the entityType in the evaluation logs if it's not empty
 	EntityType string `json:"entityType,omitempty"`
 
+	// hash algorithm used for the consistent hashing of rollouts. crc32 is the default and it only supports 0.1% granularity of percents. Other algorithms support 0.01% (basis point) granularity.
+	// Enum: ["crc32","murmur3","xxhash","sha1"]
+	HashAlgorithm *string `json:"hashAlgorithm,omitempty"`
+
 	// id
 	// Read Only: true
 	// Minimum: 1
@@ -54,6 +73,9 @@ type Flag struct {
 	// segments
 	Segments []*Segment `json:"segments"`
 
+	// an entity keeps the variant it first gets from a segment, even if the segments or the distributions change, until the assignment salt is reset
+	StickyAssignments bool `json:"stickyAssignments,omitempty"`
+
 	// tags
 	Tags []*Tag `json:"tags"`
 
@@ -64,6 +86,12 @@ type Flag struct {
 	// updated by
 	UpdatedBy string `json:"updatedBy,omitempty"`
 
+	// the optional JSON Schema that the flag value of the variants has to match
+	ValueSchema interface{} `json:"valueSchema,omitempty"`
+
+	// the type of the flag value, one of boolean, string, number and object. The attachments of the variants of boolean, string and number flags hold the value in the "value" key, e.g. {"value": true}. The flag is untyped if it's empty.
+	ValueType string `json:"valueType,omitempty"`
+
 	// variants
 	Variants []*Variant `json:"variants"`
 }
@@ -76,6 +104,10 @@ func (m *Flag) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDefaultVariantID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
@@ -84,6 +116,10 @@ func (m *Flag) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHashAlgorithm(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -123,6 +159,18 @@ func (m *Flag) validateDataRecordsEnabled(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Flag) validateDefaultVariantID(formats strfmt.Registry) error {
+	if swag.IsZero(m.DefaultVariantID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("defaultVariantID", "body", *m.DefaultVariantID, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Flag) validateDescription(formats strfmt.Registry) error {
 
 	if err := validate.Required("description", "body", m.Description); err != nil {
@@ -145,6 +193,54 @@ func (m *Flag) validateEnabled(formats strfmt.Registry) error {
 	return nil
 }
 
+var flagTypeHashAlgorithmPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["crc32","murmur3","xxhash","sha1"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		flagTypeHashAlgorithmPropEnum = append(flagTypeHashAlgorithmPropEnum, v)
+	}
+}
+
+const (
+
+	// FlagHashAlgorithmCrc32 captures enum value "crc32"
+	FlagHashAlgorithmCrc32 string = "crc32"
+
+	// FlagHashAlgorithmMurmur3 captures enum value "murmur3"
+	FlagHashAlgorithmMurmur3 string = "murmur3"
+
+	// FlagHashAlgorithmXxhash captures enum value "xxhash"
+	FlagHashAlgorithmXxhash string = "xxhash"
+
+	// FlagHashAlgorithmSha1 captures enum value "sha1"
+	FlagHashAlgorithmSha1 string = "sha1"
+)
+
+// prop value enum
+func (m *Flag) validateHashAlgorithmEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, flagTypeHashAlgorithmPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Flag) validateHashAlgorithm(formats strfmt.Registry) error {
+	if swag.IsZero(m.HashAlgorithm) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHashAlgorithmEnum("hashAlgorithm", "body", *m.HashAlgorithm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Flag) validateID(formats strfmt.Registry) error {
 	if swag.IsZero(m.ID) { // not required
 		return nil
@@ -263,6 +359,10 @@ func (m *Flag) validateVariants(formats strfmt.Registry) error {
 func (m *Flag) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateAssignmentSalt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateID(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -285,6 +385,15 @@ func (m *Flag) ContextValidate(ctx context.Context, formats strfmt.Registry) err
 	return nil
 }
 
+func (m *Flag) contextValidateAssignmentSalt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "assignmentSalt", "body", string(m.AssignmentSalt)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Flag) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "id", "body", int64(m.ID)); err != nil {
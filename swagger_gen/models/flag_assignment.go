@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// FlagAssignment flag assignment
+//
+// swagger:model flagAssignment
+type FlagAssignment struct {
+
+	// attachment
+	Attachment interface{} `json:"attachment,omitempty"`
+
+	// empty if the flag has no variant for the entity
+	VariantKey string `json:"variantKey,omitempty"`
+}
+
+// Validate validates this flag assignment
+func (m *FlagAssignment) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this flag assignment based on context it is used
+func (m *FlagAssignment) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FlagAssignment) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FlagAssignment) UnmarshalBinary(b []byte) error {
+	var res FlagAssignment
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
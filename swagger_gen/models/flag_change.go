@@ -0,0 +1,122 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// FlagChange flag change
+//
+// swagger:model flagChange
+type FlagChange struct {
+
+	// the flag is deleted, or it's not in the evaluation cache anymore
+	// Required: true
+	Deleted *bool `json:"deleted"`
+
+	// flag ID
+	// Required: true
+	FlagID *int64 `json:"flagID"`
+
+	// flag key
+	// Required: true
+	FlagKey *string `json:"flagKey"`
+
+	// the snapshot of the flag picked up by the evaluation cache
+	// Required: true
+	FlagSnapshotID *int64 `json:"flagSnapshotID"`
+}
+
+// Validate validates this flag change
+func (m *FlagChange) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDeleted(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFlagID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFlagKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFlagSnapshotID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FlagChange) validateDeleted(formats strfmt.Registry) error {
+
+	if err := validate.Required("deleted", "body", m.Deleted); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FlagChange) validateFlagID(formats strfmt.Registry) error {
+
+	if err := validate.Required("flagID", "body", m.FlagID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FlagChange) validateFlagKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("flagKey", "body", m.FlagKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *FlagChange) validateFlagSnapshotID(formats strfmt.Registry) error {
+
+	if err := validate.Required("flagSnapshotID", "body", m.FlagSnapshotID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this flag change based on context it is used
+func (m *FlagChange) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *FlagChange) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *FlagChange) UnmarshalBinary(b []byte) error {
+	var res FlagChange
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,110 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OfrepBulkEvaluationFailure ofrep bulk evaluation failure
+//
+// swagger:model ofrepBulkEvaluationFailure
+type OfrepBulkEvaluationFailure struct {
+
+	// error code
+	// Required: true
+	// Enum: ["INVALID_CONTEXT","GENERAL"]
+	ErrorCode *string `json:"errorCode"`
+
+	// error details
+	ErrorDetails string `json:"errorDetails,omitempty"`
+}
+
+// Validate validates this ofrep bulk evaluation failure
+func (m *OfrepBulkEvaluationFailure) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateErrorCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var ofrepBulkEvaluationFailureTypeErrorCodePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["INVALID_CONTEXT","GENERAL"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		ofrepBulkEvaluationFailureTypeErrorCodePropEnum = append(ofrepBulkEvaluationFailureTypeErrorCodePropEnum, v)
+	}
+}
+
+const (
+
+	// OfrepBulkEvaluationFailureErrorCodeINVALIDCONTEXT captures enum value "INVALID_CONTEXT"
+	OfrepBulkEvaluationFailureErrorCodeINVALIDCONTEXT string = "INVALID_CONTEXT"
+
+	// OfrepBulkEvaluationFailureErrorCodeGENERAL captures enum value "GENERAL"
+	OfrepBulkEvaluationFailureErrorCodeGENERAL string = "GENERAL"
+)
+
+// prop value enum
+func (m *OfrepBulkEvaluationFailure) validateErrorCodeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, ofrepBulkEvaluationFailureTypeErrorCodePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *OfrepBulkEvaluationFailure) validateErrorCode(formats strfmt.Registry) error {
+
+	if err := validate.Required("errorCode", "body", m.ErrorCode); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateErrorCodeEnum("errorCode", "body", *m.ErrorCode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this ofrep bulk evaluation failure based on context it is used
+func (m *OfrepBulkEvaluationFailure) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OfrepBulkEvaluationFailure) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OfrepBulkEvaluationFailure) UnmarshalBinary(b []byte) error {
+	var res OfrepBulkEvaluationFailure
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,124 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OfrepBulkEvaluationResponse ofrep bulk evaluation response
+//
+// swagger:model ofrepBulkEvaluationResponse
+type OfrepBulkEvaluationResponse struct {
+
+	// flags
+	// Required: true
+	Flags []*OfrepEvaluationResult `json:"flags"`
+}
+
+// Validate validates this ofrep bulk evaluation response
+func (m *OfrepBulkEvaluationResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFlags(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OfrepBulkEvaluationResponse) validateFlags(formats strfmt.Registry) error {
+
+	if err := validate.Required("flags", "body", m.Flags); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Flags); i++ {
+		if swag.IsZero(m.Flags[i]) { // not required
+			continue
+		}
+
+		if m.Flags[i] != nil {
+			if err := m.Flags[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("flags" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("flags" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this ofrep bulk evaluation response based on the context it is used
+func (m *OfrepBulkEvaluationResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateFlags(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OfrepBulkEvaluationResponse) contextValidateFlags(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Flags); i++ {
+
+		if m.Flags[i] != nil {
+
+			if swag.IsZero(m.Flags[i]) { // not required
+				return nil
+			}
+
+			if err := m.Flags[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("flags" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("flags" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OfrepBulkEvaluationResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OfrepBulkEvaluationResponse) UnmarshalBinary(b []byte) error {
+	var res OfrepBulkEvaluationResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,50 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// OfrepEvaluationRequest ofrep evaluation request
+//
+// swagger:model ofrepEvaluationRequest
+type OfrepEvaluationRequest struct {
+
+	// the evaluation context of OpenFeature. The targetingKey is the entityID, and the other attributes are the entityContext.
+	Context interface{} `json:"context,omitempty"`
+}
+
+// Validate validates this ofrep evaluation request
+func (m *OfrepEvaluationRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this ofrep evaluation request based on context it is used
+func (m *OfrepEvaluationRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OfrepEvaluationRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OfrepEvaluationRequest) UnmarshalBinary(b []byte) error {
+	var res OfrepEvaluationRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,143 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OfrepEvaluationResult ofrep evaluation result
+//
+// swagger:model ofrepEvaluationResult
+type OfrepEvaluationResult struct {
+
+	// the OpenFeature error code if the evaluation failed
+	// Enum: ["FLAG_NOT_FOUND","TYPE_MISMATCH","INVALID_CONTEXT","GENERAL"]
+	ErrorCode string `json:"errorCode,omitempty"`
+
+	// error details
+	ErrorDetails string `json:"errorDetails,omitempty"`
+
+	// the flag key
+	// Required: true
+	Key *string `json:"key"`
+
+	// the flagID, flagSnapshotID, segmentID, variantID and flagrReason of the evaluation
+	Metadata interface{} `json:"metadata,omitempty"`
+
+	// the OpenFeature reason. TARGETING_MATCH means the entity got the variant of a matched segment or a variant override, and DEFAULT means it got a default variant. The flagr reason is in the metadata. A disabled flag is FLAG_NOT_FOUND, and a result without a variant is GENERAL, with the flagr reason in the errorDetails.
+	Reason string `json:"reason,omitempty"`
+
+	// the flag value of the variant. It's the value in the attachment for the boolean, string and number flags, and the attachment for the object flags. For the untyped flags, it's the value of an attachment with only the "value" key, the attachment if it's not empty, or the variant key otherwise.
+	Value interface{} `json:"value,omitempty"`
+
+	// the variant key, it's empty if the entity has no variant
+	Variant string `json:"variant,omitempty"`
+}
+
+// Validate validates this ofrep evaluation result
+func (m *OfrepEvaluationResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateErrorCode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var ofrepEvaluationResultTypeErrorCodePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["FLAG_NOT_FOUND","TYPE_MISMATCH","INVALID_CONTEXT","GENERAL"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		ofrepEvaluationResultTypeErrorCodePropEnum = append(ofrepEvaluationResultTypeErrorCodePropEnum, v)
+	}
+}
+
+const (
+
+	// OfrepEvaluationResultErrorCodeFLAGNOTFOUND captures enum value "FLAG_NOT_FOUND"
+	OfrepEvaluationResultErrorCodeFLAGNOTFOUND string = "FLAG_NOT_FOUND"
+
+	// OfrepEvaluationResultErrorCodeTYPEMISMATCH captures enum value "TYPE_MISMATCH"
+	OfrepEvaluationResultErrorCodeTYPEMISMATCH string = "TYPE_MISMATCH"
+
+	// OfrepEvaluationResultErrorCodeINVALIDCONTEXT captures enum value "INVALID_CONTEXT"
+	OfrepEvaluationResultErrorCodeINVALIDCONTEXT string = "INVALID_CONTEXT"
+
+	// OfrepEvaluationResultErrorCodeGENERAL captures enum value "GENERAL"
+	OfrepEvaluationResultErrorCodeGENERAL string = "GENERAL"
+)
+
+// prop value enum
+func (m *OfrepEvaluationResult) validateErrorCodeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, ofrepEvaluationResultTypeErrorCodePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *OfrepEvaluationResult) validateErrorCode(formats strfmt.Registry) error {
+	if swag.IsZero(m.ErrorCode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateErrorCodeEnum("errorCode", "body", m.ErrorCode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OfrepEvaluationResult) validateKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("key", "body", m.Key); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this ofrep evaluation result based on context it is used
+func (m *OfrepEvaluationResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OfrepEvaluationResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OfrepEvaluationResult) UnmarshalBinary(b []byte) error {
+	var res OfrepEvaluationResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
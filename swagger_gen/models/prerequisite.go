@@ -0,0 +1,109 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Prerequisite the entity needs to get one of the variantKeys of the flag with flagKey to match the segment
+//
+// swagger:model prerequisite
+type Prerequisite struct {
+
+	// flag key
+	// Required: true
+	// Min Length: 1
+	FlagKey *string `json:"flagKey"`
+
+	// variant keys
+	// Required: true
+	// Min Items: 1
+	VariantKeys []string `json:"variantKeys"`
+}
+
+// Validate validates this prerequisite
+func (m *Prerequisite) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFlagKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVariantKeys(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Prerequisite) validateFlagKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("flagKey", "body", m.FlagKey); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("flagKey", "body", *m.FlagKey, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Prerequisite) validateVariantKeys(formats strfmt.Registry) error {
+
+	if err := validate.Required("variantKeys", "body", m.VariantKeys); err != nil {
+		return err
+	}
+
+	iVariantKeysSize := int64(len(m.VariantKeys))
+
+	if err := validate.MinItems("variantKeys", "body", iVariantKeysSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.VariantKeys); i++ {
+
+		if err := validate.MinLength("variantKeys"+"."+strconv.Itoa(i), "body", m.VariantKeys[i], 1); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validates this prerequisite based on context it is used
+func (m *Prerequisite) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Prerequisite) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Prerequisite) UnmarshalBinary(b []byte) error {
+	var res Prerequisite
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,9 +20,19 @@ import (
 // swagger:model putFlagRequest
 type PutFlagRequest struct {
 
+	// comma separated entityContext properties used as the bucketing key for rollouts
+	BucketBy *string `json:"bucketBy,omitempty"`
+
+	// client visible flags are evaluated by the bootstrap evaluation with clientVisibleOnly and by OFREP
+	ClientVisible *bool `json:"clientVisible,omitempty"`
+
 	// enabled data records will get data logging in the metrics pipeline, for example, kafka.
 	DataRecordsEnabled *bool `json:"dataRecordsEnabled,omitempty"`
 
+	// the variant returned when the flag is disabled, has no segments, or no segment matches. 0 clears it.
+	// Minimum: 0
+	DefaultVariantID *int64 `json:"defaultVariantID,omitempty"`
+
 	// description
 	// Min Length: 1
 	Description *string `json:"description,omitempty"`
@@ -32,27 +43,60 @@ type PutFlagRequest struct {
 	// it will overwrite entityType into evaluation logs if it's not empty
 	EntityType *string `json:"entityType,omitempty"`
 
+	// hash algorithm used for the consistent hashing of rollouts
+	// Enum: ["crc32","murmur3","xxhash","sha1"]
+	HashAlgorithm *string `json:"hashAlgorithm,omitempty"`
+
 	// key
 	Key *string `json:"key,omitempty"`
 
 	// notes
 	Notes *string `json:"notes,omitempty"`
+
+	// an entity keeps the variant it first gets from a segment until the assignment salt is reset
+	StickyAssignments *bool `json:"stickyAssignments,omitempty"`
+
+	// the JSON Schema of the flag value. An empty object clears it.
+	ValueSchema interface{} `json:"valueSchema,omitempty"`
+
+	// the type of the flag value, one of boolean, string, number and object. Empty makes the flag untyped.
+	ValueType *string `json:"valueType,omitempty"`
 }
 
 // Validate validates this put flag request
 func (m *PutFlagRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDefaultVariantID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateHashAlgorithm(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *PutFlagRequest) validateDefaultVariantID(formats strfmt.Registry) error {
+	if swag.IsZero(m.DefaultVariantID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("defaultVariantID", "body", *m.DefaultVariantID, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PutFlagRequest) validateDescription(formats strfmt.Registry) error {
 	if swag.IsZero(m.Description) { // not required
 		return nil
@@ -65,6 +109,54 @@ func (m *PutFlagRequest) validateDescription(formats strfmt.Registry) error {
 	return nil
 }
 
+var putFlagRequestTypeHashAlgorithmPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["crc32","murmur3","xxhash","sha1"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		putFlagRequestTypeHashAlgorithmPropEnum = append(putFlagRequestTypeHashAlgorithmPropEnum, v)
+	}
+}
+
+const (
+
+	// PutFlagRequestHashAlgorithmCrc32 captures enum value "crc32"
+	PutFlagRequestHashAlgorithmCrc32 string = "crc32"
+
+	// PutFlagRequestHashAlgorithmMurmur3 captures enum value "murmur3"
+	PutFlagRequestHashAlgorithmMurmur3 string = "murmur3"
+
+	// PutFlagRequestHashAlgorithmXxhash captures enum value "xxhash"
+	PutFlagRequestHashAlgorithmXxhash string = "xxhash"
+
+	// PutFlagRequestHashAlgorithmSha1 captures enum value "sha1"
+	PutFlagRequestHashAlgorithmSha1 string = "sha1"
+)
+
+// prop value enum
+func (m *PutFlagRequest) validateHashAlgorithmEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, putFlagRequestTypeHashAlgorithmPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PutFlagRequest) validateHashAlgorithm(formats strfmt.Registry) error {
+	if swag.IsZero(m.HashAlgorithm) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateHashAlgorithmEnum("hashAlgorithm", "body", *m.HashAlgorithm); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validates this put flag request based on context it is used
 func (m *PutFlagRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,26 +20,58 @@ import (
 // swagger:model putSegmentRequest
 type PutSegmentRequest struct {
 
+	// audiences of the segment. If it's not set, the audiences stay the same.
+	AudienceIDs []int64 `json:"audienceIDs"`
+
+	// overrides the flag's bucketBy if it's not empty
+	BucketBy *string `json:"bucketBy,omitempty"`
+
+	// constraint groups of the segment. If it's not set, the constraint groups stay the same.
+	ConstraintGroups []*ConstraintGroup `json:"constraintGroups"`
+
+	// the variant returned when the entity matches the segment but misses the rollout. 0 clears it.
+	// Minimum: 0
+	DefaultVariantID *int64 `json:"defaultVariantID,omitempty"`
+
 	// description
 	// Required: true
 	// Min Length: 1
 	Description *string `json:"description"`
 
+	// prerequisites of the segment. If it's not set, the prerequisites stay the same.
+	Prerequisites []*Prerequisite `json:"prerequisites"`
+
 	// rollout percent
 	// Required: true
 	// Maximum: 100
 	// Minimum: 0
-	RolloutPercent *int64 `json:"rolloutPercent"`
+	RolloutPercent *float64 `json:"rolloutPercent"`
 }
 
 // Validate validates this put segment request
 func (m *PutSegmentRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAudienceIDs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateConstraintGroups(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDefaultVariantID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validatePrerequisites(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRolloutPercent(formats); err != nil {
 		res = append(res, err)
 	}
@@ -49,6 +82,60 @@ func (m *PutSegmentRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PutSegmentRequest) validateAudienceIDs(formats strfmt.Registry) error {
+	if swag.IsZero(m.AudienceIDs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AudienceIDs); i++ {
+
+		if err := validate.MinimumInt("audienceIDs"+"."+strconv.Itoa(i), "body", m.AudienceIDs[i], 1, false); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PutSegmentRequest) validateConstraintGroups(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConstraintGroups) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ConstraintGroups); i++ {
+		if swag.IsZero(m.ConstraintGroups[i]) { // not required
+			continue
+		}
+
+		if m.ConstraintGroups[i] != nil {
+			if err := m.ConstraintGroups[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PutSegmentRequest) validateDefaultVariantID(formats strfmt.Registry) error {
+	if swag.IsZero(m.DefaultVariantID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("defaultVariantID", "body", *m.DefaultVariantID, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PutSegmentRequest) validateDescription(formats strfmt.Registry) error {
 
 	if err := validate.Required("description", "body", m.Description); err != nil {
@@ -62,25 +149,114 @@ func (m *PutSegmentRequest) validateDescription(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PutSegmentRequest) validatePrerequisites(formats strfmt.Registry) error {
+	if swag.IsZero(m.Prerequisites) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Prerequisites); i++ {
+		if swag.IsZero(m.Prerequisites[i]) { // not required
+			continue
+		}
+
+		if m.Prerequisites[i] != nil {
+			if err := m.Prerequisites[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("prerequisites" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("prerequisites" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *PutSegmentRequest) validateRolloutPercent(formats strfmt.Registry) error {
 
 	if err := validate.Required("rolloutPercent", "body", m.RolloutPercent); err != nil {
 		return err
 	}
 
-	if err := validate.MinimumInt("rolloutPercent", "body", *m.RolloutPercent, 0, false); err != nil {
+	if err := validate.Minimum("rolloutPercent", "body", *m.RolloutPercent, 0, false); err != nil {
 		return err
 	}
 
-	if err := validate.MaximumInt("rolloutPercent", "body", *m.RolloutPercent, 100, false); err != nil {
+	if err := validate.Maximum("rolloutPercent", "body", *m.RolloutPercent, 100, false); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this put segment request based on context it is used
+// ContextValidate validate this put segment request based on the context it is used
 func (m *PutSegmentRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateConstraintGroups(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePrerequisites(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PutSegmentRequest) contextValidateConstraintGroups(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ConstraintGroups); i++ {
+
+		if m.ConstraintGroups[i] != nil {
+
+			if swag.IsZero(m.ConstraintGroups[i]) { // not required
+				return nil
+			}
+
+			if err := m.ConstraintGroups[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PutSegmentRequest) contextValidatePrerequisites(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Prerequisites); i++ {
+
+		if m.Prerequisites[i] != nil {
+
+			if swag.IsZero(m.Prerequisites[i]) { // not required
+				return nil
+			}
+
+			if err := m.Prerequisites[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("prerequisites" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("prerequisites" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -0,0 +1,276 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// RolloutRamp rollout ramp
+//
+// swagger:model rolloutRamp
+type RolloutRamp struct {
+
+	// created by
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	// the number of the steps applied
+	// Required: true
+	CurrentStep *int64 `json:"currentStep"`
+
+	// the reason of the FAILED status
+	Error string `json:"error,omitempty"`
+
+	// flag ID
+	// Required: true
+	// Minimum: 1
+	FlagID *int64 `json:"flagID"`
+
+	// id
+	// Read Only: true
+	// Minimum: 1
+	ID int64 `json:"id,omitempty"`
+
+	// when the next step is due, it's empty if the ramp is not active
+	// Format: date-time
+	NextStepAt *strfmt.DateTime `json:"nextStepAt,omitempty"`
+
+	// segment ID
+	// Required: true
+	// Minimum: 1
+	SegmentID *int64 `json:"segmentID"`
+
+	// status
+	// Required: true
+	// Enum: ["ACTIVE","PAUSED","COMPLETED","ABORTED","FAILED"]
+	Status *string `json:"status"`
+
+	// step interval seconds
+	// Required: true
+	StepIntervalSeconds *int64 `json:"stepIntervalSeconds"`
+
+	// the rollout percents of the steps in order
+	// Required: true
+	Steps []float64 `json:"steps"`
+}
+
+// Validate validates this rollout ramp
+func (m *RolloutRamp) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCurrentStep(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFlagID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNextStepAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSegmentID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStepIntervalSeconds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSteps(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RolloutRamp) validateCurrentStep(formats strfmt.Registry) error {
+
+	if err := validate.Required("currentStep", "body", m.CurrentStep); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RolloutRamp) validateFlagID(formats strfmt.Registry) error {
+
+	if err := validate.Required("flagID", "body", m.FlagID); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("flagID", "body", *m.FlagID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RolloutRamp) validateID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("id", "body", m.ID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RolloutRamp) validateNextStepAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.NextStepAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("nextStepAt", "body", "date-time", m.NextStepAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RolloutRamp) validateSegmentID(formats strfmt.Registry) error {
+
+	if err := validate.Required("segmentID", "body", m.SegmentID); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("segmentID", "body", *m.SegmentID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var rolloutRampTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["ACTIVE","PAUSED","COMPLETED","ABORTED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		rolloutRampTypeStatusPropEnum = append(rolloutRampTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// RolloutRampStatusACTIVE captures enum value "ACTIVE"
+	RolloutRampStatusACTIVE string = "ACTIVE"
+
+	// RolloutRampStatusPAUSED captures enum value "PAUSED"
+	RolloutRampStatusPAUSED string = "PAUSED"
+
+	// RolloutRampStatusCOMPLETED captures enum value "COMPLETED"
+	RolloutRampStatusCOMPLETED string = "COMPLETED"
+
+	// RolloutRampStatusABORTED captures enum value "ABORTED"
+	RolloutRampStatusABORTED string = "ABORTED"
+
+	// RolloutRampStatusFAILED captures enum value "FAILED"
+	RolloutRampStatusFAILED string = "FAILED"
+)
+
+// prop value enum
+func (m *RolloutRamp) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, rolloutRampTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *RolloutRamp) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RolloutRamp) validateStepIntervalSeconds(formats strfmt.Registry) error {
+
+	if err := validate.Required("stepIntervalSeconds", "body", m.StepIntervalSeconds); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RolloutRamp) validateSteps(formats strfmt.Registry) error {
+
+	if err := validate.Required("steps", "body", m.Steps); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this rollout ramp based on the context it is used
+func (m *RolloutRamp) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RolloutRamp) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "id", "body", int64(m.ID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RolloutRamp) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RolloutRamp) UnmarshalBinary(b []byte) error {
+	var res RolloutRamp
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
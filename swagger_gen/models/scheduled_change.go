@@ -0,0 +1,349 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ScheduledChange scheduled change
+//
+// swagger:model scheduledChange
+type ScheduledChange struct {
+
+	// action
+	// Required: true
+	// Enum: ["ENABLE_FLAG","DISABLE_FLAG","SET_ROLLOUT_PERCENT","SET_DISTRIBUTIONS"]
+	Action *string `json:"action"`
+
+	// applied at
+	// Format: date-time
+	AppliedAt *strfmt.DateTime `json:"appliedAt,omitempty"`
+
+	// created by
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	// the distributions of SET_DISTRIBUTIONS
+	Distributions []*Distribution `json:"distributions"`
+
+	// the reason of the FAILED status
+	Error string `json:"error,omitempty"`
+
+	// execute at
+	// Required: true
+	// Format: date-time
+	ExecuteAt *strfmt.DateTime `json:"executeAt"`
+
+	// flag ID
+	// Required: true
+	// Minimum: 1
+	FlagID *int64 `json:"flagID"`
+
+	// id
+	// Read Only: true
+	// Minimum: 1
+	ID int64 `json:"id,omitempty"`
+
+	// the rollout percent of SET_ROLLOUT_PERCENT
+	RolloutPercent float64 `json:"rolloutPercent,omitempty"`
+
+	// the segment of SET_ROLLOUT_PERCENT and SET_DISTRIBUTIONS
+	SegmentID int64 `json:"segmentID,omitempty"`
+
+	// status
+	// Required: true
+	// Enum: ["PENDING","APPLIED","FAILED","CANCELED"]
+	Status *string `json:"status"`
+}
+
+// Validate validates this scheduled change
+func (m *ScheduledChange) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAppliedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDistributions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExecuteAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFlagID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var scheduledChangeTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["ENABLE_FLAG","DISABLE_FLAG","SET_ROLLOUT_PERCENT","SET_DISTRIBUTIONS"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		scheduledChangeTypeActionPropEnum = append(scheduledChangeTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// ScheduledChangeActionENABLEFLAG captures enum value "ENABLE_FLAG"
+	ScheduledChangeActionENABLEFLAG string = "ENABLE_FLAG"
+
+	// ScheduledChangeActionDISABLEFLAG captures enum value "DISABLE_FLAG"
+	ScheduledChangeActionDISABLEFLAG string = "DISABLE_FLAG"
+
+	// ScheduledChangeActionSETROLLOUTPERCENT captures enum value "SET_ROLLOUT_PERCENT"
+	ScheduledChangeActionSETROLLOUTPERCENT string = "SET_ROLLOUT_PERCENT"
+
+	// ScheduledChangeActionSETDISTRIBUTIONS captures enum value "SET_DISTRIBUTIONS"
+	ScheduledChangeActionSETDISTRIBUTIONS string = "SET_DISTRIBUTIONS"
+)
+
+// prop value enum
+func (m *ScheduledChange) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, scheduledChangeTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ScheduledChange) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", *m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ScheduledChange) validateAppliedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.AppliedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("appliedAt", "body", "date-time", m.AppliedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ScheduledChange) validateDistributions(formats strfmt.Registry) error {
+	if swag.IsZero(m.Distributions) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Distributions); i++ {
+		if swag.IsZero(m.Distributions[i]) { // not required
+			continue
+		}
+
+		if m.Distributions[i] != nil {
+			if err := m.Distributions[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("distributions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("distributions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ScheduledChange) validateExecuteAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("executeAt", "body", m.ExecuteAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("executeAt", "body", "date-time", m.ExecuteAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ScheduledChange) validateFlagID(formats strfmt.Registry) error {
+
+	if err := validate.Required("flagID", "body", m.FlagID); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("flagID", "body", *m.FlagID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ScheduledChange) validateID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("id", "body", m.ID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var scheduledChangeTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["PENDING","APPLIED","FAILED","CANCELED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		scheduledChangeTypeStatusPropEnum = append(scheduledChangeTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ScheduledChangeStatusPENDING captures enum value "PENDING"
+	ScheduledChangeStatusPENDING string = "PENDING"
+
+	// ScheduledChangeStatusAPPLIED captures enum value "APPLIED"
+	ScheduledChangeStatusAPPLIED string = "APPLIED"
+
+	// ScheduledChangeStatusFAILED captures enum value "FAILED"
+	ScheduledChangeStatusFAILED string = "FAILED"
+
+	// ScheduledChangeStatusCANCELED captures enum value "CANCELED"
+	ScheduledChangeStatusCANCELED string = "CANCELED"
+)
+
+// prop value enum
+func (m *ScheduledChange) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, scheduledChangeTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ScheduledChange) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this scheduled change based on the context it is used
+func (m *ScheduledChange) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDistributions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ScheduledChange) contextValidateDistributions(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Distributions); i++ {
+
+		if m.Distributions[i] != nil {
+
+			if swag.IsZero(m.Distributions[i]) { // not required
+				return nil
+			}
+
+			if err := m.Distributions[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("distributions" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("distributions" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ScheduledChange) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "id", "body", int64(m.ID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ScheduledChange) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ScheduledChange) UnmarshalBinary(b []byte) error {
+	var res ScheduledChange
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -20,9 +20,22 @@ import (
 // swagger:model segment
 type Segment struct {
 
+	// the audiences the entity needs to be in, together with the constraints of the segment
+	AudienceIDs []int64 `json:"audienceIDs"`
+
+	// overrides the flag's bucketBy if it's not empty
+	BucketBy string `json:"bucketBy,omitempty"`
+
+	// groups of constraints with AND/OR/NOT nesting, they are joined with AND together with the constraints
+	ConstraintGroups []*ConstraintGroup `json:"constraintGroups"`
+
 	// constraints
 	Constraints []*Constraint `json:"constraints"`
 
+	// the variant returned when the entity matches the segment but misses the rollout. 0 means no default variant.
+	// Minimum: 0
+	DefaultVariantID *int64 `json:"defaultVariantID,omitempty"`
+
 	// description
 	// Required: true
 	// Min Length: 1
@@ -36,6 +49,9 @@ type Segment struct {
 	// Minimum: 1
 	ID int64 `json:"id,omitempty"`
 
+	// prerequisites
+	Prerequisites []*Prerequisite `json:"prerequisites"`
+
 	// rank
 	// Required: true
 	// Minimum: 0
@@ -45,17 +61,29 @@ type Segment struct {
 	// Required: true
 	// Maximum: 100
 	// Minimum: 0
-	RolloutPercent *int64 `json:"rolloutPercent"`
+	RolloutPercent *float64 `json:"rolloutPercent"`
 }
 
 // Validate validates this segment
 func (m *Segment) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAudienceIDs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateConstraintGroups(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateConstraints(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateDefaultVariantID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
@@ -68,6 +96,10 @@ func (m *Segment) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePrerequisites(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRank(formats); err != nil {
 		res = append(res, err)
 	}
@@ -82,6 +114,48 @@ func (m *Segment) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Segment) validateAudienceIDs(formats strfmt.Registry) error {
+	if swag.IsZero(m.AudienceIDs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AudienceIDs); i++ {
+
+		if err := validate.MinimumInt("audienceIDs"+"."+strconv.Itoa(i), "body", m.AudienceIDs[i], 1, false); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Segment) validateConstraintGroups(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConstraintGroups) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ConstraintGroups); i++ {
+		if swag.IsZero(m.ConstraintGroups[i]) { // not required
+			continue
+		}
+
+		if m.ConstraintGroups[i] != nil {
+			if err := m.ConstraintGroups[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Segment) validateConstraints(formats strfmt.Registry) error {
 	if swag.IsZero(m.Constraints) { // not required
 		return nil
@@ -108,6 +182,18 @@ func (m *Segment) validateConstraints(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Segment) validateDefaultVariantID(formats strfmt.Registry) error {
+	if swag.IsZero(m.DefaultVariantID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("defaultVariantID", "body", *m.DefaultVariantID, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Segment) validateDescription(formats strfmt.Registry) error {
 
 	if err := validate.Required("description", "body", m.Description); err != nil {
@@ -159,6 +245,32 @@ func (m *Segment) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Segment) validatePrerequisites(formats strfmt.Registry) error {
+	if swag.IsZero(m.Prerequisites) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Prerequisites); i++ {
+		if swag.IsZero(m.Prerequisites[i]) { // not required
+			continue
+		}
+
+		if m.Prerequisites[i] != nil {
+			if err := m.Prerequisites[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("prerequisites" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("prerequisites" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Segment) validateRank(formats strfmt.Registry) error {
 
 	if err := validate.Required("rank", "body", m.Rank); err != nil {
@@ -178,11 +290,11 @@ func (m *Segment) validateRolloutPercent(formats strfmt.Registry) error {
 		return err
 	}
 
-	if err := validate.MinimumInt("rolloutPercent", "body", *m.RolloutPercent, 0, false); err != nil {
+	if err := validate.Minimum("rolloutPercent", "body", *m.RolloutPercent, 0, false); err != nil {
 		return err
 	}
 
-	if err := validate.MaximumInt("rolloutPercent", "body", *m.RolloutPercent, 100, false); err != nil {
+	if err := validate.Maximum("rolloutPercent", "body", *m.RolloutPercent, 100, false); err != nil {
 		return err
 	}
 
@@ -193,6 +305,10 @@ func (m *Segment) validateRolloutPercent(formats strfmt.Registry) error {
 func (m *Segment) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateConstraintGroups(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateConstraints(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -205,12 +321,41 @@ func (m *Segment) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidatePrerequisites(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *Segment) contextValidateConstraintGroups(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ConstraintGroups); i++ {
+
+		if m.ConstraintGroups[i] != nil {
+
+			if swag.IsZero(m.ConstraintGroups[i]) { // not required
+				return nil
+			}
+
+			if err := m.ConstraintGroups[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraintGroups" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Segment) contextValidateConstraints(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Constraints); i++ {
@@ -270,6 +415,31 @@ func (m *Segment) contextValidateID(ctx context.Context, formats strfmt.Registry
 	return nil
 }
 
+func (m *Segment) contextValidatePrerequisites(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Prerequisites); i++ {
+
+		if m.Prerequisites[i] != nil {
+
+			if swag.IsZero(m.Prerequisites[i]) { // not required
+				return nil
+			}
+
+			if err := m.Prerequisites[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("prerequisites" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("prerequisites" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Segment) MarshalBinary() ([]byte, error) {
 	if m == nil {
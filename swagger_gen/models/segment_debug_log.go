@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,6 +20,9 @@ import (
 // swagger:model segmentDebugLog
 type SegmentDebugLog struct {
 
+	// the result of each constraint of the segment and its audiences, only present when enableDebug is true
+	ConstraintTraces []*ConstraintTrace `json:"constraintTraces"`
+
 	// msg
 	Msg string `json:"msg,omitempty"`
 
@@ -31,6 +35,10 @@ type SegmentDebugLog struct {
 func (m *SegmentDebugLog) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateConstraintTraces(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSegmentID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -41,6 +49,32 @@ func (m *SegmentDebugLog) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *SegmentDebugLog) validateConstraintTraces(formats strfmt.Registry) error {
+	if swag.IsZero(m.ConstraintTraces) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ConstraintTraces); i++ {
+		if swag.IsZero(m.ConstraintTraces[i]) { // not required
+			continue
+		}
+
+		if m.ConstraintTraces[i] != nil {
+			if err := m.ConstraintTraces[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraintTraces" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraintTraces" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *SegmentDebugLog) validateSegmentID(formats strfmt.Registry) error {
 	if swag.IsZero(m.SegmentID) { // not required
 		return nil
@@ -53,8 +87,42 @@ func (m *SegmentDebugLog) validateSegmentID(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this segment debug log based on context it is used
+// ContextValidate validate this segment debug log based on the context it is used
 func (m *SegmentDebugLog) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateConstraintTraces(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SegmentDebugLog) contextValidateConstraintTraces(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.ConstraintTraces); i++ {
+
+		if m.ConstraintTraces[i] != nil {
+
+			if swag.IsZero(m.ConstraintTraces[i]) { // not required
+				return nil
+			}
+
+			if err := m.ConstraintTraces[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("constraintTraces" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("constraintTraces" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -0,0 +1,125 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SimulationSegment simulation segment
+//
+// swagger:model simulationSegment
+type SimulationSegment struct {
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// the number of the entities getting a variant from the segment
+	// Required: true
+	Matched *int64 `json:"matched"`
+
+	// rank
+	// Required: true
+	Rank *int64 `json:"rank"`
+
+	// the number of the entities matching the segment but not in its rollout
+	// Required: true
+	RolloutExcluded *int64 `json:"rolloutExcluded"`
+
+	// segment ID
+	// Required: true
+	SegmentID *int64 `json:"segmentID"`
+}
+
+// Validate validates this simulation segment
+func (m *SimulationSegment) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMatched(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRank(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRolloutExcluded(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSegmentID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SimulationSegment) validateMatched(formats strfmt.Registry) error {
+
+	if err := validate.Required("matched", "body", m.Matched); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SimulationSegment) validateRank(formats strfmt.Registry) error {
+
+	if err := validate.Required("rank", "body", m.Rank); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SimulationSegment) validateRolloutExcluded(formats strfmt.Registry) error {
+
+	if err := validate.Required("rolloutExcluded", "body", m.RolloutExcluded); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SimulationSegment) validateSegmentID(formats strfmt.Registry) error {
+
+	if err := validate.Required("segmentID", "body", m.SegmentID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this simulation segment based on context it is used
+func (m *SimulationSegment) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SimulationSegment) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SimulationSegment) UnmarshalBinary(b []byte) error {
+	var res SimulationSegment
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
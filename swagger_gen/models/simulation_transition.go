@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SimulationTransition simulation transition
+//
+// swagger:model simulationTransition
+type SimulationTransition struct {
+
+	// count
+	// Required: true
+	Count *int64 `json:"count"`
+
+	// the variant key from the live flag, empty if there's no variant
+	// Required: true
+	FromVariantKey *string `json:"fromVariantKey"`
+
+	// the variant key from the simulated flag, empty if there's no variant
+	// Required: true
+	ToVariantKey *string `json:"toVariantKey"`
+}
+
+// Validate validates this simulation transition
+func (m *SimulationTransition) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFromVariantKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateToVariantKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SimulationTransition) validateCount(formats strfmt.Registry) error {
+
+	if err := validate.Required("count", "body", m.Count); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SimulationTransition) validateFromVariantKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("fromVariantKey", "body", m.FromVariantKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SimulationTransition) validateToVariantKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("toVariantKey", "body", m.ToVariantKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this simulation transition based on context it is used
+func (m *SimulationTransition) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SimulationTransition) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SimulationTransition) UnmarshalBinary(b []byte) error {
+	var res SimulationTransition
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
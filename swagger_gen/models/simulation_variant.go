@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SimulationVariant simulation variant
+//
+// swagger:model simulationVariant
+type SimulationVariant struct {
+
+	// count
+	// Required: true
+	Count *int64 `json:"count"`
+
+	// variant ID
+	// Required: true
+	VariantID *int64 `json:"variantID"`
+
+	// variant key
+	// Required: true
+	VariantKey *string `json:"variantKey"`
+}
+
+// Validate validates this simulation variant
+func (m *SimulationVariant) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVariantID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVariantKey(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SimulationVariant) validateCount(formats strfmt.Registry) error {
+
+	if err := validate.Required("count", "body", m.Count); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SimulationVariant) validateVariantID(formats strfmt.Registry) error {
+
+	if err := validate.Required("variantID", "body", m.VariantID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SimulationVariant) validateVariantKey(formats strfmt.Registry) error {
+
+	if err := validate.Required("variantKey", "body", m.VariantKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this simulation variant based on context it is used
+func (m *SimulationVariant) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SimulationVariant) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SimulationVariant) UnmarshalBinary(b []byte) error {
+	var res SimulationVariant
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
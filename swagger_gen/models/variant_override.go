@@ -0,0 +1,180 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// VariantOverride variant override
+//
+// swagger:model variantOverride
+type VariantOverride struct {
+
+	// created by
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	// entity ID
+	// Required: true
+	// Min Length: 1
+	EntityID *string `json:"entityID"`
+
+	// the override only applies to this entityType if it's set
+	EntityType string `json:"entityType,omitempty"`
+
+	// the override no longer applies since this time
+	// Format: date-time
+	ExpiresAt *strfmt.DateTime `json:"expiresAt,omitempty"`
+
+	// id
+	// Read Only: true
+	// Minimum: 1
+	ID int64 `json:"id,omitempty"`
+
+	// variant ID
+	// Required: true
+	// Minimum: 1
+	VariantID *int64 `json:"variantID"`
+
+	// variant key
+	// Read Only: true
+	VariantKey string `json:"variantKey,omitempty"`
+}
+
+// Validate validates this variant override
+func (m *VariantOverride) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEntityID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateVariantID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VariantOverride) validateEntityID(formats strfmt.Registry) error {
+
+	if err := validate.Required("entityID", "body", m.EntityID); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("entityID", "body", *m.EntityID, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *VariantOverride) validateExpiresAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expiresAt", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *VariantOverride) validateID(formats strfmt.Registry) error {
+	if swag.IsZero(m.ID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("id", "body", m.ID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *VariantOverride) validateVariantID(formats strfmt.Registry) error {
+
+	if err := validate.Required("variantID", "body", m.VariantID); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("variantID", "body", *m.VariantID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this variant override based on the context it is used
+func (m *VariantOverride) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateVariantKey(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VariantOverride) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "id", "body", int64(m.ID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *VariantOverride) contextValidateVariantKey(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "variantKey", "body", string(m.VariantKey)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VariantOverride) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VariantOverride) UnmarshalBinary(b []byte) error {
+	var res VariantOverride
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -33,6 +33,8 @@ func configureAPI(api *operations.FlagrAPI) http.Handler {
 		dec.UseNumber()
 		return dec.Decode(data)
 	})
+	// the CSV body of the ID list entries is parsed by the handler
+	api.CsvConsumer = runtime.TextConsumer()
 
 	api.JSONProducer = runtime.ProducerFunc(func(writer io.Writer, data interface{}) error {
 		enc := json.NewEncoder(writer)
@@ -40,6 +42,8 @@ func configureAPI(api *operations.FlagrAPI) http.Handler {
 		return enc.Encode(data)
 	})
 	api.BinProducer = runtime.ByteStreamProducer()
+	// the events of the flag changes stream are written by the handler, it only produces the errors
+	api.TextEventStreamProducer = runtime.TextProducer()
 
 	api.Logger = logrus.Infof
 	api.ServerShutdown = config.ServerShutdown
@@ -11,11 +11,14 @@
 //	Version: 1.1.18
 //
 //	Consumes:
+//	  - text/csv
 //	  - application/json
+//	  - text/plain
 //
 //	Produces:
 //	  - application/octet-stream
 //	  - application/json
+//	  - text/event-stream
 //
 // swagger:meta
 package restapi
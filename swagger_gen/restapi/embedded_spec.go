@@ -35,203 +35,47 @@ func init() {
   },
   "basePath": "/api/v1",
   "paths": {
-    "/evaluation": {
-      "post": {
-        "tags": [
-          "evaluation"
-        ],
-        "operationId": "postEvaluation",
-        "parameters": [
-          {
-            "description": "evalution context",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/evalContext"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "evaluation result",
-            "schema": {
-              "$ref": "#/definitions/evalResult"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/evaluation/batch": {
-      "post": {
-        "tags": [
-          "evaluation"
-        ],
-        "operationId": "postEvaluationBatch",
-        "parameters": [
-          {
-            "description": "evalution batch request",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/evaluationBatchRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "evaluation batch result",
-            "schema": {
-              "$ref": "#/definitions/evaluationBatchResponse"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/export/eval_cache/json": {
-      "get": {
-        "description": "Export JSON format of the eval cache dump",
-        "produces": [
-          "application/json"
-        ],
-        "tags": [
-          "export"
-        ],
-        "operationId": "getExportEvalCacheJSON",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "object"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/export/sqlite": {
-      "get": {
-        "description": "Export sqlite3 format of the db dump, which is converted from the main database.",
-        "produces": [
-          "application/octet-stream"
-        ],
-        "tags": [
-          "export"
-        ],
-        "operationId": "getExportSqlite",
-        "parameters": [
-          {
-            "type": "boolean",
-            "description": "export without snapshots data - useful for smaller db without snapshots\n",
-            "name": "exclude_snapshots",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "file"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/flags": {
+    "/audiences": {
       "get": {
         "tags": [
-          "flag"
+          "audience"
         ],
-        "operationId": "findFlags",
+        "operationId": "findAudiences",
         "parameters": [
           {
             "type": "integer",
             "format": "int64",
-            "description": "the numbers of flags to return",
+            "description": "the numbers of audiences to return",
             "name": "limit",
             "in": "query"
           },
-          {
-            "type": "boolean",
-            "description": "return flags having given enabled status",
-            "name": "enabled",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return flags exactly matching given description",
-            "name": "description",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return flags with the given tags (comma separated)",
-            "name": "tags",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return flags partially matching given description",
-            "name": "description_like",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return flags matching given key",
-            "name": "key",
-            "in": "query"
-          },
           {
             "type": "integer",
             "format": "int64",
-            "description": "return flags given the offset, it should usually set together with limit",
+            "description": "return audiences given the offset, it should usually set together with limit",
             "name": "offset",
             "in": "query"
           },
           {
-            "type": "boolean",
-            "description": "return flags with preloaded segments and variants",
-            "name": "preload",
+            "type": "string",
+            "description": "return audiences matching given key",
+            "name": "key",
             "in": "query"
           },
           {
-            "type": "boolean",
-            "description": "return all deleted flags",
-            "name": "deleted",
+            "type": "string",
+            "description": "return audiences partially matching given description",
+            "name": "description_like",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "list all the flags",
+            "description": "list all the audiences",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/flag"
+                "$ref": "#/definitions/audience"
               }
             }
           },
@@ -245,51 +89,25 @@ func init() {
       },
       "post": {
         "tags": [
-          "flag"
+          "audience"
         ],
-        "operationId": "createFlag",
+        "operationId": "createAudience",
         "parameters": [
           {
-            "description": "create a flag",
+            "description": "create an audience",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createFlagRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "returns the created flag",
-            "schema": {
-              "$ref": "#/definitions/flag"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/createAudienceRequest"
             }
           }
-        }
-      }
-    },
-    "/flags/entity_types": {
-      "get": {
-        "tags": [
-          "flag"
         ],
-        "operationId": "getFlagEntityTypes",
         "responses": {
           "200": {
-            "description": "returns all the FlagEntityTypes",
+            "description": "returns the created audience",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string",
-                "minLength": 1
-              }
+              "$ref": "#/definitions/audience"
             }
           },
           "default": {
@@ -301,28 +119,28 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}": {
+    "/audiences/{audienceID}": {
       "get": {
         "tags": [
-          "flag"
+          "audience"
         ],
-        "operationId": "getFlag",
+        "operationId": "getAudience",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
+            "description": "numeric ID of the audience to get",
+            "name": "audienceID",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
+            "description": "returns the audience",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "$ref": "#/definitions/audience"
             }
           },
           "default": {
@@ -335,34 +153,34 @@ func init() {
       },
       "put": {
         "tags": [
-          "flag"
+          "audience"
         ],
-        "operationId": "putFlag",
+        "operationId": "putAudience",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
             "in": "path",
             "required": true
           },
           {
-            "description": "update a flag",
+            "description": "update an audience",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putFlagRequest"
+              "$ref": "#/definitions/putAudienceRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
+            "description": "returns the audience",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "$ref": "#/definitions/audience"
             }
           },
           "default": {
@@ -375,16 +193,16 @@ func init() {
       },
       "delete": {
         "tags": [
-          "flag"
+          "audience"
         ],
-        "operationId": "deleteFlag",
+        "operationId": "deleteAudience",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
             "in": "path",
             "required": true
           }
@@ -402,37 +220,32 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/enabled": {
-      "put": {
+    "/audiences/{audienceID}/flags": {
+      "get": {
+        "description": "Find the flags that reference the audience in any of their segments",
         "tags": [
-          "flag"
+          "audience"
         ],
-        "operationId": "setFlagEnabled",
+        "operationId": "findAudienceFlags",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "set flag enabled state",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setFlagEnabledRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
+            "description": "list the flags using the audience",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/flag"
+              }
             }
           },
           "default": {
@@ -444,28 +257,28 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/restore": {
-      "put": {
+    "/evaluation": {
+      "post": {
         "tags": [
-          "flag"
+          "evaluation"
         ],
-        "operationId": "restoreFlag",
+        "operationId": "postEvaluation",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+            "description": "evalution context",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evalContext"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
+            "description": "evaluation result",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "$ref": "#/definitions/evalResult"
             }
           },
           "default": {
@@ -477,31 +290,28 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments": {
-      "get": {
+    "/evaluation/batch": {
+      "post": {
         "tags": [
-          "segment"
+          "evaluation"
         ],
-        "operationId": "findSegments",
+        "operationId": "postEvaluationBatch",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+            "description": "evalution batch request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evaluationBatchRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "segments ordered by rank of the flag",
+            "description": "evaluation batch result",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/segment"
-              }
+              "$ref": "#/definitions/evaluationBatchResponse"
             }
           },
           "default": {
@@ -511,37 +321,31 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/evaluation/bootstrap": {
       "post": {
+        "description": "evaluates all the enabled flags, or the ones filtered by flagTags or clientVisibleOnly, for the entity, e.g. to bootstrap the flags of a frontend at page load. The response is escaped to be embedded in HTML.",
         "tags": [
-          "segment"
+          "evaluation"
         ],
-        "operationId": "createSegment",
+        "operationId": "postEvaluationBootstrap",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a segment under a flag",
+            "description": "evaluation bootstrap request",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createSegmentRequest"
+              "$ref": "#/definitions/evaluationBootstrapRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "segment created",
+            "description": "the assignments of the flags keyed by flagKey",
             "schema": {
-              "$ref": "#/definitions/segment"
+              "$ref": "#/definitions/evaluationBootstrapResponse"
             }
           },
           "default": {
@@ -553,35 +357,56 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/reorder": {
-      "put": {
+    "/evaluation/changes": {
+      "get": {
+        "description": "streams the changes of the flags as server-sent events, e.g. for SDKs and relay instances to refresh the changed flags as soon as the evaluation cache picks them up, instead of polling all the flags. Each message is a flag_changes event with the data of flagChanges. A comment line is sent as the heartbeat when there are no changes. The request needs the \"Accept: text/event-stream\" header.",
+        "produces": [
+          "text/event-stream"
+        ],
         "tags": [
-          "segment"
+          "evaluation"
         ],
-        "operationId": "putSegmentsReorder",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+        "operationId": "getEvaluationChanges",
+        "responses": {
+          "200": {
+            "description": "the stream of flag_changes events",
+            "schema": {
+              "$ref": "#/definitions/flagChanges"
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/evaluation/dry_run": {
+      "post": {
+        "description": "evaluates an unsaved flag definition with the entities, without saving the flag or recording the results. The debug logs are always included.",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationDryRun",
+        "parameters": [
           {
-            "description": "reorder segments",
+            "description": "evaluation dry run request",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putSegmentReorderRequest"
+              "$ref": "#/definitions/evaluationDryRunRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "segments reordered"
+            "description": "evaluation dry run result",
+            "schema": {
+              "$ref": "#/definitions/evaluationDryRunResponse"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -592,46 +417,29 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}": {
-      "put": {
+    "/evaluation/simulation": {
+      "post": {
+        "description": "simulates the rollout of a flag definition over a sample of entities, e.g. to preview how a change of the distributions or the constraints would split the population before saving it. It reports how many entities land in each segment and variant, and how many of them would get a different variant than from the live flag. Nothing is saved, and the results are not logged or recorded.",
         "tags": [
-          "segment"
+          "evaluation"
         ],
-        "operationId": "putSegment",
+        "operationId": "postEvaluationSimulation",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "update a segment",
+            "description": "evaluation simulation request",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putSegmentRequest"
+              "$ref": "#/definitions/evaluationSimulationRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "segment updated",
+            "description": "evaluation simulation result",
             "schema": {
-              "$ref": "#/definitions/segment"
+              "$ref": "#/definitions/evaluationSimulationResponse"
             }
           },
           "default": {
@@ -641,35 +449,32 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/evaluation/snapshot": {
+      "post": {
+        "description": "evaluates a historical snapshot of the flag with the entities, i.e. what the entities would have got at that time. The results are not logged or recorded. It's not available in the eval only mode.",
         "tags": [
-          "segment"
+          "evaluation"
         ],
-        "operationId": "deleteSegment",
+        "operationId": "postEvaluationSnapshot",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
+            "description": "evaluation snapshot request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evaluationSnapshotRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "deleted"
+            "description": "evaluation snapshot result",
+            "schema": {
+              "$ref": "#/definitions/evaluationSnapshotResponse"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -680,40 +485,21 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/constraints": {
+    "/export/eval_cache/json": {
       "get": {
-        "tags": [
-          "constraint"
+        "description": "Export JSON format of the eval cache dump",
+        "produces": [
+          "application/json"
         ],
-        "operationId": "findConstraints",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          }
+        "tags": [
+          "export"
         ],
+        "operationId": "getExportEvalCacheJSON",
         "responses": {
           "200": {
-            "description": "constraints under the segment",
+            "description": "OK",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/constraint"
-              }
+              "type": "object"
             }
           },
           "default": {
@@ -723,46 +509,31 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/export/sqlite": {
+      "get": {
+        "description": "Export sqlite3 format of the db dump, which is converted from the main database.",
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "constraint"
+          "export"
         ],
-        "operationId": "createConstraint",
+        "operationId": "getExportSqlite",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a constraint",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createConstraintRequest"
-            }
+            "type": "boolean",
+            "description": "export without snapshots data - useful for smaller db without snapshots\n",
+            "name": "exclude_snapshots",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "the constraint created",
+            "description": "OK",
             "schema": {
-              "$ref": "#/definitions/constraint"
+              "type": "file"
             }
           },
           "default": {
@@ -774,55 +545,78 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}": {
-      "put": {
+    "/flags": {
+      "get": {
         "tags": [
-          "constraint"
+          "flag"
         ],
-        "operationId": "putConstraint",
+        "operationId": "findFlags",
         "parameters": [
           {
-            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+            "description": "the numbers of flags to return",
+            "name": "limit",
+            "in": "query"
           },
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
+            "type": "boolean",
+            "description": "return flags having given enabled status",
+            "name": "enabled",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags exactly matching given description",
+            "name": "description",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags with the given tags (comma separated)",
+            "name": "tags",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags partially matching given description",
+            "name": "description_like",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags matching given key",
+            "name": "key",
+            "in": "query"
           },
           {
-            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the constraint",
-            "name": "constraintID",
-            "in": "path",
-            "required": true
+            "description": "return flags given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
           },
           {
-            "description": "create a constraint",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createConstraintRequest"
-            }
+            "type": "boolean",
+            "description": "return flags with preloaded segments and variants",
+            "name": "preload",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return all deleted flags",
+            "name": "deleted",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "constraint just updated",
+            "description": "list all the flags",
             "schema": {
-              "$ref": "#/definitions/constraint"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/flag"
+              }
             }
           },
           "default": {
@@ -833,43 +627,54 @@ func init() {
           }
         }
       },
-      "delete": {
+      "post": {
         "tags": [
-          "constraint"
+          "flag"
         ],
-        "operationId": "deleteConstraint",
+        "operationId": "createFlag",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
+            "description": "create a flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createFlagRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the created flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
           },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the constraint",
-            "name": "constraintID",
-            "in": "path",
-            "required": true
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
+        }
+      }
+    },
+    "/flags/entity_types": {
+      "get": {
+        "tags": [
+          "flag"
         ],
+        "operationId": "getFlagEntityTypes",
         "responses": {
           "200": {
-            "description": "deleted"
+            "description": "returns all the FlagEntityTypes",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string",
+                "minLength": 1
+              }
+            }
           },
           "default": {
             "description": "generic error response",
@@ -880,40 +685,28 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/distributions": {
+    "/flags/{flagID}": {
       "get": {
         "tags": [
-          "distribution"
+          "flag"
         ],
-        "operationId": "findDistributions",
+        "operationId": "getFlag",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
+            "description": "numeric ID of the flag to get",
             "name": "flagID",
             "in": "path",
             "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "distribution under the segment",
+            "description": "returns the flag",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/distribution"
-              }
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -925,48 +718,36 @@ func init() {
         }
       },
       "put": {
-        "description": "replace the distribution with the new setting",
+        "description": "Update the flag. Changing the key fails if any segment of the other flags requires the flag as a prerequisite.",
         "tags": [
-          "distribution"
+          "flag"
         ],
-        "operationId": "putDistributions",
+        "operationId": "putFlag",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
+            "description": "numeric ID of the flag to get",
             "name": "flagID",
             "in": "path",
             "required": true
           },
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "array of distributions",
+            "description": "update a flag",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putDistributionsRequest"
+              "$ref": "#/definitions/putFlagRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "distribution under the segment",
+            "description": "returns the flag",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/distribution"
-              }
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -976,58 +757,27 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/flags/{flagID}/snapshots": {
-      "get": {
+      },
+      "delete": {
+        "description": "Delete the flag. It fails if any segment of the other flags requires the flag as a prerequisite.",
         "tags": [
           "flag"
         ],
-        "operationId": "getFlagSnapshots",
+        "operationId": "deleteFlag",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "the number of snapshots to return",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "return snapshots given the offset, it should usually set together with limit",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "ASC",
-              "DESC"
-            ],
-            "type": "string",
-            "description": "sort order",
-            "name": "sort",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag snapshots",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/flagSnapshot"
-              }
-            }
+            "description": "OK deleted"
           },
           "default": {
             "description": "generic error response",
@@ -1038,12 +788,13 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/tags": {
-      "get": {
+    "/flags/{flagID}/assignment_salt/reset": {
+      "post": {
+        "description": "resets the assignment salt of the flag, so that all the sticky assignments of the flag are dropped and the entities get the variants of the current segments and distributions again",
         "tags": [
-          "tag"
+          "flag"
         ],
-        "operationId": "findTags",
+        "operationId": "resetFlagAssignmentSalt",
         "parameters": [
           {
             "minimum": 1,
@@ -1057,12 +808,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "tag ordered by tagID",
+            "description": "returns the flag",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/tag"
-              }
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -1072,37 +820,39 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/flags/{flagID}/enabled": {
+      "put": {
         "tags": [
-          "tag"
+          "flag"
         ],
-        "operationId": "createTag",
+        "operationId": "setFlagEnabled",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
+            "description": "numeric ID of the flag to get",
             "name": "flagID",
             "in": "path",
             "required": true
           },
           {
-            "description": "create a tag",
+            "description": "set flag enabled state",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createTagRequest"
+              "$ref": "#/definitions/setFlagEnabledRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "tag just created",
+            "description": "returns the flag",
             "schema": {
-              "$ref": "#/definitions/tag"
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -1114,12 +864,12 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/tags/{tagID}": {
-      "delete": {
+    "/flags/{flagID}/overrides": {
+      "get": {
         "tags": [
-          "tag"
+          "variantOverride"
         ],
-        "operationId": "deleteTag",
+        "operationId": "findVariantOverrides",
         "parameters": [
           {
             "minimum": 1,
@@ -1129,20 +879,58 @@ func init() {
             "name": "flagID",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant overrides of the flag",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/variantOverride"
+              }
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Pin the entity to the variant regardless of the segments and the rollout. An entity can only have one override per entityType of the flag.\n",
+        "tags": [
+          "variantOverride"
+        ],
+        "operationId": "createVariantOverride",
+        "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the tag",
-            "name": "tagID",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "create a variant override",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createVariantOverrideRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "deleted"
+            "description": "variant override just created",
+            "schema": {
+              "$ref": "#/definitions/variantOverride"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -1153,12 +941,12 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/variants": {
-      "get": {
+    "/flags/{flagID}/overrides/{variantOverrideID}": {
+      "delete": {
         "tags": [
-          "variant"
+          "variantOverride"
         ],
-        "operationId": "findVariants",
+        "operationId": "deleteVariantOverride",
         "parameters": [
           {
             "minimum": 1,
@@ -1168,17 +956,20 @@ func init() {
             "name": "flagID",
             "in": "path",
             "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the variant override",
+            "name": "variantOverrideID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "variant ordered by variantID",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/variant"
-              }
-            }
+            "description": "OK deleted"
           },
           "default": {
             "description": "generic error response",
@@ -1187,37 +978,30 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/flags/{flagID}/restore": {
+      "put": {
         "tags": [
-          "variant"
+          "flag"
         ],
-        "operationId": "createVariant",
+        "operationId": "restoreFlag",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
+            "description": "numeric ID of the flag to get",
             "name": "flagID",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "create a variant",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createVariantRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "variant just created",
+            "description": "returns the flag",
             "schema": {
-              "$ref": "#/definitions/variant"
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -1229,12 +1013,12 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/variants/{variantID}": {
-      "put": {
+    "/flags/{flagID}/scheduled_changes": {
+      "get": {
         "tags": [
-          "variant"
+          "scheduledChange"
         ],
-        "operationId": "putVariant",
+        "operationId": "findScheduledChanges",
         "parameters": [
           {
             "minimum": 1,
@@ -1245,30 +1029,67 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "enum": [
+              "PENDING",
+              "APPLIED",
+              "FAILED",
+              "CANCELED"
+            ],
+            "type": "string",
+            "description": "return scheduled changes with the given status",
+            "name": "status",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "scheduled changes of the flag ordered by executeAt",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/scheduledChange"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "scheduledChange"
+        ],
+        "operationId": "createScheduledChange",
+        "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the variant",
-            "name": "variantID",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
             "in": "path",
             "required": true
           },
           {
-            "description": "update a variant",
+            "description": "create a change of the flag to apply at executeAt",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putVariantRequest"
+              "$ref": "#/definitions/createScheduledChangeRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "variant just updated",
+            "description": "the scheduled change created",
             "schema": {
-              "$ref": "#/definitions/variant"
+              "$ref": "#/definitions/scheduledChange"
             }
           },
           "default": {
@@ -1278,12 +1099,15 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/flags/{flagID}/scheduled_changes/{scheduledChangeID}": {
       "delete": {
+        "description": "Cancel the scheduled change, only a pending one can be canceled",
         "tags": [
-          "variant"
+          "scheduledChange"
         ],
-        "operationId": "deleteVariant",
+        "operationId": "cancelScheduledChange",
         "parameters": [
           {
             "minimum": 1,
@@ -1298,15 +1122,18 @@ func init() {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the variant",
-            "name": "variantID",
+            "description": "numeric ID of the scheduled change",
+            "name": "scheduledChangeID",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "deleted"
+            "description": "the canceled scheduled change",
+            "schema": {
+              "$ref": "#/definitions/scheduledChange"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -1317,18 +1144,31 @@ func init() {
         }
       }
     },
-    "/health": {
+    "/flags/{flagID}/segments": {
       "get": {
-        "description": "Check if Flagr is healthy",
         "tags": [
-          "health"
+          "segment"
+        ],
+        "operationId": "findSegments",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "getHealth",
         "responses": {
           "200": {
-            "description": "status of health check",
+            "description": "segments ordered by rank of the flag",
             "schema": {
-              "$ref": "#/definitions/health"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/segment"
+              }
             }
           },
           "default": {
@@ -1338,45 +1178,77 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/tags": {
-      "get": {
+      },
+      "post": {
         "tags": [
-          "tag"
+          "segment"
         ],
-        "operationId": "findAllTags",
+        "operationId": "createSegment",
         "parameters": [
           {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "the numbers of tags to return",
-            "name": "limit",
-            "in": "query"
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a segment under a flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createSegmentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segment created",
+            "schema": {
+              "$ref": "#/definitions/segment"
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/reorder": {
+      "put": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "putSegmentsReorder",
+        "parameters": [
           {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "return tags given the offset, it should usually set together with limit",
-            "name": "offset",
-            "in": "query"
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "return tags partially matching given value",
-            "name": "value_like",
-            "in": "query"
+            "description": "reorder segments",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putSegmentReorderRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "list all the tags",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/tag"
-              }
-            }
+            "description": "segments reordered"
           },
           "default": {
             "description": "generic error response",
@@ -1386,124 +1258,3266 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "constraint": {
-      "type": "object",
-      "required": [
+    },
+    "/flags/{flagID}/segments/{segmentID}": {
+      "put": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "putSegment",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a segment",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putSegmentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segment updated",
+            "schema": {
+              "$ref": "#/definitions/segment"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "deleteSegment",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/constraints": {
+      "get": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "findConstraints",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "constraints under the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/constraint"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "createConstraint",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a constraint",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createConstraintRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the constraint created",
+            "schema": {
+              "$ref": "#/definitions/constraint"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}": {
+      "put": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "putConstraint",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the constraint",
+            "name": "constraintID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a constraint",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createConstraintRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "constraint just updated",
+            "schema": {
+              "$ref": "#/definitions/constraint"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "deleteConstraint",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the constraint",
+            "name": "constraintID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/distributions": {
+      "get": {
+        "tags": [
+          "distribution"
+        ],
+        "operationId": "findDistributions",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "distribution under the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/distribution"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "replace the distribution with the new setting",
+        "tags": [
+          "distribution"
+        ],
+        "operationId": "putDistributions",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "array of distributions",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putDistributionsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "distribution under the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/distribution"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/rollout_ramps": {
+      "get": {
+        "tags": [
+          "rolloutRamp"
+        ],
+        "operationId": "findRolloutRamps",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "rollout ramps of the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/rolloutRamp"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Ramp the rollout percent of the segment through the steps automatically. A segment can only have one active or paused rollout ramp.\n",
+        "tags": [
+          "rolloutRamp"
+        ],
+        "operationId": "createRolloutRamp",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a rollout ramp",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createRolloutRampRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the rollout ramp",
+            "schema": {
+              "$ref": "#/definitions/rolloutRamp"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/rollout_ramps/{rolloutRampID}/abort": {
+      "put": {
+        "description": "Abort the active or paused rollout ramp, the rollout percent of the segment stays at the current step",
+        "tags": [
+          "rolloutRamp"
+        ],
+        "operationId": "abortRolloutRamp",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the rollout ramp",
+            "name": "rolloutRampID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the rollout ramp",
+            "schema": {
+              "$ref": "#/definitions/rolloutRamp"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/rollout_ramps/{rolloutRampID}/pause": {
+      "put": {
+        "description": "Pause the active rollout ramp, the time left until the next step is kept for resuming",
+        "tags": [
+          "rolloutRamp"
+        ],
+        "operationId": "pauseRolloutRamp",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the rollout ramp",
+            "name": "rolloutRampID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the rollout ramp",
+            "schema": {
+              "$ref": "#/definitions/rolloutRamp"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/rollout_ramps/{rolloutRampID}/resume": {
+      "put": {
+        "description": "Resume the paused rollout ramp",
+        "tags": [
+          "rolloutRamp"
+        ],
+        "operationId": "resumeRolloutRamp",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the rollout ramp",
+            "name": "rolloutRampID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the rollout ramp",
+            "schema": {
+              "$ref": "#/definitions/rolloutRamp"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/snapshots": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlagSnapshots",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the number of snapshots to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return snapshots given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ASC",
+              "DESC"
+            ],
+            "type": "string",
+            "description": "sort order",
+            "name": "sort",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag snapshots",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/flagSnapshot"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/tags": {
+      "get": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "findTags",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "tag ordered by tagID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/tag"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "createTag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a tag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createTagRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "tag just created",
+            "schema": {
+              "$ref": "#/definitions/tag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/tags/{tagID}": {
+      "delete": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "deleteTag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the tag",
+            "name": "tagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/variants": {
+      "get": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "findVariants",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant ordered by variantID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/variant"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "createVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a variant",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createVariantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant just created",
+            "schema": {
+              "$ref": "#/definitions/variant"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/variants/{variantID}": {
+      "put": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "putVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the variant",
+            "name": "variantID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a variant",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putVariantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant just updated",
+            "schema": {
+              "$ref": "#/definitions/variant"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "deleteVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the variant",
+            "name": "variantID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/health": {
+      "get": {
+        "description": "Check if Flagr is healthy",
+        "tags": [
+          "health"
+        ],
+        "operationId": "getHealth",
+        "responses": {
+          "200": {
+            "description": "status of health check",
+            "schema": {
+              "$ref": "#/definitions/health"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/idlists": {
+      "get": {
+        "tags": [
+          "idList"
+        ],
+        "operationId": "findIDLists",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of ID lists to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return ID lists given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return ID lists matching given key",
+            "name": "key",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list all the ID lists",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/idList"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "idList"
+        ],
+        "operationId": "createIDList",
+        "parameters": [
+          {
+            "description": "create an ID list",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createIDListRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the created ID list",
+            "schema": {
+              "$ref": "#/definitions/idList"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/idlists/{idListID}": {
+      "get": {
+        "tags": [
+          "idList"
+        ],
+        "operationId": "getIDList",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list to get",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the ID list",
+            "schema": {
+              "$ref": "#/definitions/idList"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete the ID list and its entries. It fails if any constraint still references the ID list.",
+        "tags": [
+          "idList"
+        ],
+        "operationId": "deleteIDList",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/idlists/{idListID}/entries": {
+      "put": {
+        "description": "Upload the entries of the ID list, replacing all the existing entries. The body is either CSV or one entry per line.",
+        "consumes": [
+          "text/plain",
+          "text/csv"
+        ],
+        "tags": [
+          "idList"
+        ],
+        "operationId": "replaceIDListEntries",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "the entries to upload",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the ID list",
+            "schema": {
+              "$ref": "#/definitions/idList"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Append the entries to the ID list, the existing entries are kept. The body is either CSV or one entry per line.",
+        "consumes": [
+          "text/plain",
+          "text/csv"
+        ],
+        "tags": [
+          "idList"
+        ],
+        "operationId": "appendIDListEntries",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "the entries to append",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the ID list",
+            "schema": {
+              "$ref": "#/definitions/idList"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Remove the entries from the ID list. The body is either CSV or one entry per line.",
+        "consumes": [
+          "text/plain",
+          "text/csv"
+        ],
+        "tags": [
+          "idList"
+        ],
+        "operationId": "removeIDListEntries",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "the entries to remove",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the ID list",
+            "schema": {
+              "$ref": "#/definitions/idList"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/ofrep/v1/evaluate/flags": {
+      "post": {
+        "description": "evaluates all the enabled and client visible flags for the context with the OpenFeature Remote Evaluation Protocol (OFREP), e.g. for the OpenFeature SDKs of the static context paradigm to cache the flags. The ETag of the response is the hash of the results, and the request with a matching If-None-Match header gets 304. The results are not logged or recorded as data records.",
+        "tags": [
+          "ofrep"
+        ],
+        "operationId": "postOfrepEvaluateFlags",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "the ETag of the results the client has",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "description": "OFREP evaluation request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ofrepEvaluationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the evaluation results of the flags",
+            "schema": {
+              "$ref": "#/definitions/ofrepBulkEvaluationResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string"
+              }
+            }
+          },
+          "304": {
+            "description": "the results are the same as the ones of the If-None-Match header"
+          },
+          "400": {
+            "description": "the evaluation failed, e.g. an invalid context",
+            "schema": {
+              "$ref": "#/definitions/ofrepBulkEvaluationFailure"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/ofrep/v1/evaluate/flags/{key}": {
+      "post": {
+        "description": "evaluates the flag for the context with the OpenFeature Remote Evaluation Protocol (OFREP), so that any OpenFeature SDK with the OFREP provider can evaluate the flags of flagr. The base URL of the provider is the base path of the APIs, e.g. http://localhost:18000/api/v1. Only the client visible flags are evaluated, the others are not found.",
+        "tags": [
+          "ofrep"
+        ],
+        "operationId": "postOfrepEvaluateFlag",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "the flag key",
+            "name": "key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "OFREP evaluation request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ofrepEvaluationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the evaluation result of the flag",
+            "schema": {
+              "$ref": "#/definitions/ofrepEvaluationResult"
+            }
+          },
+          "400": {
+            "description": "the evaluation failed, e.g. an invalid context or a variant value mismatching the flag's valueType",
+            "schema": {
+              "$ref": "#/definitions/ofrepEvaluationResult"
+            }
+          },
+          "404": {
+            "description": "the flag is not found or not client visible",
+            "schema": {
+              "$ref": "#/definitions/ofrepEvaluationResult"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/tags": {
+      "get": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "findAllTags",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of tags to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return tags given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return tags partially matching given value",
+            "name": "value_like",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list all the tags",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/tag"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "audience": {
+      "type": "object",
+      "required": [
+        "key",
+        "description"
+      ],
+      "properties": {
+        "constraintGroups": {
+          "description": "groups of constraints with AND/OR/NOT nesting, they are joined with AND together with the constraints",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraint"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "constraint": {
+      "type": "object",
+      "required": [
+        "property",
+        "operator",
+        "value"
+      ],
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "operator": {
+          "type": "string",
+          "minLength": 1,
+          "enum": [
+            "EQ",
+            "NEQ",
+            "LT",
+            "LTE",
+            "GT",
+            "GTE",
+            "EREG",
+            "NEREG",
+            "IN",
+            "NOTIN",
+            "CONTAINS",
+            "NOTCONTAINS",
+            "SEMVER_EQ",
+            "SEMVER_GT",
+            "SEMVER_GTE",
+            "SEMVER_LT",
+            "SEMVER_LTE",
+            "BEFORE",
+            "AFTER",
+            "BETWEEN",
+            "IN_LIST",
+            "NOT_IN_LIST"
+          ]
+        },
+        "property": {
+          "type": "string",
+          "minLength": 1
+        },
+        "value": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "constraintGroup": {
+      "description": "a group of constraints and nested groups joined with the operator, the result is negated if negate is true",
+      "type": "object",
+      "required": [
+        "operator"
+      ],
+      "properties": {
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
+          }
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "negate": {
+          "type": "boolean"
+        },
+        "operator": {
+          "type": "string",
+          "enum": [
+            "AND",
+            "OR"
+          ]
+        }
+      }
+    },
+    "constraintTrace": {
+      "type": "object",
+      "properties": {
+        "actual": {
+          "description": "the value of the property in the entity context",
+          "x-nullable": true
+        },
+        "audienceKey": {
+          "description": "the key of the audience of the constraint, empty for the constraints of the segment",
+          "type": "string"
+        },
+        "group": {
+          "description": "the path of the constraint group of the constraint, e.g. constraintGroups[0].groups[1], empty for the flat constraints",
+          "type": "string"
+        },
+        "msg": {
+          "type": "string"
+        },
+        "operator": {
+          "type": "string"
+        },
+        "property": {
+          "type": "string"
+        },
+        "result": {
+          "description": "MISSING_PROPERTY if the property is missing in the entity context, and WRONG_TYPE if the property cannot be evaluated by the operator, e.g. a string compared with a number",
+          "type": "string",
+          "enum": [
+            "MATCH",
+            "NOT_MATCH",
+            "MISSING_PROPERTY",
+            "WRONG_TYPE",
+            "ERROR"
+          ]
+        },
+        "value": {
+          "description": "the expected value of the constraint",
+          "type": "string"
+        }
+      }
+    },
+    "createAudienceRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "constraintGroups": {
+          "description": "groups of constraints with AND/OR/NOT nesting, they are joined with AND together with the constraints",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createConstraintRequest": {
+      "type": "object",
+      "required": [
         "property",
         "operator",
         "value"
       ],
       "properties": {
+        "operator": {
+          "type": "string",
+          "minLength": 1
+        },
+        "property": {
+          "type": "string",
+          "minLength": 1
+        },
+        "value": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createFlagRequest": {
+      "type": "object",
+      "required": [
+        "description"
+      ],
+      "properties": {
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "key": {
+          "description": "unique key representation of the flag",
+          "type": "string"
+        },
+        "template": {
+          "description": "template for flag creation",
+          "type": "string"
+        }
+      }
+    },
+    "createIDListRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the ID list",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createRolloutRampRequest": {
+      "type": "object",
+      "required": [
+        "steps",
+        "stepIntervalSeconds"
+      ],
+      "properties": {
+        "startAt": {
+          "description": "when to apply the first step, it's applied right away if it's not set",
+          "type": "string",
+          "format": "date-time"
+        },
+        "stepIntervalSeconds": {
+          "description": "the time between two steps",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "steps": {
+          "description": "the increasing rollout percents of the steps, e.g. [1, 5, 25, 50, 100]",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "number",
+            "format": "double",
+            "maximum": 100
+          }
+        }
+      }
+    },
+    "createScheduledChangeRequest": {
+      "type": "object",
+      "required": [
+        "executeAt",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "ENABLE_FLAG",
+            "DISABLE_FLAG",
+            "SET_ROLLOUT_PERCENT",
+            "SET_DISTRIBUTIONS"
+          ]
+        },
+        "distributions": {
+          "description": "the distributions of SET_DISTRIBUTIONS",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/distribution"
+          }
+        },
+        "executeAt": {
+          "description": "when to apply the change, it needs to be in the future",
+          "type": "string",
+          "format": "date-time"
+        },
+        "rolloutPercent": {
+          "description": "the rollout percent of SET_ROLLOUT_PERCENT",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "x-nullable": true
+        },
+        "segmentID": {
+          "description": "the segment of SET_ROLLOUT_PERCENT and SET_DISTRIBUTIONS",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "createSegmentRequest": {
+      "type": "object",
+      "required": [
+        "description",
+        "rolloutPercent"
+      ],
+      "properties": {
+        "audienceIDs": {
+          "description": "the audiences the entity needs to be in, together with the constraints of the segment",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        },
+        "bucketBy": {
+          "description": "overrides the flag's bucketBy if it's not empty",
+          "type": "string"
+        },
+        "constraintGroups": {
+          "description": "groups of constraints with AND/OR/NOT nesting, they are joined with AND together with the constraints",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "defaultVariantID": {
+          "description": "the variant returned when the entity matches the segment but misses the rollout. 0 means no default variant.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "prerequisites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/prerequisite"
+          }
+        },
+        "rolloutPercent": {
+          "type": "number",
+          "format": "double",
+          "maximum": 100
+        }
+      }
+    },
+    "createTagRequest": {
+      "type": "object",
+      "required": [
+        "value"
+      ],
+      "properties": {
+        "value": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createVariantOverrideRequest": {
+      "type": "object",
+      "required": [
+        "entityID",
+        "variantID"
+      ],
+      "properties": {
+        "entityID": {
+          "type": "string",
+          "maxLength": 255,
+          "minLength": 1
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "createVariantRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "attachment": {
+          "type": "object"
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "distribution": {
+      "type": "object",
+      "required": [
+        "percent",
+        "variantID",
+        "variantKey"
+      ],
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "percent": {
+          "type": "number",
+          "format": "double",
+          "maximum": 100
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "message": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "evalContext": {
+      "type": "object",
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "description": "entityID is used to deterministically at random to evaluate the flag result. If it's empty, flagr will randomly generate one.",
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "flagID": {
+          "description": "flagID",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "description": "flagKey. flagID or flagKey will resolve to the same flag. Either works.",
+          "type": "string"
+        },
+        "flagTags": {
+          "description": "flagTags. flagTags looks up flags by tag. Either works.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "flagTagsOperator": {
+          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
+          "type": "string",
+          "default": "ANY",
+          "enum": [
+            "ANY",
+            "ALL"
+          ]
+        }
+      }
+    },
+    "evalDebugLog": {
+      "type": "object",
+      "properties": {
+        "msg": {
+          "type": "string"
+        },
+        "segmentDebugLogs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segmentDebugLog"
+          }
+        }
+      }
+    },
+    "evalResult": {
+      "type": "object",
+      "properties": {
+        "evalContext": {
+          "$ref": "#/definitions/evalContext"
+        },
+        "evalDebugLog": {
+          "$ref": "#/definitions/evalDebugLog"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "flagSnapshotID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagTags": {
+          "description": "flagTags. flagTags looks up flags by tag. Either works.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "reason": {
+          "description": "why the entity got the result, it's always set regardless of enableDebug. FLAG_NOT_FOUND, FLAG_DISABLED and NO_SEGMENTS are about the flag. NO_MATCH means the entity matched no segment, and ERROR means no segment matched and the evaluation of a segment failed, e.g. an invalid entityContext or a missing audience. ROLLOUT_EXCLUDED means the entity matched a segment but is not in its rollout. MATCHED means the entity got the variant of the matched segment, and OVERRIDE means the variant is from a variant override. STICKY means the variant is the sticky assignment of the entity.",
+          "type": "string",
+          "enum": [
+            "FLAG_NOT_FOUND",
+            "FLAG_DISABLED",
+            "NO_SEGMENTS",
+            "NO_MATCH",
+            "ROLLOUT_EXCLUDED",
+            "MATCHED",
+            "OVERRIDE",
+            "STICKY",
+            "ERROR"
+          ]
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "segmentRank": {
+          "description": "the rank of the matched segment, it's only set for MATCHED and ROLLOUT_EXCLUDED",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "timestamp": {
+          "type": "string"
+        },
+        "valueType": {
+          "description": "the declared value type of the flag, it's empty if the flag is untyped",
+          "type": "string"
+        },
+        "variantAttachment": {
+          "type": "object"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantKey": {
+          "type": "string"
+        }
+      }
+    },
+    "evaluationBatchRequest": {
+      "type": "object",
+      "required": [
+        "entities"
+      ],
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entities": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/evaluationEntity"
+          }
+        },
+        "flagIDs": {
+          "description": "flagIDs",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        },
+        "flagKeys": {
+          "description": "flagKeys. Either flagIDs, flagKeys or flagTags works. A flag selected multiple times is only evaluated once.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "flagTags": {
+          "description": "flagTags. Either flagIDs, flagKeys or flagTags works. A flag selected multiple times is only evaluated once.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "flagTagsOperator": {
+          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
+          "type": "string",
+          "default": "ANY",
+          "enum": [
+            "ANY",
+            "ALL"
+          ]
+        },
+        "responseFormat": {
+          "description": "LIST returns the flat list of evaluationResults. KEYED returns keyedEvaluationResults, the results keyed by entityID and then flagKey, which requires unique and non-empty entityIDs.",
+          "type": "string",
+          "default": "LIST",
+          "enum": [
+            "LIST",
+            "KEYED"
+          ]
+        }
+      }
+    },
+    "evaluationBatchResponse": {
+      "type": "object",
+      "required": [
+        "evaluationResults"
+      ],
+      "properties": {
+        "evaluationResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evalResult"
+          }
+        },
+        "keyedEvaluationResults": {
+          "description": "the results keyed by entityID and then flagKey if the responseFormat is KEYED",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object",
+            "additionalProperties": {
+              "$ref": "#/definitions/evalResult"
+            }
+          }
+        }
+      }
+    },
+    "evaluationBootstrapRequest": {
+      "type": "object",
+      "properties": {
+        "clientVisibleOnly": {
+          "description": "only evaluates the flags marked as clientVisible",
+          "type": "boolean"
+        },
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "flagTags": {
+          "description": "only evaluates the flags with the flagTags if it's not empty",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "flagTagsOperator": {
+          "description": "ANY evaluates the flags with any of the flagTags, and ALL evaluates the flags with all of them",
+          "type": "string",
+          "default": "ANY",
+          "enum": [
+            "ANY",
+            "ALL"
+          ]
+        }
+      }
+    },
+    "evaluationBootstrapResponse": {
+      "description": "the assignments keyed by flagKey",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/flagAssignment"
+      }
+    },
+    "evaluationDryRunRequest": {
+      "type": "object",
+      "required": [
+        "flag",
+        "entities"
+      ],
+      "properties": {
+        "entities": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/evaluationEntity"
+          }
+        },
+        "flag": {
+          "description": "the flag definition in the same format as the flag returned by GET /flags/{flagID}, including the IDs of the segments and the variants referenced by the distributions. The flag id is the salt of the rollouts, so it has to be set to get the same results as a saved flag. Audiences, ID lists and prerequisite flags are looked up from the saved ones.",
+          "type": "object"
+        }
+      }
+    },
+    "evaluationDryRunResponse": {
+      "type": "object",
+      "required": [
+        "evaluationResults"
+      ],
+      "properties": {
+        "evaluationResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evalResult"
+          }
+        }
+      }
+    },
+    "evaluationEntity": {
+      "type": "object",
+      "properties": {
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        }
+      }
+    },
+    "evaluationSimulationRequest": {
+      "type": "object",
+      "required": [
+        "sample"
+      ],
+      "properties": {
+        "flag": {
+          "description": "the current or proposed flag definition to simulate, in the same format as the flag of the evaluation dry run",
+          "type": "object"
+        },
+        "flagID": {
+          "description": "the ID of the live flag to compare with. It's the id of the flag definition if it's not set, and the live flag itself is simulated if there's no flag definition.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "sample": {
+          "description": "the sample of entities. Each line of JSONL is an evaluationEntity, e.g. {\"entityID\": \"1\", \"entityContext\": {\"state\": \"CA\"}}. The header of CSV has the entityID column, the optional entityType column, and the properties of the entityContext, whose numbers and booleans are parsed as JSON. Every entity needs the entityID, so that it's bucketed the same way as in production.",
+          "type": "string",
+          "minLength": 1
+        },
+        "sampleFormat": {
+          "type": "string",
+          "default": "JSONL",
+          "enum": [
+            "JSONL",
+            "CSV"
+          ]
+        }
+      }
+    },
+    "evaluationSimulationResponse": {
+      "type": "object",
+      "required": [
+        "total",
+        "reasons",
+        "segments",
+        "variants",
+        "noVariant",
+        "liveFlagFound",
+        "changed",
+        "transitions"
+      ],
+      "properties": {
+        "changed": {
+          "description": "the number of the entities getting a different variant key than from the live flag",
+          "type": "integer",
+          "format": "int64"
+        },
+        "liveFlagFound": {
+          "description": "the live flag is found in the evaluation cache, otherwise changed and transitions are empty",
+          "type": "boolean"
+        },
+        "noVariant": {
+          "description": "the number of the entities without a variant",
+          "type": "integer",
+          "format": "int64"
+        },
+        "reasons": {
+          "description": "the number of the entities keyed by the reason of their results",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "segments": {
+          "description": "the segments of the flag in the order of their ranks",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulationSegment"
+          }
+        },
+        "total": {
+          "description": "the number of the entities in the sample",
+          "type": "integer",
+          "format": "int64"
+        },
+        "transitions": {
+          "description": "the changes of the variant keys from the live flag, the most frequent first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulationTransition"
+          }
+        },
+        "variants": {
+          "description": "the variants of the flag",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulationVariant"
+          }
+        }
+      }
+    },
+    "evaluationSnapshotRequest": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "entities"
+      ],
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entities": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/evaluationEntity"
+          }
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagSnapshotID": {
+          "description": "the ID of the snapshot of the flag to evaluate. Either flagSnapshotID or timestamp works.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "timestamp": {
+          "description": "evaluates the latest snapshot of the flag saved at or before the timestamp, at the time of the timestamp",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        }
+      }
+    },
+    "evaluationSnapshotResponse": {
+      "type": "object",
+      "required": [
+        "flagSnapshotID",
+        "evaluatedAt",
+        "evaluationResults"
+      ],
+      "properties": {
+        "evaluatedAt": {
+          "description": "the time the entities are evaluated at, i.e. the timestamp of the request, or the time of the snapshot found by flagSnapshotID. The built-in now property of the constraints and the expiry of the overrides are evaluated at this time.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "evaluationResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evalResult"
+          }
+        },
+        "flagSnapshotID": {
+          "description": "the ID of the evaluated snapshot",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "warnings": {
+          "description": "the parts of the evaluation that are not historical, i.e. the audiences, the ID lists and the prerequisite flags referenced by the snapshot are the current ones",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "flag": {
+      "type": "object",
+      "required": [
+        "description",
+        "enabled",
+        "dataRecordsEnabled"
+      ],
+      "properties": {
+        "assignmentSalt": {
+          "description": "the salt of the sticky assignments, resetting it drops all the sticky assignments of the flag",
+          "type": "string",
+          "readOnly": true
+        },
+        "bucketBy": {
+          "description": "comma separated entityContext properties used as the bucketing key for rollouts, e.g. \"org_id\". If it's empty, entityID is used.",
+          "type": "string"
+        },
+        "clientVisible": {
+          "description": "client visible flags are evaluated by the bootstrap evaluation with clientVisibleOnly and by OFREP",
+          "type": "boolean"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "dataRecordsEnabled": {
+          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
+          "type": "boolean"
+        },
+        "defaultVariantID": {
+          "description": "the variant returned when the flag is disabled, has no segments, or no segment matches. 0 means no default variant.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "entityType": {
+          "description": "it will override the entityType in the evaluation logs if it's not empty",
+          "type": "string"
+        },
+        "hashAlgorithm": {
+          "description": "hash algorithm used for the consistent hashing of rollouts. crc32 is the default and it only supports 0.1% granularity of percents. Other algorithms support 0.01% (basis point) granularity.",
+          "type": "string",
+          "default": "crc32",
+          "enum": [
+            "crc32",
+            "murmur3",
+            "xxhash",
+            "sha1"
+          ]
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the flag",
+          "type": "string",
+          "minLength": 1
+        },
+        "notes": {
+          "description": "flag usage details in markdown format",
+          "type": "string"
+        },
+        "segments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segment"
+          }
+        },
+        "stickyAssignments": {
+          "description": "an entity keeps the variant it first gets from a segment, even if the segments or the distributions change, until the assignment salt is reset",
+          "type": "boolean"
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tag"
+          }
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "updatedBy": {
+          "type": "string"
+        },
+        "valueSchema": {
+          "description": "the optional JSON Schema that the flag value of the variants has to match",
+          "type": "object"
+        },
+        "valueType": {
+          "description": "the type of the flag value, one of boolean, string, number and object. The attachments of the variants of boolean, string and number flags hold the value in the \"value\" key, e.g. {\"value\": true}. The flag is untyped if it's empty.",
+          "type": "string"
+        },
+        "variants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/variant"
+          }
+        }
+      }
+    },
+    "flagAssignment": {
+      "type": "object",
+      "properties": {
+        "attachment": {
+          "type": "object"
+        },
+        "variantKey": {
+          "description": "empty if the flag has no variant for the entity",
+          "type": "string"
+        }
+      }
+    },
+    "flagChange": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "flagKey",
+        "flagSnapshotID",
+        "deleted"
+      ],
+      "properties": {
+        "deleted": {
+          "description": "the flag is deleted, or it's not in the evaluation cache anymore",
+          "type": "boolean"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "flagSnapshotID": {
+          "description": "the snapshot of the flag picked up by the evaluation cache",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "flagChanges": {
+      "type": "object",
+      "required": [
+        "flags"
+      ],
+      "properties": {
+        "audienceKeys": {
+          "description": "the keys of the audiences added, changed or deleted",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "flags": {
+          "description": "the changed flags, including the ones whose audiences or ID lists changed, which keep their flagSnapshotID",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/flagChange"
+          }
+        },
+        "idListKeys": {
+          "description": "the keys of the ID lists added, changed or deleted, e.g. their entries",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "flagSnapshot": {
+      "type": "object",
+      "required": [
+        "id",
+        "flag",
+        "updatedAt"
+      ],
+      "properties": {
+        "flag": {
+          "$ref": "#/definitions/flag"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "updatedAt": {
+          "type": "string",
+          "minLength": 1
+        },
+        "updatedBy": {
+          "type": "string"
+        }
+      }
+    },
+    "health": {
+      "type": "object",
+      "properties": {
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "idList": {
+      "type": "object",
+      "required": [
+        "key",
+        "description"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "entryCount": {
+          "description": "the number of entries in the ID list",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the ID list, which is the value of the IN_LIST and NOT_IN_LIST constraints",
+          "type": "string",
+          "minLength": 1
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "ofrepBulkEvaluationFailure": {
+      "type": "object",
+      "required": [
+        "errorCode"
+      ],
+      "properties": {
+        "errorCode": {
+          "type": "string",
+          "enum": [
+            "INVALID_CONTEXT",
+            "GENERAL"
+          ]
+        },
+        "errorDetails": {
+          "type": "string"
+        }
+      }
+    },
+    "ofrepBulkEvaluationResponse": {
+      "type": "object",
+      "required": [
+        "flags"
+      ],
+      "properties": {
+        "flags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ofrepEvaluationResult"
+          }
+        }
+      }
+    },
+    "ofrepEvaluationRequest": {
+      "type": "object",
+      "properties": {
+        "context": {
+          "description": "the evaluation context of OpenFeature. The targetingKey is the entityID, and the other attributes are the entityContext.",
+          "type": "object"
+        }
+      }
+    },
+    "ofrepEvaluationResult": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "errorCode": {
+          "description": "the OpenFeature error code if the evaluation failed",
+          "type": "string",
+          "enum": [
+            "FLAG_NOT_FOUND",
+            "TYPE_MISMATCH",
+            "INVALID_CONTEXT",
+            "GENERAL"
+          ]
+        },
+        "errorDetails": {
+          "type": "string"
+        },
+        "key": {
+          "description": "the flag key",
+          "type": "string"
+        },
+        "metadata": {
+          "description": "the flagID, flagSnapshotID, segmentID, variantID and flagrReason of the evaluation",
+          "type": "object"
+        },
+        "reason": {
+          "description": "the OpenFeature reason. TARGETING_MATCH means the entity got the variant of a matched segment or a variant override, and DEFAULT means it got a default variant. The flagr reason is in the metadata. A disabled flag is FLAG_NOT_FOUND, and a result without a variant is GENERAL, with the flagr reason in the errorDetails.",
+          "type": "string"
+        },
+        "value": {
+          "description": "the flag value of the variant. It's the value in the attachment for the boolean, string and number flags, and the attachment for the object flags. For the untyped flags, it's the value of an attachment with only the \"value\" key, the attachment if it's not empty, or the variant key otherwise."
+        },
+        "variant": {
+          "description": "the variant key, it's empty if the entity has no variant",
+          "type": "string"
+        }
+      }
+    },
+    "prerequisite": {
+      "description": "the entity needs to get one of the variantKeys of the flag with flagKey to match the segment",
+      "type": "object",
+      "required": [
+        "flagKey",
+        "variantKeys"
+      ],
+      "properties": {
+        "flagKey": {
+          "type": "string",
+          "minLength": 1
+        },
+        "variantKeys": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "putAudienceRequest": {
+      "type": "object",
+      "properties": {
+        "constraintGroups": {
+          "description": "constraint groups of the audience. If it's not set, the constraint groups stay the same.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "constraints": {
+          "description": "constraints of the audience. If it's not set, the constraints stay the same.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
+          }
+        },
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        }
+      }
+    },
+    "putDistributionsRequest": {
+      "type": "object",
+      "required": [
+        "distributions"
+      ],
+      "properties": {
+        "distributions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/distribution"
+          }
+        }
+      }
+    },
+    "putFlagRequest": {
+      "type": "object",
+      "properties": {
+        "bucketBy": {
+          "description": "comma separated entityContext properties used as the bucketing key for rollouts",
+          "type": "string",
+          "x-nullable": true
+        },
+        "clientVisible": {
+          "description": "client visible flags are evaluated by the bootstrap evaluation with clientVisibleOnly and by OFREP",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "dataRecordsEnabled": {
+          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "defaultVariantID": {
+          "description": "the variant returned when the flag is disabled, has no segments, or no segment matches. 0 clears it.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "enabled": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "entityType": {
+          "description": "it will overwrite entityType into evaluation logs if it's not empty",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hashAlgorithm": {
+          "description": "hash algorithm used for the consistent hashing of rollouts",
+          "type": "string",
+          "enum": [
+            "crc32",
+            "murmur3",
+            "xxhash",
+            "sha1"
+          ],
+          "x-nullable": true
+        },
+        "key": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "notes": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "stickyAssignments": {
+          "description": "an entity keeps the variant it first gets from a segment until the assignment salt is reset",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "valueSchema": {
+          "description": "the JSON Schema of the flag value. An empty object clears it.",
+          "type": "object"
+        },
+        "valueType": {
+          "description": "the type of the flag value, one of boolean, string, number and object. Empty makes the flag untyped.",
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "putSegmentReorderRequest": {
+      "type": "object",
+      "required": [
+        "segmentIDs"
+      ],
+      "properties": {
+        "segmentIDs": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        }
+      }
+    },
+    "putSegmentRequest": {
+      "type": "object",
+      "required": [
+        "description",
+        "rolloutPercent"
+      ],
+      "properties": {
+        "audienceIDs": {
+          "description": "audiences of the segment. If it's not set, the audiences stay the same.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        },
+        "bucketBy": {
+          "description": "overrides the flag's bucketBy if it's not empty",
+          "type": "string",
+          "x-nullable": true
+        },
+        "constraintGroups": {
+          "description": "constraint groups of the segment. If it's not set, the constraint groups stay the same.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "defaultVariantID": {
+          "description": "the variant returned when the entity matches the segment but misses the rollout. 0 clears it.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "prerequisites": {
+          "description": "prerequisites of the segment. If it's not set, the prerequisites stay the same.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/prerequisite"
+          }
+        },
+        "rolloutPercent": {
+          "type": "number",
+          "format": "double",
+          "maximum": 100
+        }
+      }
+    },
+    "putVariantRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "attachment": {
+          "type": "object"
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "rolloutRamp": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "segmentID",
+        "steps",
+        "stepIntervalSeconds",
+        "currentStep",
+        "status"
+      ],
+      "properties": {
+        "createdBy": {
+          "type": "string"
+        },
+        "currentStep": {
+          "description": "the number of the steps applied",
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "the reason of the FAILED status",
+          "type": "string"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
         "id": {
           "type": "integer",
           "format": "int64",
           "minimum": 1,
           "readOnly": true
         },
-        "operator": {
+        "nextStepAt": {
+          "description": "when the next step is due, it's empty if the ramp is not active",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "status": {
           "type": "string",
-          "minLength": 1,
           "enum": [
-            "EQ",
-            "NEQ",
-            "LT",
-            "LTE",
-            "GT",
-            "GTE",
-            "EREG",
-            "NEREG",
-            "IN",
-            "NOTIN",
-            "CONTAINS",
-            "NOTCONTAINS"
+            "ACTIVE",
+            "PAUSED",
+            "COMPLETED",
+            "ABORTED",
+            "FAILED"
           ]
         },
-        "property": {
-          "type": "string",
-          "minLength": 1
+        "stepIntervalSeconds": {
+          "type": "integer",
+          "format": "int64"
         },
-        "value": {
-          "type": "string",
-          "minLength": 1
+        "steps": {
+          "description": "the rollout percents of the steps in order",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "double"
+          }
         }
       }
     },
-    "createConstraintRequest": {
+    "scheduledChange": {
       "type": "object",
       "required": [
-        "property",
-        "operator",
-        "value"
+        "flagID",
+        "executeAt",
+        "action",
+        "status"
       ],
       "properties": {
-        "operator": {
+        "action": {
           "type": "string",
-          "minLength": 1
+          "enum": [
+            "ENABLE_FLAG",
+            "DISABLE_FLAG",
+            "SET_ROLLOUT_PERCENT",
+            "SET_DISTRIBUTIONS"
+          ]
         },
-        "property": {
+        "appliedAt": {
           "type": "string",
-          "minLength": 1
+          "format": "date-time",
+          "x-nullable": true
         },
-        "value": {
+        "createdBy": {
+          "type": "string"
+        },
+        "distributions": {
+          "description": "the distributions of SET_DISTRIBUTIONS",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/distribution"
+          }
+        },
+        "error": {
+          "description": "the reason of the FAILED status",
+          "type": "string"
+        },
+        "executeAt": {
           "type": "string",
-          "minLength": 1
+          "format": "date-time"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "rolloutPercent": {
+          "description": "the rollout percent of SET_ROLLOUT_PERCENT",
+          "type": "number",
+          "format": "double"
+        },
+        "segmentID": {
+          "description": "the segment of SET_ROLLOUT_PERCENT and SET_DISTRIBUTIONS",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "PENDING",
+            "APPLIED",
+            "FAILED",
+            "CANCELED"
+          ]
         }
       }
     },
-    "createFlagRequest": {
+    "segment": {
       "type": "object",
       "required": [
-        "description"
+        "description",
+        "rank",
+        "rolloutPercent"
       ],
       "properties": {
+        "audienceIDs": {
+          "description": "the audiences the entity needs to be in, together with the constraints of the segment",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        },
+        "bucketBy": {
+          "description": "overrides the flag's bucketBy if it's not empty",
+          "type": "string"
+        },
+        "constraintGroups": {
+          "description": "groups of constraints with AND/OR/NOT nesting, they are joined with AND together with the constraints",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraint"
+          }
+        },
+        "defaultVariantID": {
+          "description": "the variant returned when the entity matches the segment but misses the rollout. 0 means no default variant.",
+          "type": "integer",
+          "format": "int64"
+        },
         "description": {
           "type": "string",
           "minLength": 1
         },
-        "key": {
-          "description": "unique key representation of the flag",
-          "type": "string"
+        "distributions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/distribution"
+          }
         },
-        "template": {
-          "description": "template for flag creation",
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "prerequisites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/prerequisite"
+          }
+        },
+        "rank": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rolloutPercent": {
+          "type": "number",
+          "format": "double",
+          "maximum": 100
+        }
+      }
+    },
+    "segmentDebugLog": {
+      "type": "object",
+      "properties": {
+        "constraintTraces": {
+          "description": "the result of each constraint of the segment and its audiences, only present when enableDebug is true",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintTrace"
+          }
+        },
+        "msg": {
           "type": "string"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         }
       }
     },
-    "createSegmentRequest": {
+    "setFlagEnabledRequest": {
       "type": "object",
       "required": [
-        "description",
-        "rolloutPercent"
+        "enabled"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        }
+      }
+    },
+    "simulationSegment": {
+      "type": "object",
+      "required": [
+        "segmentID",
+        "rank",
+        "matched",
+        "rolloutExcluded"
       ],
       "properties": {
         "description": {
-          "type": "string",
-          "minLength": 1
+          "type": "string"
         },
-        "rolloutPercent": {
+        "matched": {
+          "description": "the number of the entities getting a variant from the segment",
           "type": "integer",
-          "format": "int64",
-          "maximum": 100
+          "format": "int64"
+        },
+        "rank": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rolloutExcluded": {
+          "description": "the number of the entities matching the segment but not in its rollout",
+          "type": "integer",
+          "format": "int64"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "simulationTransition": {
+      "type": "object",
+      "required": [
+        "fromVariantKey",
+        "toVariantKey",
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "fromVariantKey": {
+          "description": "the variant key from the live flag, empty if there's no variant",
+          "type": "string"
+        },
+        "toVariantKey": {
+          "description": "the variant key from the simulated flag, empty if there's no variant",
+          "type": "string"
+        }
+      }
+    },
+    "simulationVariant": {
+      "type": "object",
+      "required": [
+        "variantID",
+        "variantKey",
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantKey": {
+          "type": "string"
         }
       }
     },
-    "createTagRequest": {
+    "tag": {
       "type": "object",
       "required": [
         "value"
       ],
       "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
         "value": {
           "type": "string",
           "minLength": 1
         }
       }
     },
-    "createVariantRequest": {
+    "variant": {
       "type": "object",
       "required": [
         "key"
@@ -1512,648 +4526,988 @@ func init() {
         "attachment": {
           "type": "object"
         },
-        "key": {
-          "type": "string",
-          "minLength": 1
-        }
-      }
-    },
-    "distribution": {
-      "type": "object",
-      "required": [
-        "percent",
-        "variantID",
-        "variantKey"
-      ],
-      "properties": {
         "id": {
           "type": "integer",
           "format": "int64",
           "minimum": 1,
           "readOnly": true
         },
-        "percent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100
-        },
-        "variantID": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
-        },
-        "variantKey": {
+        "key": {
           "type": "string",
           "minLength": 1
         }
       }
     },
-    "error": {
+    "variantOverride": {
       "type": "object",
       "required": [
-        "message"
+        "entityID",
+        "variantID"
       ],
       "properties": {
-        "message": {
-          "type": "string",
-          "minLength": 1
-        }
-      }
-    },
-    "evalContext": {
-      "type": "object",
-      "properties": {
-        "enableDebug": {
-          "type": "boolean"
-        },
-        "entityContext": {
-          "type": "object"
+        "createdBy": {
+          "type": "string"
         },
         "entityID": {
-          "description": "entityID is used to deterministically at random to evaluate the flag result. If it's empty, flagr will randomly generate one.",
-          "type": "string"
+          "type": "string",
+          "minLength": 1
         },
         "entityType": {
+          "description": "the override only applies to this entityType if it's set",
           "type": "string"
         },
-        "flagID": {
-          "description": "flagID",
+        "expiresAt": {
+          "description": "the override no longer applies since this time",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
           "type": "integer",
           "format": "int64",
-          "minimum": 1
-        },
-        "flagKey": {
-          "description": "flagKey. flagID or flagKey will resolve to the same flag. Either works.",
-          "type": "string"
+          "minimum": 1,
+          "readOnly": true
         },
-        "flagTags": {
-          "description": "flagTags. flagTags looks up flags by tag. Either works.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "x-omitempty": true
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         },
-        "flagTagsOperator": {
-          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
+        "variantKey": {
           "type": "string",
-          "default": "ANY",
-          "enum": [
-            "ANY",
-            "ALL"
-          ]
+          "readOnly": true
         }
       }
+    }
+  },
+  "tags": [
+    {
+      "description": "Everything about the flag",
+      "name": "flag"
     },
-    "evalDebugLog": {
-      "type": "object",
-      "properties": {
-        "msg": {
-          "type": "string"
-        },
-        "segmentDebugLogs": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/segmentDebugLog"
+    {
+      "description": "Segment defines the audience of the flag, it's the user segmentation",
+      "name": "segment"
+    },
+    {
+      "description": "Constraint is the unit of defining a small subset of users",
+      "name": "constraint"
+    },
+    {
+      "description": "Audience is a named set of constraints that can be shared by segments across flags",
+      "name": "audience"
+    },
+    {
+      "description": "ID list is a managed list of entity IDs that constraints can reference with IN_LIST and NOT_IN_LIST",
+      "name": "idList"
+    },
+    {
+      "description": "Distribution is the percent distribution of variants within that segment",
+      "name": "distribution"
+    },
+    {
+      "description": "Rollout ramp advances the rollout percent of a segment through the steps automatically",
+      "name": "rolloutRamp"
+    },
+    {
+      "description": "Variant override pins an entity to a variant of the flag",
+      "name": "variantOverride"
+    },
+    {
+      "description": "Scheduled change is a change of the flag applied by the scheduler at a given time",
+      "name": "scheduledChange"
+    },
+    {
+      "description": "Variants are the possible outcomes of flag evaluation",
+      "name": "variant"
+    },
+    {
+      "description": "Evaluation is the process of evaluating a flag given the entity context",
+      "name": "evaluation"
+    },
+    {
+      "description": "OpenFeature Remote Evaluation Protocol (OFREP) of the flag evaluation",
+      "name": "ofrep"
+    },
+    {
+      "description": "Check if Flagr is healthy",
+      "name": "health"
+    }
+  ],
+  "x-tagGroups": [
+    {
+      "name": "Flag Management",
+      "tags": [
+        "flag",
+        "segment",
+        "constraint",
+        "audience",
+        "idList",
+        "distribution",
+        "variant",
+        "tag",
+        "variantOverride",
+        "scheduledChange",
+        "rolloutRamp"
+      ]
+    },
+    {
+      "name": "Flag Evaluation",
+      "tags": [
+        "evaluation",
+        "ofrep"
+      ]
+    },
+    {
+      "name": "Health Check",
+      "tags": [
+        "health"
+      ]
+    },
+    {
+      "name": "Export",
+      "tags": [
+        "export"
+      ]
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Flagr is a feature flagging, A/B testing and dynamic configuration microservice. The base path for all the APIs is \"/api/v1\".\n",
+    "title": "Flagr",
+    "version": "1.1.18"
+  },
+  "basePath": "/api/v1",
+  "paths": {
+    "/audiences": {
+      "get": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "findAudiences",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of audiences to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return audiences given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return audiences matching given key",
+            "name": "key",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return audiences partially matching given description",
+            "name": "description_like",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list all the audiences",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/audience"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "createAudience",
+        "parameters": [
+          {
+            "description": "create an audience",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createAudienceRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the created audience",
+            "schema": {
+              "$ref": "#/definitions/audience"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "evalResult": {
-      "type": "object",
-      "properties": {
-        "evalContext": {
-          "$ref": "#/definitions/evalContext"
-        },
-        "evalDebugLog": {
-          "$ref": "#/definitions/evalDebugLog"
-        },
-        "flagID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "flagKey": {
-          "type": "string"
-        },
-        "flagSnapshotID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "flagTags": {
-          "description": "flagTags. flagTags looks up flags by tag. Either works.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/audiences/{audienceID}": {
+      "get": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "getAudience",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience to get",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the audience",
+            "schema": {
+              "$ref": "#/definitions/audience"
+            }
           },
-          "x-omitempty": true
-        },
-        "segmentID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "timestamp": {
-          "type": "string"
-        },
-        "variantAttachment": {
-          "type": "object"
-        },
-        "variantID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "variantKey": {
-          "type": "string"
-        }
-      }
-    },
-    "evaluationBatchRequest": {
-      "type": "object",
-      "required": [
-        "entities"
-      ],
-      "properties": {
-        "enableDebug": {
-          "type": "boolean"
-        },
-        "entities": {
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "$ref": "#/definitions/evaluationEntity"
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "flagIDs": {
-          "description": "flagIDs",
-          "type": "array",
-          "minItems": 1,
-          "items": {
+        }
+      },
+      "put": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "putAudience",
+        "parameters": [
+          {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "minimum": 1
-          }
-        },
-        "flagKeys": {
-          "description": "flagKeys. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.",
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "type": "string",
-            "minLength": 1
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update an audience",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putAudienceRequest"
+            }
           }
-        },
-        "flagTags": {
-          "description": "flagTags. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.",
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "type": "string",
-            "minLength": 1
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the audience",
+            "schema": {
+              "$ref": "#/definitions/audience"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "flagTagsOperator": {
-          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
-          "type": "string",
-          "default": "ANY",
-          "enum": [
-            "ANY",
-            "ALL"
-          ]
         }
-      }
-    },
-    "evaluationBatchResponse": {
-      "type": "object",
-      "required": [
-        "evaluationResults"
-      ],
-      "properties": {
-        "evaluationResults": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/evalResult"
+      },
+      "delete": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "deleteAudience",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "evaluationEntity": {
-      "type": "object",
-      "properties": {
-        "entityContext": {
-          "type": "object"
-        },
-        "entityID": {
-          "type": "string"
-        },
-        "entityType": {
-          "type": "string"
-        }
-      }
-    },
-    "flag": {
-      "type": "object",
-      "required": [
-        "description",
-        "enabled",
-        "dataRecordsEnabled"
-      ],
-      "properties": {
-        "createdBy": {
-          "type": "string"
-        },
-        "dataRecordsEnabled": {
-          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
-          "type": "boolean"
-        },
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "enabled": {
-          "type": "boolean"
-        },
-        "entityType": {
-          "description": "it will override the entityType in the evaluation logs if it's not empty",
-          "type": "string"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "key": {
-          "description": "unique key representation of the flag",
-          "type": "string",
-          "minLength": 1
-        },
-        "notes": {
-          "description": "flag usage details in markdown format",
-          "type": "string"
-        },
-        "segments": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/segment"
-          }
-        },
-        "tags": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/tag"
-          }
-        },
-        "updatedAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "updatedBy": {
-          "type": "string"
-        },
-        "variants": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/variant"
+    "/audiences/{audienceID}/flags": {
+      "get": {
+        "description": "Find the flags that reference the audience in any of their segments",
+        "tags": [
+          "audience"
+        ],
+        "operationId": "findAudienceFlags",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list the flags using the audience",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/flag"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "flagSnapshot": {
-      "type": "object",
-      "required": [
-        "id",
-        "flag",
-        "updatedAt"
-      ],
-      "properties": {
-        "flag": {
-          "$ref": "#/definitions/flag"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "updatedAt": {
-          "type": "string",
-          "minLength": 1
-        },
-        "updatedBy": {
-          "type": "string"
+    "/evaluation": {
+      "post": {
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluation",
+        "parameters": [
+          {
+            "description": "evalution context",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evalContext"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation result",
+            "schema": {
+              "$ref": "#/definitions/evalResult"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "health": {
-      "type": "object",
-      "properties": {
-        "status": {
-          "type": "string"
+    "/evaluation/batch": {
+      "post": {
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationBatch",
+        "parameters": [
+          {
+            "description": "evalution batch request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evaluationBatchRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation batch result",
+            "schema": {
+              "$ref": "#/definitions/evaluationBatchResponse"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "putDistributionsRequest": {
-      "type": "object",
-      "required": [
-        "distributions"
-      ],
-      "properties": {
-        "distributions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/distribution"
+    "/evaluation/bootstrap": {
+      "post": {
+        "description": "evaluates all the enabled flags, or the ones filtered by flagTags or clientVisibleOnly, for the entity, e.g. to bootstrap the flags of a frontend at page load. The response is escaped to be embedded in HTML.",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationBootstrap",
+        "parameters": [
+          {
+            "description": "evaluation bootstrap request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evaluationBootstrapRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the assignments of the flags keyed by flagKey",
+            "schema": {
+              "$ref": "#/definitions/evaluationBootstrapResponse"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "putFlagRequest": {
-      "type": "object",
-      "properties": {
-        "dataRecordsEnabled": {
-          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "description": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": true
-        },
-        "enabled": {
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "entityType": {
-          "description": "it will overwrite entityType into evaluation logs if it's not empty",
-          "type": "string",
-          "x-nullable": true
-        },
-        "key": {
-          "type": "string",
-          "x-nullable": true
-        },
-        "notes": {
-          "type": "string",
-          "x-nullable": true
+    "/evaluation/changes": {
+      "get": {
+        "description": "streams the changes of the flags as server-sent events, e.g. for SDKs and relay instances to refresh the changed flags as soon as the evaluation cache picks them up, instead of polling all the flags. Each message is a flag_changes event with the data of flagChanges. A comment line is sent as the heartbeat when there are no changes. The request needs the \"Accept: text/event-stream\" header.",
+        "produces": [
+          "text/event-stream"
+        ],
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "getEvaluationChanges",
+        "responses": {
+          "200": {
+            "description": "the stream of flag_changes events",
+            "schema": {
+              "$ref": "#/definitions/flagChanges"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "putSegmentReorderRequest": {
-      "type": "object",
-      "required": [
-        "segmentIDs"
-      ],
-      "properties": {
-        "segmentIDs": {
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "type": "integer",
-            "format": "int64",
-            "minimum": 1
+    "/evaluation/dry_run": {
+      "post": {
+        "description": "evaluates an unsaved flag definition with the entities, without saving the flag or recording the results. The debug logs are always included.",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationDryRun",
+        "parameters": [
+          {
+            "description": "evaluation dry run request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evaluationDryRunRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation dry run result",
+            "schema": {
+              "$ref": "#/definitions/evaluationDryRunResponse"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "putSegmentRequest": {
-      "type": "object",
-      "required": [
-        "description",
-        "rolloutPercent"
-      ],
-      "properties": {
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "rolloutPercent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100
+    "/evaluation/simulation": {
+      "post": {
+        "description": "simulates the rollout of a flag definition over a sample of entities, e.g. to preview how a change of the distributions or the constraints would split the population before saving it. It reports how many entities land in each segment and variant, and how many of them would get a different variant than from the live flag. Nothing is saved, and the results are not logged or recorded.",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationSimulation",
+        "parameters": [
+          {
+            "description": "evaluation simulation request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evaluationSimulationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation simulation result",
+            "schema": {
+              "$ref": "#/definitions/evaluationSimulationResponse"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "putVariantRequest": {
-      "type": "object",
-      "required": [
-        "key"
-      ],
-      "properties": {
-        "attachment": {
-          "type": "object"
-        },
-        "key": {
-          "type": "string",
-          "minLength": 1
+    "/evaluation/snapshot": {
+      "post": {
+        "description": "evaluates a historical snapshot of the flag with the entities, i.e. what the entities would have got at that time. The results are not logged or recorded. It's not available in the eval only mode.",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationSnapshot",
+        "parameters": [
+          {
+            "description": "evaluation snapshot request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evaluationSnapshotRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation snapshot result",
+            "schema": {
+              "$ref": "#/definitions/evaluationSnapshotResponse"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "segment": {
-      "type": "object",
-      "required": [
-        "description",
-        "rank",
-        "rolloutPercent"
-      ],
-      "properties": {
-        "constraints": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/constraint"
-          }
-        },
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "distributions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/distribution"
+    "/export/eval_cache/json": {
+      "get": {
+        "description": "Export JSON format of the eval cache dump",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "export"
+        ],
+        "operationId": "getExportEvalCacheJSON",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "rank": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "rolloutPercent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100
         }
       }
     },
-    "segmentDebugLog": {
-      "type": "object",
-      "properties": {
-        "msg": {
-          "type": "string"
-        },
-        "segmentID": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
+    "/export/sqlite": {
+      "get": {
+        "description": "Export sqlite3 format of the db dump, which is converted from the main database.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "export"
+        ],
+        "operationId": "getExportSqlite",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "export without snapshots data - useful for smaller db without snapshots\n",
+            "name": "exclude_snapshots",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "setFlagEnabledRequest": {
-      "type": "object",
-      "required": [
-        "enabled"
-      ],
-      "properties": {
-        "enabled": {
-          "type": "boolean"
+    "/flags": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "findFlags",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of flags to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return flags having given enabled status",
+            "name": "enabled",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags exactly matching given description",
+            "name": "description",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags with the given tags (comma separated)",
+            "name": "tags",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags partially matching given description",
+            "name": "description_like",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags matching given key",
+            "name": "key",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return flags given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return flags with preloaded segments and variants",
+            "name": "preload",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return all deleted flags",
+            "name": "deleted",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list all the flags",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/flag"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "createFlag",
+        "parameters": [
+          {
+            "description": "create a flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createFlagRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the created flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tag": {
-      "type": "object",
-      "required": [
-        "value"
-      ],
-      "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "value": {
-          "type": "string",
-          "minLength": 1
+    "/flags/entity_types": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlagEntityTypes",
+        "responses": {
+          "200": {
+            "description": "returns all the FlagEntityTypes",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string",
+                "minLength": 1
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "variant": {
-      "type": "object",
-      "required": [
-        "key"
-      ],
-      "properties": {
-        "attachment": {
-          "type": "object"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "key": {
-          "type": "string",
-          "minLength": 1
+    "/flags/{flagID}": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update the flag. Changing the key fails if any segment of the other flags requires the flag as a prerequisite.",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "putFlag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putFlagRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete the flag. It fails if any segment of the other flags requires the flag as a prerequisite.",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "deleteFlag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
-    }
-  },
-  "tags": [
-    {
-      "description": "Everything about the flag",
-      "name": "flag"
-    },
-    {
-      "description": "Segment defines the audience of the flag, it's the user segmentation",
-      "name": "segment"
-    },
-    {
-      "description": "Constraint is the unit of defining a small subset of users",
-      "name": "constraint"
-    },
-    {
-      "description": "Distribution is the percent distribution of variants within that segment",
-      "name": "distribution"
-    },
-    {
-      "description": "Variants are the possible outcomes of flag evaluation",
-      "name": "variant"
-    },
-    {
-      "description": "Evaluation is the process of evaluating a flag given the entity context",
-      "name": "evaluation"
-    },
-    {
-      "description": "Check if Flagr is healthy",
-      "name": "health"
-    }
-  ],
-  "x-tagGroups": [
-    {
-      "name": "Flag Management",
-      "tags": [
-        "flag",
-        "segment",
-        "constraint",
-        "distribution",
-        "variant",
-        "tag"
-      ]
-    },
-    {
-      "name": "Flag Evaluation",
-      "tags": [
-        "evaluation"
-      ]
-    },
-    {
-      "name": "Health Check",
-      "tags": [
-        "health"
-      ]
     },
-    {
-      "name": "Export",
-      "tags": [
-        "export"
-      ]
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Flagr is a feature flagging, A/B testing and dynamic configuration microservice. The base path for all the APIs is \"/api/v1\".\n",
-    "title": "Flagr",
-    "version": "1.1.18"
-  },
-  "basePath": "/api/v1",
-  "paths": {
-    "/evaluation": {
+    "/flags/{flagID}/assignment_salt/reset": {
       "post": {
+        "description": "resets the assignment salt of the flag, so that all the sticky assignments of the flag are dropped and the entities get the variants of the current segments and distributions again",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "resetFlagAssignmentSalt",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/enabled": {
+      "put": {
         "tags": [
-          "evaluation"
+          "flag"
         ],
-        "operationId": "postEvaluation",
+        "operationId": "setFlagEnabled",
         "parameters": [
           {
-            "description": "evalution context",
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "set flag enabled state",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/evalContext"
+              "$ref": "#/definitions/setFlagEnabledRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "evaluation result",
+            "description": "returns the flag",
             "schema": {
-              "$ref": "#/definitions/evalResult"
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -2165,28 +5519,72 @@ func init() {
         }
       }
     },
-    "/evaluation/batch": {
+    "/flags/{flagID}/overrides": {
+      "get": {
+        "tags": [
+          "variantOverride"
+        ],
+        "operationId": "findVariantOverrides",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant overrides of the flag",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/variantOverride"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
       "post": {
+        "description": "Pin the entity to the variant regardless of the segments and the rollout. An entity can only have one override per entityType of the flag.\n",
         "tags": [
-          "evaluation"
+          "variantOverride"
         ],
-        "operationId": "postEvaluationBatch",
+        "operationId": "createVariantOverride",
         "parameters": [
           {
-            "description": "evalution batch request",
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a variant override",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/evaluationBatchRequest"
+              "$ref": "#/definitions/createVariantOverrideRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "evaluation batch result",
+            "description": "variant override just created",
             "schema": {
-              "$ref": "#/definitions/evaluationBatchResponse"
+              "$ref": "#/definitions/variantOverride"
             }
           },
           "default": {
@@ -2198,22 +5596,35 @@ func init() {
         }
       }
     },
-    "/export/eval_cache/json": {
-      "get": {
-        "description": "Export JSON format of the eval cache dump",
-        "produces": [
-          "application/json"
-        ],
+    "/flags/{flagID}/overrides/{variantOverrideID}": {
+      "delete": {
         "tags": [
-          "export"
+          "variantOverride"
+        ],
+        "operationId": "deleteVariantOverride",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the variant override",
+            "name": "variantOverrideID",
+            "in": "path",
+            "required": true
+          }
         ],
-        "operationId": "getExportEvalCacheJSON",
         "responses": {
           "200": {
-            "description": "OK",
-            "schema": {
-              "type": "object"
-            }
+            "description": "OK deleted"
           },
           "default": {
             "description": "generic error response",
@@ -2224,29 +5635,28 @@ func init() {
         }
       }
     },
-    "/export/sqlite": {
-      "get": {
-        "description": "Export sqlite3 format of the db dump, which is converted from the main database.",
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/flags/{flagID}/restore": {
+      "put": {
         "tags": [
-          "export"
+          "flag"
         ],
-        "operationId": "getExportSqlite",
+        "operationId": "restoreFlag",
         "parameters": [
           {
-            "type": "boolean",
-            "description": "export without snapshots data - useful for smaller db without snapshots\n",
-            "name": "exclude_snapshots",
-            "in": "query"
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "OK",
+            "description": "returns the flag",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -2258,77 +5668,161 @@ func init() {
         }
       }
     },
-    "/flags": {
+    "/flags/{flagID}/scheduled_changes": {
       "get": {
         "tags": [
-          "flag"
+          "scheduledChange"
         ],
-        "operationId": "findFlags",
+        "operationId": "findScheduledChanges",
         "parameters": [
           {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "the numbers of flags to return",
-            "name": "limit",
-            "in": "query"
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "boolean",
-            "description": "return flags having given enabled status",
-            "name": "enabled",
+            "enum": [
+              "PENDING",
+              "APPLIED",
+              "FAILED",
+              "CANCELED"
+            ],
+            "type": "string",
+            "description": "return scheduled changes with the given status",
+            "name": "status",
             "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "scheduled changes of the flag ordered by executeAt",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/scheduledChange"
+              }
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "scheduledChange"
+        ],
+        "operationId": "createScheduledChange",
+        "parameters": [
           {
-            "type": "string",
-            "description": "return flags exactly matching given description",
-            "name": "description",
-            "in": "query"
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "return flags with the given tags (comma separated)",
-            "name": "tags",
-            "in": "query"
+            "description": "create a change of the flag to apply at executeAt",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createScheduledChangeRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the scheduled change created",
+            "schema": {
+              "$ref": "#/definitions/scheduledChange"
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/scheduled_changes/{scheduledChangeID}": {
+      "delete": {
+        "description": "Cancel the scheduled change, only a pending one can be canceled",
+        "tags": [
+          "scheduledChange"
+        ],
+        "operationId": "cancelScheduledChange",
+        "parameters": [
           {
-            "type": "string",
-            "description": "return flags partially matching given description",
-            "name": "description_like",
-            "in": "query"
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "return flags matching given key",
-            "name": "key",
-            "in": "query"
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the scheduled change",
+            "name": "scheduledChangeID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the canceled scheduled change",
+            "schema": {
+              "$ref": "#/definitions/scheduledChange"
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments": {
+      "get": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "findSegments",
+        "parameters": [
           {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "return flags given the offset, it should usually set together with limit",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "return flags with preloaded segments and variants",
-            "name": "preload",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "return all deleted flags",
-            "name": "deleted",
-            "in": "query"
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "list all the flags",
+            "description": "segments ordered by rank of the flag",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/flag"
+                "$ref": "#/definitions/segment"
               }
             }
           },
@@ -2342,25 +5836,34 @@ func init() {
       },
       "post": {
         "tags": [
-          "flag"
+          "segment"
         ],
-        "operationId": "createFlag",
+        "operationId": "createSegment",
         "parameters": [
           {
-            "description": "create a flag",
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a segment under a flag",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createFlagRequest"
+              "$ref": "#/definitions/createSegmentRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the created flag",
+            "description": "segment created",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "$ref": "#/definitions/segment"
             }
           },
           "default": {
@@ -2372,22 +5875,35 @@ func init() {
         }
       }
     },
-    "/flags/entity_types": {
-      "get": {
+    "/flags/{flagID}/segments/reorder": {
+      "put": {
         "tags": [
-          "flag"
+          "segment"
         ],
-        "operationId": "getFlagEntityTypes",
-        "responses": {
-          "200": {
-            "description": "returns all the FlagEntityTypes",
+        "operationId": "putSegmentsReorder",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "reorder segments",
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string",
-                "minLength": 1
-              }
+              "$ref": "#/definitions/putSegmentReorderRequest"
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segments reordered"
           },
           "default": {
             "description": "generic error response",
@@ -2398,28 +5914,46 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}": {
-      "get": {
+    "/flags/{flagID}/segments/{segmentID}": {
+      "put": {
         "tags": [
-          "flag"
+          "segment"
         ],
-        "operationId": "getFlag",
+        "operationId": "putSegment",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a segment",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putSegmentRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
+            "description": "segment updated",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "$ref": "#/definitions/segment"
             }
           },
           "default": {
@@ -2430,36 +5964,78 @@ func init() {
           }
         }
       },
-      "put": {
+      "delete": {
         "tags": [
-          "flag"
+          "segment"
         ],
-        "operationId": "putFlag",
+        "operationId": "deleteSegment",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
           },
           {
-            "description": "update a flag",
-            "name": "body",
-            "in": "body",
-            "required": true,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
             "schema": {
-              "$ref": "#/definitions/putFlagRequest"
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/constraints": {
+      "get": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "findConstraints",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
+            "description": "constraints under the segment",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/constraint"
+              }
             }
           },
           "default": {
@@ -2470,11 +6046,11 @@ func init() {
           }
         }
       },
-      "delete": {
+      "post": {
         "tags": [
-          "flag"
+          "constraint"
         ],
-        "operationId": "deleteFlag",
+        "operationId": "createConstraint",
         "parameters": [
           {
             "minimum": 1,
@@ -2484,11 +6060,32 @@ func init() {
             "name": "flagID",
             "in": "path",
             "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a constraint",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createConstraintRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "OK deleted"
+            "description": "the constraint created",
+            "schema": {
+              "$ref": "#/definitions/constraint"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -2499,37 +6096,55 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/enabled": {
+    "/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}": {
       "put": {
         "tags": [
-          "flag"
+          "constraint"
         ],
-        "operationId": "setFlagEnabled",
+        "operationId": "putConstraint",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
           },
           {
-            "description": "set flag enabled state",
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the constraint",
+            "name": "constraintID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a constraint",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setFlagEnabledRequest"
+              "$ref": "#/definitions/createConstraintRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
+            "description": "constraint just updated",
             "schema": {
-              "$ref": "#/definitions/flag"
+              "$ref": "#/definitions/constraint"
             }
           },
           "default": {
@@ -2539,31 +6154,44 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/flags/{flagID}/restore": {
-      "put": {
+      },
+      "delete": {
         "tags": [
-          "flag"
+          "constraint"
         ],
-        "operationId": "restoreFlag",
+        "operationId": "deleteConstraint",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the constraint",
+            "name": "constraintID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag",
-            "schema": {
-              "$ref": "#/definitions/flag"
-            }
+            "description": "deleted"
           },
           "default": {
             "description": "generic error response",
@@ -2574,30 +6202,39 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments": {
+    "/flags/{flagID}/segments/{segmentID}/distributions": {
       "get": {
         "tags": [
-          "segment"
+          "distribution"
         ],
-        "operationId": "findSegments",
+        "operationId": "findDistributions",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "segments ordered by rank of the flag",
+            "description": "distribution under the segment",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/segment"
+                "$ref": "#/definitions/distribution"
               }
             }
           },
@@ -2609,36 +6246,49 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
+        "description": "replace the distribution with the new setting",
         "tags": [
-          "segment"
+          "distribution"
         ],
-        "operationId": "createSegment",
+        "operationId": "putDistributions",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
           },
           {
-            "description": "create a segment under a flag",
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "array of distributions",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createSegmentRequest"
+              "$ref": "#/definitions/putDistributionsRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "segment created",
+            "description": "distribution under the segment",
             "schema": {
-              "$ref": "#/definitions/segment"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/distribution"
+              }
             }
           },
           "default": {
@@ -2650,12 +6300,12 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/reorder": {
-      "put": {
+    "/flags/{flagID}/segments/{segmentID}/rollout_ramps": {
+      "get": {
         "tags": [
-          "segment"
+          "rolloutRamp"
         ],
-        "operationId": "putSegmentsReorder",
+        "operationId": "findRolloutRamps",
         "parameters": [
           {
             "minimum": 1,
@@ -2667,18 +6317,24 @@ func init() {
             "required": true
           },
           {
-            "description": "reorder segments",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putSegmentReorderRequest"
-            }
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "segments reordered"
+            "description": "rollout ramps of the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/rolloutRamp"
+              }
+            }
           },
           "default": {
             "description": "generic error response",
@@ -2687,14 +6343,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/flags/{flagID}/segments/{segmentID}": {
-      "put": {
+      },
+      "post": {
+        "description": "Ramp the rollout percent of the segment through the steps automatically. A segment can only have one active or paused rollout ramp.\n",
         "tags": [
-          "segment"
+          "rolloutRamp"
         ],
-        "operationId": "putSegment",
+        "operationId": "createRolloutRamp",
         "parameters": [
           {
             "minimum": 1,
@@ -2715,20 +6370,20 @@ func init() {
             "required": true
           },
           {
-            "description": "update a segment",
+            "description": "create a rollout ramp",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putSegmentRequest"
+              "$ref": "#/definitions/createRolloutRampRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "segment updated",
+            "description": "the rollout ramp",
             "schema": {
-              "$ref": "#/definitions/segment"
+              "$ref": "#/definitions/rolloutRamp"
             }
           },
           "default": {
@@ -2738,12 +6393,15 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/rollout_ramps/{rolloutRampID}/abort": {
+      "put": {
+        "description": "Abort the active or paused rollout ramp, the rollout percent of the segment stays at the current step",
         "tags": [
-          "segment"
+          "rolloutRamp"
         ],
-        "operationId": "deleteSegment",
+        "operationId": "abortRolloutRamp",
         "parameters": [
           {
             "minimum": 1,
@@ -2762,11 +6420,23 @@ func init() {
             "name": "segmentID",
             "in": "path",
             "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the rollout ramp",
+            "name": "rolloutRampID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "deleted"
+            "description": "the rollout ramp",
+            "schema": {
+              "$ref": "#/definitions/rolloutRamp"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -2777,12 +6447,13 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/constraints": {
-      "get": {
+    "/flags/{flagID}/segments/{segmentID}/rollout_ramps/{rolloutRampID}/pause": {
+      "put": {
+        "description": "Pause the active rollout ramp, the time left until the next step is kept for resuming",
         "tags": [
-          "constraint"
+          "rolloutRamp"
         ],
-        "operationId": "findConstraints",
+        "operationId": "pauseRolloutRamp",
         "parameters": [
           {
             "minimum": 1,
@@ -2801,16 +6472,22 @@ func init() {
             "name": "segmentID",
             "in": "path",
             "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the rollout ramp",
+            "name": "rolloutRampID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "constraints under the segment",
+            "description": "the rollout ramp",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/constraint"
-              }
+              "$ref": "#/definitions/rolloutRamp"
             }
           },
           "default": {
@@ -2820,12 +6497,15 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/rollout_ramps/{rolloutRampID}/resume": {
+      "put": {
+        "description": "Resume the paused rollout ramp",
         "tags": [
-          "constraint"
+          "rolloutRamp"
         ],
-        "operationId": "createConstraint",
+        "operationId": "resumeRolloutRamp",
         "parameters": [
           {
             "minimum": 1,
@@ -2846,20 +6526,20 @@ func init() {
             "required": true
           },
           {
-            "description": "create a constraint",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createConstraintRequest"
-            }
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the rollout ramp",
+            "name": "rolloutRampID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "the constraint created",
+            "description": "the rollout ramp",
             "schema": {
-              "$ref": "#/definitions/constraint"
+              "$ref": "#/definitions/rolloutRamp"
             }
           },
           "default": {
@@ -2871,55 +6551,131 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}": {
-      "put": {
+    "/flags/{flagID}/snapshots": {
+      "get": {
         "tags": [
-          "constraint"
+          "flag"
         ],
-        "operationId": "putConstraint",
+        "operationId": "getFlagSnapshots",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
+            "description": "numeric ID of the flag to get",
             "name": "flagID",
             "in": "path",
             "required": true
           },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the number of snapshots to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return snapshots given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ASC",
+              "DESC"
+            ],
+            "type": "string",
+            "description": "sort order",
+            "name": "sort",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag snapshots",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/flagSnapshot"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/tags": {
+      "get": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "findTags",
+        "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "tag ordered by tagID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/tag"
+              }
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "createTag",
+        "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the constraint",
-            "name": "constraintID",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
             "in": "path",
             "required": true
           },
           {
-            "description": "create a constraint",
+            "description": "create a tag",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createConstraintRequest"
+              "$ref": "#/definitions/createTagRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "constraint just updated",
+            "description": "tag just created",
             "schema": {
-              "$ref": "#/definitions/constraint"
+              "$ref": "#/definitions/tag"
             }
           },
           "default": {
@@ -2929,12 +6685,14 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/flags/{flagID}/tags/{tagID}": {
       "delete": {
         "tags": [
-          "constraint"
+          "tag"
         ],
-        "operationId": "deleteConstraint",
+        "operationId": "deleteTag",
         "parameters": [
           {
             "minimum": 1,
@@ -2949,17 +6707,8 @@ func init() {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the constraint",
-            "name": "constraintID",
+            "description": "numeric ID of the tag",
+            "name": "tagID",
             "in": "path",
             "required": true
           }
@@ -2977,12 +6726,12 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/distributions": {
+    "/flags/{flagID}/variants": {
       "get": {
         "tags": [
-          "distribution"
+          "variant"
         ],
-        "operationId": "findDistributions",
+        "operationId": "findVariants",
         "parameters": [
           {
             "minimum": 1,
@@ -2992,25 +6741,56 @@ func init() {
             "name": "flagID",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant ordered by variantID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/variant"
+              }
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "createVariant",
+        "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "create a variant",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createVariantRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "distribution under the segment",
+            "description": "variant just created",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/distribution"
-              }
+              "$ref": "#/definitions/variant"
             }
           },
           "default": {
@@ -3020,13 +6800,14 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/flags/{flagID}/variants/{variantID}": {
       "put": {
-        "description": "replace the distribution with the new setting",
         "tags": [
-          "distribution"
+          "variant"
         ],
-        "operationId": "putDistributions",
+        "operationId": "putVariant",
         "parameters": [
           {
             "minimum": 1,
@@ -3041,29 +6822,26 @@ func init() {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
+            "description": "numeric ID of the variant",
+            "name": "variantID",
             "in": "path",
             "required": true
           },
           {
-            "description": "array of distributions",
+            "description": "update a variant",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putDistributionsRequest"
+              "$ref": "#/definitions/putVariantRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "distribution under the segment",
+            "description": "variant just updated",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/distribution"
-              }
+              "$ref": "#/definitions/variant"
             }
           },
           "default": {
@@ -3073,57 +6851,57 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/flags/{flagID}/snapshots": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "flag"
+          "variant"
         ],
-        "operationId": "getFlagSnapshots",
+        "operationId": "deleteVariant",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
           },
           {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "the number of snapshots to return",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "return snapshots given the offset, it should usually set together with limit",
-            "name": "offset",
-            "in": "query"
+            "description": "numeric ID of the variant",
+            "name": "variantID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
           },
-          {
-            "enum": [
-              "ASC",
-              "DESC"
-            ],
-            "type": "string",
-            "description": "sort order",
-            "name": "sort",
-            "in": "query"
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
+        }
+      }
+    },
+    "/health": {
+      "get": {
+        "description": "Check if Flagr is healthy",
+        "tags": [
+          "health"
         ],
+        "operationId": "getHealth",
         "responses": {
           "200": {
-            "description": "returns the flag snapshots",
+            "description": "status of health check",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/flagSnapshot"
-              }
+              "$ref": "#/definitions/health"
             }
           },
           "default": {
@@ -3135,30 +6913,41 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/tags": {
+    "/idlists": {
       "get": {
         "tags": [
-          "tag"
+          "idList"
         ],
-        "operationId": "findTags",
+        "operationId": "findIDLists",
         "parameters": [
           {
-            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+            "description": "the numbers of ID lists to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return ID lists given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return ID lists matching given key",
+            "name": "key",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "tag ordered by tagID",
+            "description": "list all the ID lists",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/tag"
+                "$ref": "#/definitions/idList"
               }
             }
           },
@@ -3172,34 +6961,25 @@ func init() {
       },
       "post": {
         "tags": [
-          "tag"
+          "idList"
         ],
-        "operationId": "createTag",
+        "operationId": "createIDList",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a tag",
+            "description": "create an ID list",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createTagRequest"
+              "$ref": "#/definitions/createIDListRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "tag just created",
+            "description": "returns the created ID list",
             "schema": {
-              "$ref": "#/definitions/tag"
+              "$ref": "#/definitions/idList"
             }
           },
           "default": {
@@ -3211,35 +6991,58 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/tags/{tagID}": {
-      "delete": {
+    "/idlists/{idListID}": {
+      "get": {
         "tags": [
-          "tag"
+          "idList"
         ],
-        "operationId": "deleteTag",
+        "operationId": "getIDList",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
+            "description": "numeric ID of the ID list to get",
+            "name": "idListID",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the ID list",
+            "schema": {
+              "$ref": "#/definitions/idList"
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete the ID list and its entries. It fails if any constraint still references the ID list.",
+        "tags": [
+          "idList"
+        ],
+        "operationId": "deleteIDList",
+        "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the tag",
-            "name": "tagID",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "deleted"
+            "description": "OK deleted"
           },
           "default": {
             "description": "generic error response",
@@ -3250,31 +7053,42 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/variants": {
-      "get": {
+    "/idlists/{idListID}/entries": {
+      "put": {
+        "description": "Upload the entries of the ID list, replacing all the existing entries. The body is either CSV or one entry per line.",
+        "consumes": [
+          "text/plain",
+          "text/csv"
+        ],
         "tags": [
-          "variant"
+          "idList"
         ],
-        "operationId": "findVariants",
+        "operationId": "replaceIDListEntries",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "the entries to upload",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "variant ordered by variantID",
+            "description": "returns the ID list",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/variant"
-              }
+              "$ref": "#/definitions/idList"
             }
           },
           "default": {
@@ -3286,35 +7100,40 @@ func init() {
         }
       },
       "post": {
+        "description": "Append the entries to the ID list, the existing entries are kept. The body is either CSV or one entry per line.",
+        "consumes": [
+          "text/plain",
+          "text/csv"
+        ],
         "tags": [
-          "variant"
+          "idList"
         ],
-        "operationId": "createVariant",
+        "operationId": "appendIDListEntries",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
             "in": "path",
             "required": true
           },
           {
-            "description": "create a variant",
+            "description": "the entries to append",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createVariantRequest"
+              "type": "string"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "variant just created",
+            "description": "returns the ID list",
             "schema": {
-              "$ref": "#/definitions/variant"
+              "$ref": "#/definitions/idList"
             }
           },
           "default": {
@@ -3324,48 +7143,42 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/flags/{flagID}/variants/{variantID}": {
-      "put": {
+      },
+      "delete": {
+        "description": "Remove the entries from the ID list. The body is either CSV or one entry per line.",
+        "consumes": [
+          "text/plain",
+          "text/csv"
+        ],
         "tags": [
-          "variant"
+          "idList"
         ],
-        "operationId": "putVariant",
+        "operationId": "removeIDListEntries",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the variant",
-            "name": "variantID",
+            "description": "numeric ID of the ID list",
+            "name": "idListID",
             "in": "path",
             "required": true
           },
           {
-            "description": "update a variant",
+            "description": "the entries to remove",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putVariantRequest"
+              "type": "string"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "variant just updated",
+            "description": "returns the ID list",
             "schema": {
-              "$ref": "#/definitions/variant"
+              "$ref": "#/definitions/idList"
             }
           },
           "default": {
@@ -3375,35 +7188,52 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/ofrep/v1/evaluate/flags": {
+      "post": {
+        "description": "evaluates all the enabled and client visible flags for the context with the OpenFeature Remote Evaluation Protocol (OFREP), e.g. for the OpenFeature SDKs of the static context paradigm to cache the flags. The ETag of the response is the hash of the results, and the request with a matching If-None-Match header gets 304. The results are not logged or recorded as data records.",
         "tags": [
-          "variant"
+          "ofrep"
         ],
-        "operationId": "deleteVariant",
+        "operationId": "postOfrepEvaluateFlags",
         "parameters": [
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+            "type": "string",
+            "description": "the ETag of the results the client has",
+            "name": "If-None-Match",
+            "in": "header"
           },
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the variant",
-            "name": "variantID",
-            "in": "path",
-            "required": true
+            "description": "OFREP evaluation request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ofrepEvaluationRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "deleted"
+            "description": "the evaluation results of the flags",
+            "schema": {
+              "$ref": "#/definitions/ofrepBulkEvaluationResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string"
+              }
+            }
+          },
+          "304": {
+            "description": "the results are the same as the ones of the If-None-Match header"
+          },
+          "400": {
+            "description": "the evaluation failed, e.g. an invalid context",
+            "schema": {
+              "$ref": "#/definitions/ofrepBulkEvaluationFailure"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -3414,18 +7244,49 @@ func init() {
         }
       }
     },
-    "/health": {
-      "get": {
-        "description": "Check if Flagr is healthy",
+    "/ofrep/v1/evaluate/flags/{key}": {
+      "post": {
+        "description": "evaluates the flag for the context with the OpenFeature Remote Evaluation Protocol (OFREP), so that any OpenFeature SDK with the OFREP provider can evaluate the flags of flagr. The base URL of the provider is the base path of the APIs, e.g. http://localhost:18000/api/v1. Only the client visible flags are evaluated, the others are not found.",
         "tags": [
-          "health"
+          "ofrep"
+        ],
+        "operationId": "postOfrepEvaluateFlag",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "the flag key",
+            "name": "key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "OFREP evaluation request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ofrepEvaluationRequest"
+            }
+          }
         ],
-        "operationId": "getHealth",
         "responses": {
           "200": {
-            "description": "status of health check",
+            "description": "the evaluation result of the flag",
             "schema": {
-              "$ref": "#/definitions/health"
+              "$ref": "#/definitions/ofrepEvaluationResult"
+            }
+          },
+          "400": {
+            "description": "the evaluation failed, e.g. an invalid context or a variant value mismatching the flag's valueType",
+            "schema": {
+              "$ref": "#/definitions/ofrepEvaluationResult"
+            }
+          },
+          "404": {
+            "description": "the flag is not found or not client visible",
+            "schema": {
+              "$ref": "#/definitions/ofrepEvaluationResult"
             }
           },
           "default": {
@@ -3486,6 +7347,42 @@ func init() {
     }
   },
   "definitions": {
+    "audience": {
+      "type": "object",
+      "required": [
+        "key",
+        "description"
+      ],
+      "properties": {
+        "constraintGroups": {
+          "description": "groups of constraints with AND/OR/NOT nesting, they are joined with AND together with the constraints",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraint"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
     "constraint": {
       "type": "object",
       "required": [
@@ -3515,7 +7412,17 @@ func init() {
             "IN",
             "NOTIN",
             "CONTAINS",
-            "NOTCONTAINS"
+            "NOTCONTAINS",
+            "SEMVER_EQ",
+            "SEMVER_GT",
+            "SEMVER_GTE",
+            "SEMVER_LT",
+            "SEMVER_LTE",
+            "BEFORE",
+            "AFTER",
+            "BETWEEN",
+            "IN_LIST",
+            "NOT_IN_LIST"
           ]
         },
         "property": {
@@ -3528,6 +7435,107 @@ func init() {
         }
       }
     },
+    "constraintGroup": {
+      "description": "a group of constraints and nested groups joined with the operator, the result is negated if negate is true",
+      "type": "object",
+      "required": [
+        "operator"
+      ],
+      "properties": {
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
+          }
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "negate": {
+          "type": "boolean"
+        },
+        "operator": {
+          "type": "string",
+          "enum": [
+            "AND",
+            "OR"
+          ]
+        }
+      }
+    },
+    "constraintTrace": {
+      "type": "object",
+      "properties": {
+        "actual": {
+          "description": "the value of the property in the entity context",
+          "x-nullable": true
+        },
+        "audienceKey": {
+          "description": "the key of the audience of the constraint, empty for the constraints of the segment",
+          "type": "string"
+        },
+        "group": {
+          "description": "the path of the constraint group of the constraint, e.g. constraintGroups[0].groups[1], empty for the flat constraints",
+          "type": "string"
+        },
+        "msg": {
+          "type": "string"
+        },
+        "operator": {
+          "type": "string"
+        },
+        "property": {
+          "type": "string"
+        },
+        "result": {
+          "description": "MISSING_PROPERTY if the property is missing in the entity context, and WRONG_TYPE if the property cannot be evaluated by the operator, e.g. a string compared with a number",
+          "type": "string",
+          "enum": [
+            "MATCH",
+            "NOT_MATCH",
+            "MISSING_PROPERTY",
+            "WRONG_TYPE",
+            "ERROR"
+          ]
+        },
+        "value": {
+          "description": "the expected value of the constraint",
+          "type": "string"
+        }
+      }
+    },
+    "createAudienceRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "constraintGroups": {
+          "description": "groups of constraints with AND/OR/NOT nesting, they are joined with AND together with the constraints",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
     "createConstraintRequest": {
       "type": "object",
       "required": [
@@ -3558,15 +7566,106 @@ func init() {
       "properties": {
         "description": {
           "type": "string",
-          "minLength": 1
+          "minLength": 1
+        },
+        "key": {
+          "description": "unique key representation of the flag",
+          "type": "string"
+        },
+        "template": {
+          "description": "template for flag creation",
+          "type": "string"
+        }
+      }
+    },
+    "createIDListRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the ID list",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createRolloutRampRequest": {
+      "type": "object",
+      "required": [
+        "steps",
+        "stepIntervalSeconds"
+      ],
+      "properties": {
+        "startAt": {
+          "description": "when to apply the first step, it's applied right away if it's not set",
+          "type": "string",
+          "format": "date-time"
+        },
+        "stepIntervalSeconds": {
+          "description": "the time between two steps",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "steps": {
+          "description": "the increasing rollout percents of the steps, e.g. [1, 5, 25, 50, 100]",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "number",
+            "format": "double",
+            "maximum": 100,
+            "minimum": 0
+          }
+        }
+      }
+    },
+    "createScheduledChangeRequest": {
+      "type": "object",
+      "required": [
+        "executeAt",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "ENABLE_FLAG",
+            "DISABLE_FLAG",
+            "SET_ROLLOUT_PERCENT",
+            "SET_DISTRIBUTIONS"
+          ]
+        },
+        "distributions": {
+          "description": "the distributions of SET_DISTRIBUTIONS",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/distribution"
+          }
+        },
+        "executeAt": {
+          "description": "when to apply the change, it needs to be in the future",
+          "type": "string",
+          "format": "date-time"
         },
-        "key": {
-          "description": "unique key representation of the flag",
-          "type": "string"
+        "rolloutPercent": {
+          "description": "the rollout percent of SET_ROLLOUT_PERCENT",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "minimum": 0,
+          "x-nullable": true
         },
-        "template": {
-          "description": "template for flag creation",
-          "type": "string"
+        "segmentID": {
+          "description": "the segment of SET_ROLLOUT_PERCENT and SET_DISTRIBUTIONS",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         }
       }
     },
@@ -3577,13 +7676,45 @@ func init() {
         "rolloutPercent"
       ],
       "properties": {
+        "audienceIDs": {
+          "description": "the audiences the entity needs to be in, together with the constraints of the segment",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        },
+        "bucketBy": {
+          "description": "overrides the flag's bucketBy if it's not empty",
+          "type": "string"
+        },
+        "constraintGroups": {
+          "description": "groups of constraints with AND/OR/NOT nesting, they are joined with AND together with the constraints",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "defaultVariantID": {
+          "description": "the variant returned when the entity matches the segment but misses the rollout. 0 means no default variant.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
         "description": {
           "type": "string",
           "minLength": 1
         },
+        "prerequisites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/prerequisite"
+          }
+        },
         "rolloutPercent": {
-          "type": "integer",
-          "format": "int64",
+          "type": "number",
+          "format": "double",
           "maximum": 100,
           "minimum": 0
         }
@@ -3601,6 +7732,33 @@ func init() {
         }
       }
     },
+    "createVariantOverrideRequest": {
+      "type": "object",
+      "required": [
+        "entityID",
+        "variantID"
+      ],
+      "properties": {
+        "entityID": {
+          "type": "string",
+          "maxLength": 255,
+          "minLength": 1
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
     "createVariantRequest": {
       "type": "object",
       "required": [
@@ -3631,8 +7789,8 @@ func init() {
           "readOnly": true
         },
         "percent": {
-          "type": "integer",
-          "format": "int64",
+          "type": "number",
+          "format": "double",
           "maximum": 100,
           "minimum": 0
         },
@@ -3746,13 +7904,38 @@ func init() {
           },
           "x-omitempty": true
         },
+        "reason": {
+          "description": "why the entity got the result, it's always set regardless of enableDebug. FLAG_NOT_FOUND, FLAG_DISABLED and NO_SEGMENTS are about the flag. NO_MATCH means the entity matched no segment, and ERROR means no segment matched and the evaluation of a segment failed, e.g. an invalid entityContext or a missing audience. ROLLOUT_EXCLUDED means the entity matched a segment but is not in its rollout. MATCHED means the entity got the variant of the matched segment, and OVERRIDE means the variant is from a variant override. STICKY means the variant is the sticky assignment of the entity.",
+          "type": "string",
+          "enum": [
+            "FLAG_NOT_FOUND",
+            "FLAG_DISABLED",
+            "NO_SEGMENTS",
+            "NO_MATCH",
+            "ROLLOUT_EXCLUDED",
+            "MATCHED",
+            "OVERRIDE",
+            "STICKY",
+            "ERROR"
+          ]
+        },
         "segmentID": {
           "type": "integer",
           "format": "int64"
         },
+        "segmentRank": {
+          "description": "the rank of the matched segment, it's only set for MATCHED and ROLLOUT_EXCLUDED",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
         "timestamp": {
           "type": "string"
         },
+        "valueType": {
+          "description": "the declared value type of the flag, it's empty if the flag is untyped",
+          "type": "string"
+        },
         "variantAttachment": {
           "type": "object"
         },
@@ -3792,7 +7975,16 @@ func init() {
           }
         },
         "flagKeys": {
-          "description": "flagKeys. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.",
+          "description": "flagKeys. Either flagIDs, flagKeys or flagTags works. A flag selected multiple times is only evaluated once.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "flagTags": {
+          "description": "flagTags. Either flagIDs, flagKeys or flagTags works. A flag selected multiple times is only evaluated once.",
           "type": "array",
           "minItems": 1,
           "items": {
@@ -3800,51 +7992,301 @@ func init() {
             "minLength": 1
           }
         },
-        "flagTags": {
-          "description": "flagTags. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.",
+        "flagTagsOperator": {
+          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
+          "type": "string",
+          "default": "ANY",
+          "enum": [
+            "ANY",
+            "ALL"
+          ]
+        },
+        "responseFormat": {
+          "description": "LIST returns the flat list of evaluationResults. KEYED returns keyedEvaluationResults, the results keyed by entityID and then flagKey, which requires unique and non-empty entityIDs.",
+          "type": "string",
+          "default": "LIST",
+          "enum": [
+            "LIST",
+            "KEYED"
+          ]
+        }
+      }
+    },
+    "evaluationBatchResponse": {
+      "type": "object",
+      "required": [
+        "evaluationResults"
+      ],
+      "properties": {
+        "evaluationResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evalResult"
+          }
+        },
+        "keyedEvaluationResults": {
+          "description": "the results keyed by entityID and then flagKey if the responseFormat is KEYED",
+          "type": "object",
+          "additionalProperties": {
+            "type": "object",
+            "additionalProperties": {
+              "$ref": "#/definitions/evalResult"
+            }
+          }
+        }
+      }
+    },
+    "evaluationBootstrapRequest": {
+      "type": "object",
+      "properties": {
+        "clientVisibleOnly": {
+          "description": "only evaluates the flags marked as clientVisible",
+          "type": "boolean"
+        },
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "flagTags": {
+          "description": "only evaluates the flags with the flagTags if it's not empty",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "flagTagsOperator": {
+          "description": "ANY evaluates the flags with any of the flagTags, and ALL evaluates the flags with all of them",
+          "type": "string",
+          "default": "ANY",
+          "enum": [
+            "ANY",
+            "ALL"
+          ]
+        }
+      }
+    },
+    "evaluationBootstrapResponse": {
+      "description": "the assignments keyed by flagKey",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/flagAssignment"
+      }
+    },
+    "evaluationDryRunRequest": {
+      "type": "object",
+      "required": [
+        "flag",
+        "entities"
+      ],
+      "properties": {
+        "entities": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/evaluationEntity"
+          }
+        },
+        "flag": {
+          "description": "the flag definition in the same format as the flag returned by GET /flags/{flagID}, including the IDs of the segments and the variants referenced by the distributions. The flag id is the salt of the rollouts, so it has to be set to get the same results as a saved flag. Audiences, ID lists and prerequisite flags are looked up from the saved ones.",
+          "type": "object"
+        }
+      }
+    },
+    "evaluationDryRunResponse": {
+      "type": "object",
+      "required": [
+        "evaluationResults"
+      ],
+      "properties": {
+        "evaluationResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evalResult"
+          }
+        }
+      }
+    },
+    "evaluationEntity": {
+      "type": "object",
+      "properties": {
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        }
+      }
+    },
+    "evaluationSimulationRequest": {
+      "type": "object",
+      "required": [
+        "sample"
+      ],
+      "properties": {
+        "flag": {
+          "description": "the current or proposed flag definition to simulate, in the same format as the flag of the evaluation dry run",
+          "type": "object"
+        },
+        "flagID": {
+          "description": "the ID of the live flag to compare with. It's the id of the flag definition if it's not set, and the live flag itself is simulated if there's no flag definition.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "sample": {
+          "description": "the sample of entities. Each line of JSONL is an evaluationEntity, e.g. {\"entityID\": \"1\", \"entityContext\": {\"state\": \"CA\"}}. The header of CSV has the entityID column, the optional entityType column, and the properties of the entityContext, whose numbers and booleans are parsed as JSON. Every entity needs the entityID, so that it's bucketed the same way as in production.",
+          "type": "string",
+          "minLength": 1
+        },
+        "sampleFormat": {
+          "type": "string",
+          "default": "JSONL",
+          "enum": [
+            "JSONL",
+            "CSV"
+          ]
+        }
+      }
+    },
+    "evaluationSimulationResponse": {
+      "type": "object",
+      "required": [
+        "total",
+        "reasons",
+        "segments",
+        "variants",
+        "noVariant",
+        "liveFlagFound",
+        "changed",
+        "transitions"
+      ],
+      "properties": {
+        "changed": {
+          "description": "the number of the entities getting a different variant key than from the live flag",
+          "type": "integer",
+          "format": "int64"
+        },
+        "liveFlagFound": {
+          "description": "the live flag is found in the evaluation cache, otherwise changed and transitions are empty",
+          "type": "boolean"
+        },
+        "noVariant": {
+          "description": "the number of the entities without a variant",
+          "type": "integer",
+          "format": "int64"
+        },
+        "reasons": {
+          "description": "the number of the entities keyed by the reason of their results",
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int64"
+          }
+        },
+        "segments": {
+          "description": "the segments of the flag in the order of their ranks",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulationSegment"
+          }
+        },
+        "total": {
+          "description": "the number of the entities in the sample",
+          "type": "integer",
+          "format": "int64"
+        },
+        "transitions": {
+          "description": "the changes of the variant keys from the live flag, the most frequent first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulationTransition"
+          }
+        },
+        "variants": {
+          "description": "the variants of the flag",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulationVariant"
+          }
+        }
+      }
+    },
+    "evaluationSnapshotRequest": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "entities"
+      ],
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entities": {
           "type": "array",
           "minItems": 1,
           "items": {
-            "type": "string",
-            "minLength": 1
+            "$ref": "#/definitions/evaluationEntity"
           }
         },
-        "flagTagsOperator": {
-          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagSnapshotID": {
+          "description": "the ID of the snapshot of the flag to evaluate. Either flagSnapshotID or timestamp works.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "timestamp": {
+          "description": "evaluates the latest snapshot of the flag saved at or before the timestamp, at the time of the timestamp",
           "type": "string",
-          "default": "ANY",
-          "enum": [
-            "ANY",
-            "ALL"
-          ]
+          "format": "date-time",
+          "x-nullable": true
         }
       }
     },
-    "evaluationBatchResponse": {
+    "evaluationSnapshotResponse": {
       "type": "object",
       "required": [
+        "flagSnapshotID",
+        "evaluatedAt",
         "evaluationResults"
       ],
       "properties": {
+        "evaluatedAt": {
+          "description": "the time the entities are evaluated at, i.e. the timestamp of the request, or the time of the snapshot found by flagSnapshotID. The built-in now property of the constraints and the expiry of the overrides are evaluated at this time.",
+          "type": "string",
+          "format": "date-time"
+        },
         "evaluationResults": {
           "type": "array",
           "items": {
             "$ref": "#/definitions/evalResult"
           }
-        }
-      }
-    },
-    "evaluationEntity": {
-      "type": "object",
-      "properties": {
-        "entityContext": {
-          "type": "object"
         },
-        "entityID": {
-          "type": "string"
+        "flagSnapshotID": {
+          "description": "the ID of the evaluated snapshot",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         },
-        "entityType": {
-          "type": "string"
+        "warnings": {
+          "description": "the parts of the evaluation that are not historical, i.e. the audiences, the ID lists and the prerequisite flags referenced by the snapshot are the current ones",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
@@ -3856,6 +8298,19 @@ func init() {
         "dataRecordsEnabled"
       ],
       "properties": {
+        "assignmentSalt": {
+          "description": "the salt of the sticky assignments, resetting it drops all the sticky assignments of the flag",
+          "type": "string",
+          "readOnly": true
+        },
+        "bucketBy": {
+          "description": "comma separated entityContext properties used as the bucketing key for rollouts, e.g. \"org_id\". If it's empty, entityID is used.",
+          "type": "string"
+        },
+        "clientVisible": {
+          "description": "client visible flags are evaluated by the bootstrap evaluation with clientVisibleOnly and by OFREP",
+          "type": "boolean"
+        },
         "createdBy": {
           "type": "string"
         },
@@ -3863,6 +8318,12 @@ func init() {
           "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
           "type": "boolean"
         },
+        "defaultVariantID": {
+          "description": "the variant returned when the flag is disabled, has no segments, or no segment matches. 0 means no default variant.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
         "description": {
           "type": "string",
           "minLength": 1
@@ -3874,6 +8335,17 @@ func init() {
           "description": "it will override the entityType in the evaluation logs if it's not empty",
           "type": "string"
         },
+        "hashAlgorithm": {
+          "description": "hash algorithm used for the consistent hashing of rollouts. crc32 is the default and it only supports 0.1% granularity of percents. Other algorithms support 0.01% (basis point) granularity.",
+          "type": "string",
+          "default": "crc32",
+          "enum": [
+            "crc32",
+            "murmur3",
+            "xxhash",
+            "sha1"
+          ]
+        },
         "id": {
           "type": "integer",
           "format": "int64",
@@ -3895,6 +8367,10 @@ func init() {
             "$ref": "#/definitions/segment"
           }
         },
+        "stickyAssignments": {
+          "description": "an entity keeps the variant it first gets from a segment, even if the segments or the distributions change, until the assignment salt is reset",
+          "type": "boolean"
+        },
         "tags": {
           "type": "array",
           "items": {
@@ -3908,6 +8384,14 @@ func init() {
         "updatedBy": {
           "type": "string"
         },
+        "valueSchema": {
+          "description": "the optional JSON Schema that the flag value of the variants has to match",
+          "type": "object"
+        },
+        "valueType": {
+          "description": "the type of the flag value, one of boolean, string, number and object. The attachments of the variants of boolean, string and number flags hold the value in the \"value\" key, e.g. {\"value\": true}. The flag is untyped if it's empty.",
+          "type": "string"
+        },
         "variants": {
           "type": "array",
           "items": {
@@ -3916,6 +8400,76 @@ func init() {
         }
       }
     },
+    "flagAssignment": {
+      "type": "object",
+      "properties": {
+        "attachment": {
+          "type": "object"
+        },
+        "variantKey": {
+          "description": "empty if the flag has no variant for the entity",
+          "type": "string"
+        }
+      }
+    },
+    "flagChange": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "flagKey",
+        "flagSnapshotID",
+        "deleted"
+      ],
+      "properties": {
+        "deleted": {
+          "description": "the flag is deleted, or it's not in the evaluation cache anymore",
+          "type": "boolean"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "flagSnapshotID": {
+          "description": "the snapshot of the flag picked up by the evaluation cache",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "flagChanges": {
+      "type": "object",
+      "required": [
+        "flags"
+      ],
+      "properties": {
+        "audienceKeys": {
+          "description": "the keys of the audiences added, changed or deleted",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "flags": {
+          "description": "the changed flags, including the ones whose audiences or ID lists changed, which keep their flagSnapshotID",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/flagChange"
+          }
+        },
+        "idListKeys": {
+          "description": "the keys of the ID lists added, changed or deleted, e.g. their entries",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
     "flagSnapshot": {
       "type": "object",
       "required": [
@@ -3950,6 +8504,171 @@ func init() {
         }
       }
     },
+    "idList": {
+      "type": "object",
+      "required": [
+        "key",
+        "description"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "entryCount": {
+          "description": "the number of entries in the ID list",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the ID list, which is the value of the IN_LIST and NOT_IN_LIST constraints",
+          "type": "string",
+          "minLength": 1
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "ofrepBulkEvaluationFailure": {
+      "type": "object",
+      "required": [
+        "errorCode"
+      ],
+      "properties": {
+        "errorCode": {
+          "type": "string",
+          "enum": [
+            "INVALID_CONTEXT",
+            "GENERAL"
+          ]
+        },
+        "errorDetails": {
+          "type": "string"
+        }
+      }
+    },
+    "ofrepBulkEvaluationResponse": {
+      "type": "object",
+      "required": [
+        "flags"
+      ],
+      "properties": {
+        "flags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ofrepEvaluationResult"
+          }
+        }
+      }
+    },
+    "ofrepEvaluationRequest": {
+      "type": "object",
+      "properties": {
+        "context": {
+          "description": "the evaluation context of OpenFeature. The targetingKey is the entityID, and the other attributes are the entityContext.",
+          "type": "object"
+        }
+      }
+    },
+    "ofrepEvaluationResult": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "errorCode": {
+          "description": "the OpenFeature error code if the evaluation failed",
+          "type": "string",
+          "enum": [
+            "FLAG_NOT_FOUND",
+            "TYPE_MISMATCH",
+            "INVALID_CONTEXT",
+            "GENERAL"
+          ]
+        },
+        "errorDetails": {
+          "type": "string"
+        },
+        "key": {
+          "description": "the flag key",
+          "type": "string"
+        },
+        "metadata": {
+          "description": "the flagID, flagSnapshotID, segmentID, variantID and flagrReason of the evaluation",
+          "type": "object"
+        },
+        "reason": {
+          "description": "the OpenFeature reason. TARGETING_MATCH means the entity got the variant of a matched segment or a variant override, and DEFAULT means it got a default variant. The flagr reason is in the metadata. A disabled flag is FLAG_NOT_FOUND, and a result without a variant is GENERAL, with the flagr reason in the errorDetails.",
+          "type": "string"
+        },
+        "value": {
+          "description": "the flag value of the variant. It's the value in the attachment for the boolean, string and number flags, and the attachment for the object flags. For the untyped flags, it's the value of an attachment with only the \"value\" key, the attachment if it's not empty, or the variant key otherwise."
+        },
+        "variant": {
+          "description": "the variant key, it's empty if the entity has no variant",
+          "type": "string"
+        }
+      }
+    },
+    "prerequisite": {
+      "description": "the entity needs to get one of the variantKeys of the flag with flagKey to match the segment",
+      "type": "object",
+      "required": [
+        "flagKey",
+        "variantKeys"
+      ],
+      "properties": {
+        "flagKey": {
+          "type": "string",
+          "minLength": 1
+        },
+        "variantKeys": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "putAudienceRequest": {
+      "type": "object",
+      "properties": {
+        "constraintGroups": {
+          "description": "constraint groups of the audience. If it's not set, the constraint groups stay the same.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "constraints": {
+          "description": "constraints of the audience. If it's not set, the constraints stay the same.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
+          }
+        },
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        }
+      }
+    },
     "putDistributionsRequest": {
       "type": "object",
       "required": [
@@ -3967,11 +8686,28 @@ func init() {
     "putFlagRequest": {
       "type": "object",
       "properties": {
+        "bucketBy": {
+          "description": "comma separated entityContext properties used as the bucketing key for rollouts",
+          "type": "string",
+          "x-nullable": true
+        },
+        "clientVisible": {
+          "description": "client visible flags are evaluated by the bootstrap evaluation with clientVisibleOnly and by OFREP",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "dataRecordsEnabled": {
           "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
           "type": "boolean",
           "x-nullable": true
         },
+        "defaultVariantID": {
+          "description": "the variant returned when the flag is disabled, has no segments, or no segment matches. 0 clears it.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
         "description": {
           "type": "string",
           "minLength": 1,
@@ -3981,9 +8717,20 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
-        "entityType": {
-          "description": "it will overwrite entityType into evaluation logs if it's not empty",
+        "entityType": {
+          "description": "it will overwrite entityType into evaluation logs if it's not empty",
+          "type": "string",
+          "x-nullable": true
+        },
+        "hashAlgorithm": {
+          "description": "hash algorithm used for the consistent hashing of rollouts",
           "type": "string",
+          "enum": [
+            "crc32",
+            "murmur3",
+            "xxhash",
+            "sha1"
+          ],
           "x-nullable": true
         },
         "key": {
@@ -3993,6 +8740,20 @@ func init() {
         "notes": {
           "type": "string",
           "x-nullable": true
+        },
+        "stickyAssignments": {
+          "description": "an entity keeps the variant it first gets from a segment until the assignment salt is reset",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "valueSchema": {
+          "description": "the JSON Schema of the flag value. An empty object clears it.",
+          "type": "object"
+        },
+        "valueType": {
+          "description": "the type of the flag value, one of boolean, string, number and object. Empty makes the flag untyped.",
+          "type": "string",
+          "x-nullable": true
         }
       }
     },
@@ -4020,13 +8781,48 @@ func init() {
         "rolloutPercent"
       ],
       "properties": {
+        "audienceIDs": {
+          "description": "audiences of the segment. If it's not set, the audiences stay the same.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        },
+        "bucketBy": {
+          "description": "overrides the flag's bucketBy if it's not empty",
+          "type": "string",
+          "x-nullable": true
+        },
+        "constraintGroups": {
+          "description": "constraint groups of the segment. If it's not set, the constraint groups stay the same.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
+        "defaultVariantID": {
+          "description": "the variant returned when the entity matches the segment but misses the rollout. 0 clears it.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
         "description": {
           "type": "string",
           "minLength": 1
         },
+        "prerequisites": {
+          "description": "prerequisites of the segment. If it's not set, the prerequisites stay the same.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/prerequisite"
+          }
+        },
         "rolloutPercent": {
-          "type": "integer",
-          "format": "int64",
+          "type": "number",
+          "format": "double",
           "maximum": 100,
           "minimum": 0
         }
@@ -4047,6 +8843,148 @@ func init() {
         }
       }
     },
+    "rolloutRamp": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "segmentID",
+        "steps",
+        "stepIntervalSeconds",
+        "currentStep",
+        "status"
+      ],
+      "properties": {
+        "createdBy": {
+          "type": "string"
+        },
+        "currentStep": {
+          "description": "the number of the steps applied",
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "the reason of the FAILED status",
+          "type": "string"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "nextStepAt": {
+          "description": "when the next step is due, it's empty if the ramp is not active",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "ACTIVE",
+            "PAUSED",
+            "COMPLETED",
+            "ABORTED",
+            "FAILED"
+          ]
+        },
+        "stepIntervalSeconds": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "steps": {
+          "description": "the rollout percents of the steps in order",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "double"
+          }
+        }
+      }
+    },
+    "scheduledChange": {
+      "type": "object",
+      "required": [
+        "flagID",
+        "executeAt",
+        "action",
+        "status"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "ENABLE_FLAG",
+            "DISABLE_FLAG",
+            "SET_ROLLOUT_PERCENT",
+            "SET_DISTRIBUTIONS"
+          ]
+        },
+        "appliedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "distributions": {
+          "description": "the distributions of SET_DISTRIBUTIONS",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/distribution"
+          }
+        },
+        "error": {
+          "description": "the reason of the FAILED status",
+          "type": "string"
+        },
+        "executeAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "rolloutPercent": {
+          "description": "the rollout percent of SET_ROLLOUT_PERCENT",
+          "type": "number",
+          "format": "double"
+        },
+        "segmentID": {
+          "description": "the segment of SET_ROLLOUT_PERCENT and SET_DISTRIBUTIONS",
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "PENDING",
+            "APPLIED",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
     "segment": {
       "type": "object",
       "required": [
@@ -4055,12 +8993,38 @@ func init() {
         "rolloutPercent"
       ],
       "properties": {
+        "audienceIDs": {
+          "description": "the audiences the entity needs to be in, together with the constraints of the segment",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        },
+        "bucketBy": {
+          "description": "overrides the flag's bucketBy if it's not empty",
+          "type": "string"
+        },
+        "constraintGroups": {
+          "description": "groups of constraints with AND/OR/NOT nesting, they are joined with AND together with the constraints",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintGroup"
+          }
+        },
         "constraints": {
           "type": "array",
           "items": {
             "$ref": "#/definitions/constraint"
           }
         },
+        "defaultVariantID": {
+          "description": "the variant returned when the entity matches the segment but misses the rollout. 0 means no default variant.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
         "description": {
           "type": "string",
           "minLength": 1
@@ -4077,14 +9041,20 @@ func init() {
           "minimum": 1,
           "readOnly": true
         },
+        "prerequisites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/prerequisite"
+          }
+        },
         "rank": {
           "type": "integer",
           "format": "int64",
           "minimum": 0
         },
         "rolloutPercent": {
-          "type": "integer",
-          "format": "int64",
+          "type": "number",
+          "format": "double",
           "maximum": 100,
           "minimum": 0
         }
@@ -4093,6 +9063,13 @@ func init() {
     "segmentDebugLog": {
       "type": "object",
       "properties": {
+        "constraintTraces": {
+          "description": "the result of each constraint of the segment and its audiences, only present when enableDebug is true",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintTrace"
+          }
+        },
         "msg": {
           "type": "string"
         },
@@ -4114,6 +9091,81 @@ func init() {
         }
       }
     },
+    "simulationSegment": {
+      "type": "object",
+      "required": [
+        "segmentID",
+        "rank",
+        "matched",
+        "rolloutExcluded"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "matched": {
+          "description": "the number of the entities getting a variant from the segment",
+          "type": "integer",
+          "format": "int64"
+        },
+        "rank": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rolloutExcluded": {
+          "description": "the number of the entities matching the segment but not in its rollout",
+          "type": "integer",
+          "format": "int64"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "simulationTransition": {
+      "type": "object",
+      "required": [
+        "fromVariantKey",
+        "toVariantKey",
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "fromVariantKey": {
+          "description": "the variant key from the live flag, empty if there's no variant",
+          "type": "string"
+        },
+        "toVariantKey": {
+          "description": "the variant key from the simulated flag, empty if there's no variant",
+          "type": "string"
+        }
+      }
+    },
+    "simulationVariant": {
+      "type": "object",
+      "required": [
+        "variantID",
+        "variantKey",
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantKey": {
+          "type": "string"
+        }
+      }
+    },
     "tag": {
       "type": "object",
       "required": [
@@ -4152,6 +9204,47 @@ func init() {
           "minLength": 1
         }
       }
+    },
+    "variantOverride": {
+      "type": "object",
+      "required": [
+        "entityID",
+        "variantID"
+      ],
+      "properties": {
+        "createdBy": {
+          "type": "string"
+        },
+        "entityID": {
+          "type": "string",
+          "minLength": 1
+        },
+        "entityType": {
+          "description": "the override only applies to this entityType if it's set",
+          "type": "string"
+        },
+        "expiresAt": {
+          "description": "the override no longer applies since this time",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
     }
   },
   "tags": [
@@ -4167,10 +9260,30 @@ func init() {
       "description": "Constraint is the unit of defining a small subset of users",
       "name": "constraint"
     },
+    {
+      "description": "Audience is a named set of constraints that can be shared by segments across flags",
+      "name": "audience"
+    },
+    {
+      "description": "ID list is a managed list of entity IDs that constraints can reference with IN_LIST and NOT_IN_LIST",
+      "name": "idList"
+    },
     {
       "description": "Distribution is the percent distribution of variants within that segment",
       "name": "distribution"
     },
+    {
+      "description": "Rollout ramp advances the rollout percent of a segment through the steps automatically",
+      "name": "rolloutRamp"
+    },
+    {
+      "description": "Variant override pins an entity to a variant of the flag",
+      "name": "variantOverride"
+    },
+    {
+      "description": "Scheduled change is a change of the flag applied by the scheduler at a given time",
+      "name": "scheduledChange"
+    },
     {
       "description": "Variants are the possible outcomes of flag evaluation",
       "name": "variant"
@@ -4179,6 +9292,10 @@ func init() {
       "description": "Evaluation is the process of evaluating a flag given the entity context",
       "name": "evaluation"
     },
+    {
+      "description": "OpenFeature Remote Evaluation Protocol (OFREP) of the flag evaluation",
+      "name": "ofrep"
+    },
     {
       "description": "Check if Flagr is healthy",
       "name": "health"
@@ -4191,15 +9308,21 @@ func init() {
         "flag",
         "segment",
         "constraint",
+        "audience",
+        "idList",
         "distribution",
         "variant",
-        "tag"
+        "tag",
+        "variantOverride",
+        "scheduledChange",
+        "rolloutRamp"
       ]
     },
     {
       "name": "Flag Evaluation",
       "tags": [
-        "evaluation"
+        "evaluation",
+        "ofrep"
       ]
     },
     {
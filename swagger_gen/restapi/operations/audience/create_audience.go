@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreateAudienceHandlerFunc turns a function with the right signature into a create audience handler
+type CreateAudienceHandlerFunc func(CreateAudienceParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateAudienceHandlerFunc) Handle(params CreateAudienceParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateAudienceHandler interface for that can handle valid create audience params
+type CreateAudienceHandler interface {
+	Handle(CreateAudienceParams) middleware.Responder
+}
+
+// NewCreateAudience creates a new http.Handler for the create audience operation
+func NewCreateAudience(ctx *middleware.Context, handler CreateAudienceHandler) *CreateAudience {
+	return &CreateAudience{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateAudience swagger:route POST /audiences audience createAudience
+
+CreateAudience create audience API
+*/
+type CreateAudience struct {
+	Context *middleware.Context
+	Handler CreateAudienceHandler
+}
+
+func (o *CreateAudience) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateAudienceParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// CreateAudienceOKCode is the HTTP code returned for type CreateAudienceOK
+const CreateAudienceOKCode int = 200
+
+/*
+CreateAudienceOK returns the created audience
+
+swagger:response createAudienceOK
+*/
+type CreateAudienceOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Audience `json:"body,omitempty"`
+}
+
+// NewCreateAudienceOK creates CreateAudienceOK with default headers values
+func NewCreateAudienceOK() *CreateAudienceOK {
+
+	return &CreateAudienceOK{}
+}
+
+// WithPayload adds the payload to the create audience o k response
+func (o *CreateAudienceOK) WithPayload(payload *models.Audience) *CreateAudienceOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create audience o k response
+func (o *CreateAudienceOK) SetPayload(payload *models.Audience) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateAudienceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+CreateAudienceDefault generic error response
+
+swagger:response createAudienceDefault
+*/
+type CreateAudienceDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateAudienceDefault creates CreateAudienceDefault with default headers values
+func NewCreateAudienceDefault(code int) *CreateAudienceDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateAudienceDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create audience default response
+func (o *CreateAudienceDefault) WithStatusCode(code int) *CreateAudienceDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create audience default response
+func (o *CreateAudienceDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create audience default response
+func (o *CreateAudienceDefault) WithPayload(payload *models.Error) *CreateAudienceDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create audience default response
+func (o *CreateAudienceDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateAudienceDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
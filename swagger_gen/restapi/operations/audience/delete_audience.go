@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteAudienceHandlerFunc turns a function with the right signature into a delete audience handler
+type DeleteAudienceHandlerFunc func(DeleteAudienceParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteAudienceHandlerFunc) Handle(params DeleteAudienceParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteAudienceHandler interface for that can handle valid delete audience params
+type DeleteAudienceHandler interface {
+	Handle(DeleteAudienceParams) middleware.Responder
+}
+
+// NewDeleteAudience creates a new http.Handler for the delete audience operation
+func NewDeleteAudience(ctx *middleware.Context, handler DeleteAudienceHandler) *DeleteAudience {
+	return &DeleteAudience{Context: ctx, Handler: handler}
+}
+
+/*
+	DeleteAudience swagger:route DELETE /audiences/{audienceID} audience deleteAudience
+
+DeleteAudience delete audience API
+*/
+type DeleteAudience struct {
+	Context *middleware.Context
+	Handler DeleteAudienceHandler
+}
+
+func (o *DeleteAudience) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteAudienceParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewDeleteAudienceParams creates a new DeleteAudienceParams object
+//
+// There are no default values defined in the spec.
+func NewDeleteAudienceParams() DeleteAudienceParams {
+
+	return DeleteAudienceParams{}
+}
+
+// DeleteAudienceParams contains all the bound params for the delete audience operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters deleteAudience
+type DeleteAudienceParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*numeric ID of the audience
+	  Required: true
+	  Minimum: 1
+	  In: path
+	*/
+	AudienceID int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewDeleteAudienceParams() beforehand.
+func (o *DeleteAudienceParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rAudienceID, rhkAudienceID, _ := route.Params.GetOK("audienceID")
+	if err := o.bindAudienceID(rAudienceID, rhkAudienceID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAudienceID binds and validates parameter AudienceID from path.
+func (o *DeleteAudienceParams) bindAudienceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("audienceID", "path", "int64", raw)
+	}
+	o.AudienceID = value
+
+	if err := o.validateAudienceID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateAudienceID carries on validations for parameter AudienceID
+func (o *DeleteAudienceParams) validateAudienceID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("audienceID", "path", o.AudienceID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
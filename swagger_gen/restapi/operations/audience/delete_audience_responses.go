@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// DeleteAudienceOKCode is the HTTP code returned for type DeleteAudienceOK
+const DeleteAudienceOKCode int = 200
+
+/*
+DeleteAudienceOK OK deleted
+
+swagger:response deleteAudienceOK
+*/
+type DeleteAudienceOK struct {
+}
+
+// NewDeleteAudienceOK creates DeleteAudienceOK with default headers values
+func NewDeleteAudienceOK() *DeleteAudienceOK {
+
+	return &DeleteAudienceOK{}
+}
+
+// WriteResponse to the client
+func (o *DeleteAudienceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+/*
+DeleteAudienceDefault generic error response
+
+swagger:response deleteAudienceDefault
+*/
+type DeleteAudienceDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteAudienceDefault creates DeleteAudienceDefault with default headers values
+func NewDeleteAudienceDefault(code int) *DeleteAudienceDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteAudienceDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete audience default response
+func (o *DeleteAudienceDefault) WithStatusCode(code int) *DeleteAudienceDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete audience default response
+func (o *DeleteAudienceDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete audience default response
+func (o *DeleteAudienceDefault) WithPayload(payload *models.Error) *DeleteAudienceDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete audience default response
+func (o *DeleteAudienceDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteAudienceDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// FindAudienceFlagsHandlerFunc turns a function with the right signature into a find audience flags handler
+type FindAudienceFlagsHandlerFunc func(FindAudienceFlagsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn FindAudienceFlagsHandlerFunc) Handle(params FindAudienceFlagsParams) middleware.Responder {
+	return fn(params)
+}
+
+// FindAudienceFlagsHandler interface for that can handle valid find audience flags params
+type FindAudienceFlagsHandler interface {
+	Handle(FindAudienceFlagsParams) middleware.Responder
+}
+
+// NewFindAudienceFlags creates a new http.Handler for the find audience flags operation
+func NewFindAudienceFlags(ctx *middleware.Context, handler FindAudienceFlagsHandler) *FindAudienceFlags {
+	return &FindAudienceFlags{Context: ctx, Handler: handler}
+}
+
+/*
+	FindAudienceFlags swagger:route GET /audiences/{audienceID}/flags audience findAudienceFlags
+
+Find the flags that reference the audience in any of their segments
+*/
+type FindAudienceFlags struct {
+	Context *middleware.Context
+	Handler FindAudienceFlagsHandler
+}
+
+func (o *FindAudienceFlags) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewFindAudienceFlagsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
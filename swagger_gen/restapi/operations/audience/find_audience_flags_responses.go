@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// FindAudienceFlagsOKCode is the HTTP code returned for type FindAudienceFlagsOK
+const FindAudienceFlagsOKCode int = 200
+
+/*
+FindAudienceFlagsOK list the flags using the audience
+
+swagger:response findAudienceFlagsOK
+*/
+type FindAudienceFlagsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Flag `json:"body,omitempty"`
+}
+
+// NewFindAudienceFlagsOK creates FindAudienceFlagsOK with default headers values
+func NewFindAudienceFlagsOK() *FindAudienceFlagsOK {
+
+	return &FindAudienceFlagsOK{}
+}
+
+// WithPayload adds the payload to the find audience flags o k response
+func (o *FindAudienceFlagsOK) WithPayload(payload []*models.Flag) *FindAudienceFlagsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find audience flags o k response
+func (o *FindAudienceFlagsOK) SetPayload(payload []*models.Flag) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindAudienceFlagsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Flag, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+FindAudienceFlagsDefault generic error response
+
+swagger:response findAudienceFlagsDefault
+*/
+type FindAudienceFlagsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindAudienceFlagsDefault creates FindAudienceFlagsDefault with default headers values
+func NewFindAudienceFlagsDefault(code int) *FindAudienceFlagsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &FindAudienceFlagsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the find audience flags default response
+func (o *FindAudienceFlagsDefault) WithStatusCode(code int) *FindAudienceFlagsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the find audience flags default response
+func (o *FindAudienceFlagsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the find audience flags default response
+func (o *FindAudienceFlagsDefault) WithPayload(payload *models.Error) *FindAudienceFlagsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find audience flags default response
+func (o *FindAudienceFlagsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindAudienceFlagsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// FindAudiencesHandlerFunc turns a function with the right signature into a find audiences handler
+type FindAudiencesHandlerFunc func(FindAudiencesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn FindAudiencesHandlerFunc) Handle(params FindAudiencesParams) middleware.Responder {
+	return fn(params)
+}
+
+// FindAudiencesHandler interface for that can handle valid find audiences params
+type FindAudiencesHandler interface {
+	Handle(FindAudiencesParams) middleware.Responder
+}
+
+// NewFindAudiences creates a new http.Handler for the find audiences operation
+func NewFindAudiences(ctx *middleware.Context, handler FindAudiencesHandler) *FindAudiences {
+	return &FindAudiences{Context: ctx, Handler: handler}
+}
+
+/*
+	FindAudiences swagger:route GET /audiences audience findAudiences
+
+FindAudiences find audiences API
+*/
+type FindAudiences struct {
+	Context *middleware.Context
+	Handler FindAudiencesHandler
+}
+
+func (o *FindAudiences) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewFindAudiencesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// FindAudiencesOKCode is the HTTP code returned for type FindAudiencesOK
+const FindAudiencesOKCode int = 200
+
+/*
+FindAudiencesOK list all the audiences
+
+swagger:response findAudiencesOK
+*/
+type FindAudiencesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Audience `json:"body,omitempty"`
+}
+
+// NewFindAudiencesOK creates FindAudiencesOK with default headers values
+func NewFindAudiencesOK() *FindAudiencesOK {
+
+	return &FindAudiencesOK{}
+}
+
+// WithPayload adds the payload to the find audiences o k response
+func (o *FindAudiencesOK) WithPayload(payload []*models.Audience) *FindAudiencesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find audiences o k response
+func (o *FindAudiencesOK) SetPayload(payload []*models.Audience) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindAudiencesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Audience, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+FindAudiencesDefault generic error response
+
+swagger:response findAudiencesDefault
+*/
+type FindAudiencesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindAudiencesDefault creates FindAudiencesDefault with default headers values
+func NewFindAudiencesDefault(code int) *FindAudiencesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &FindAudiencesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the find audiences default response
+func (o *FindAudiencesDefault) WithStatusCode(code int) *FindAudiencesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the find audiences default response
+func (o *FindAudiencesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the find audiences default response
+func (o *FindAudiencesDefault) WithPayload(payload *models.Error) *FindAudiencesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find audiences default response
+func (o *FindAudiencesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindAudiencesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetAudienceHandlerFunc turns a function with the right signature into a get audience handler
+type GetAudienceHandlerFunc func(GetAudienceParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetAudienceHandlerFunc) Handle(params GetAudienceParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetAudienceHandler interface for that can handle valid get audience params
+type GetAudienceHandler interface {
+	Handle(GetAudienceParams) middleware.Responder
+}
+
+// NewGetAudience creates a new http.Handler for the get audience operation
+func NewGetAudience(ctx *middleware.Context, handler GetAudienceHandler) *GetAudience {
+	return &GetAudience{Context: ctx, Handler: handler}
+}
+
+/*
+	GetAudience swagger:route GET /audiences/{audienceID} audience getAudience
+
+GetAudience get audience API
+*/
+type GetAudience struct {
+	Context *middleware.Context
+	Handler GetAudienceHandler
+}
+
+func (o *GetAudience) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetAudienceParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// GetAudienceOKCode is the HTTP code returned for type GetAudienceOK
+const GetAudienceOKCode int = 200
+
+/*
+GetAudienceOK returns the audience
+
+swagger:response getAudienceOK
+*/
+type GetAudienceOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Audience `json:"body,omitempty"`
+}
+
+// NewGetAudienceOK creates GetAudienceOK with default headers values
+func NewGetAudienceOK() *GetAudienceOK {
+
+	return &GetAudienceOK{}
+}
+
+// WithPayload adds the payload to the get audience o k response
+func (o *GetAudienceOK) WithPayload(payload *models.Audience) *GetAudienceOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get audience o k response
+func (o *GetAudienceOK) SetPayload(payload *models.Audience) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAudienceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetAudienceDefault generic error response
+
+swagger:response getAudienceDefault
+*/
+type GetAudienceDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetAudienceDefault creates GetAudienceDefault with default headers values
+func NewGetAudienceDefault(code int) *GetAudienceDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetAudienceDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get audience default response
+func (o *GetAudienceDefault) WithStatusCode(code int) *GetAudienceDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get audience default response
+func (o *GetAudienceDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get audience default response
+func (o *GetAudienceDefault) WithPayload(payload *models.Error) *GetAudienceDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get audience default response
+func (o *GetAudienceDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAudienceDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
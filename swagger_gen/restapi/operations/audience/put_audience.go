@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PutAudienceHandlerFunc turns a function with the right signature into a put audience handler
+type PutAudienceHandlerFunc func(PutAudienceParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PutAudienceHandlerFunc) Handle(params PutAudienceParams) middleware.Responder {
+	return fn(params)
+}
+
+// PutAudienceHandler interface for that can handle valid put audience params
+type PutAudienceHandler interface {
+	Handle(PutAudienceParams) middleware.Responder
+}
+
+// NewPutAudience creates a new http.Handler for the put audience operation
+func NewPutAudience(ctx *middleware.Context, handler PutAudienceHandler) *PutAudience {
+	return &PutAudience{Context: ctx, Handler: handler}
+}
+
+/*
+	PutAudience swagger:route PUT /audiences/{audienceID} audience putAudience
+
+PutAudience put audience API
+*/
+type PutAudience struct {
+	Context *middleware.Context
+	Handler PutAudienceHandler
+}
+
+func (o *PutAudience) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPutAudienceParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,130 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// NewPutAudienceParams creates a new PutAudienceParams object
+//
+// There are no default values defined in the spec.
+func NewPutAudienceParams() PutAudienceParams {
+
+	return PutAudienceParams{}
+}
+
+// PutAudienceParams contains all the bound params for the put audience operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters putAudience
+type PutAudienceParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*numeric ID of the audience
+	  Required: true
+	  Minimum: 1
+	  In: path
+	*/
+	AudienceID int64
+	/*update an audience
+	  Required: true
+	  In: body
+	*/
+	Body *models.PutAudienceRequest
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPutAudienceParams() beforehand.
+func (o *PutAudienceParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rAudienceID, rhkAudienceID, _ := route.Params.GetOK("audienceID")
+	if err := o.bindAudienceID(rAudienceID, rhkAudienceID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.PutAudienceRequest
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Body = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("body", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAudienceID binds and validates parameter AudienceID from path.
+func (o *PutAudienceParams) bindAudienceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("audienceID", "path", "int64", raw)
+	}
+	o.AudienceID = value
+
+	if err := o.validateAudienceID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateAudienceID carries on validations for parameter AudienceID
+func (o *PutAudienceParams) validateAudienceID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("audienceID", "path", o.AudienceID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audience
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// PutAudienceOKCode is the HTTP code returned for type PutAudienceOK
+const PutAudienceOKCode int = 200
+
+/*
+PutAudienceOK returns the audience
+
+swagger:response putAudienceOK
+*/
+type PutAudienceOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Audience `json:"body,omitempty"`
+}
+
+// NewPutAudienceOK creates PutAudienceOK with default headers values
+func NewPutAudienceOK() *PutAudienceOK {
+
+	return &PutAudienceOK{}
+}
+
+// WithPayload adds the payload to the put audience o k response
+func (o *PutAudienceOK) WithPayload(payload *models.Audience) *PutAudienceOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put audience o k response
+func (o *PutAudienceOK) SetPayload(payload *models.Audience) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutAudienceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PutAudienceDefault generic error response
+
+swagger:response putAudienceDefault
+*/
+type PutAudienceDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPutAudienceDefault creates PutAudienceDefault with default headers values
+func NewPutAudienceDefault(code int) *PutAudienceDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PutAudienceDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the put audience default response
+func (o *PutAudienceDefault) WithStatusCode(code int) *PutAudienceDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the put audience default response
+func (o *PutAudienceDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the put audience default response
+func (o *PutAudienceDefault) WithPayload(payload *models.Error) *PutAudienceDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put audience default response
+func (o *PutAudienceDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutAudienceDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
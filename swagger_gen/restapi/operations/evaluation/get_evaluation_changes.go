@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package evaluation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetEvaluationChangesHandlerFunc turns a function with the right signature into a get evaluation changes handler
+type GetEvaluationChangesHandlerFunc func(GetEvaluationChangesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetEvaluationChangesHandlerFunc) Handle(params GetEvaluationChangesParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetEvaluationChangesHandler interface for that can handle valid get evaluation changes params
+type GetEvaluationChangesHandler interface {
+	Handle(GetEvaluationChangesParams) middleware.Responder
+}
+
+// NewGetEvaluationChanges creates a new http.Handler for the get evaluation changes operation
+func NewGetEvaluationChanges(ctx *middleware.Context, handler GetEvaluationChangesHandler) *GetEvaluationChanges {
+	return &GetEvaluationChanges{Context: ctx, Handler: handler}
+}
+
+/*
+	GetEvaluationChanges swagger:route GET /evaluation/changes evaluation getEvaluationChanges
+
+streams the changes of the flags as server-sent events, e.g. for SDKs and relay instances to refresh the changed flags as soon as the evaluation cache picks them up, instead of polling all the flags. Each message is a flag_changes event with the data of flagChanges. A comment line is sent as the heartbeat when there are no changes. The request needs the "Accept: text/event-stream" header.
+*/
+type GetEvaluationChanges struct {
+	Context *middleware.Context
+	Handler GetEvaluationChangesHandler
+}
+
+func (o *GetEvaluationChanges) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetEvaluationChangesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package evaluation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// GetEvaluationChangesOKCode is the HTTP code returned for type GetEvaluationChangesOK
+const GetEvaluationChangesOKCode int = 200
+
+/*
+GetEvaluationChangesOK the stream of flag_changes events
+
+swagger:response getEvaluationChangesOK
+*/
+type GetEvaluationChangesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.FlagChanges `json:"body,omitempty"`
+}
+
+// NewGetEvaluationChangesOK creates GetEvaluationChangesOK with default headers values
+func NewGetEvaluationChangesOK() *GetEvaluationChangesOK {
+
+	return &GetEvaluationChangesOK{}
+}
+
+// WithPayload adds the payload to the get evaluation changes o k response
+func (o *GetEvaluationChangesOK) WithPayload(payload *models.FlagChanges) *GetEvaluationChangesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get evaluation changes o k response
+func (o *GetEvaluationChangesOK) SetPayload(payload *models.FlagChanges) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetEvaluationChangesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetEvaluationChangesDefault generic error response
+
+swagger:response getEvaluationChangesDefault
+*/
+type GetEvaluationChangesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetEvaluationChangesDefault creates GetEvaluationChangesDefault with default headers values
+func NewGetEvaluationChangesDefault(code int) *GetEvaluationChangesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetEvaluationChangesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get evaluation changes default response
+func (o *GetEvaluationChangesDefault) WithStatusCode(code int) *GetEvaluationChangesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get evaluation changes default response
+func (o *GetEvaluationChangesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get evaluation changes default response
+func (o *GetEvaluationChangesDefault) WithPayload(payload *models.Error) *GetEvaluationChangesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get evaluation changes default response
+func (o *GetEvaluationChangesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetEvaluationChangesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
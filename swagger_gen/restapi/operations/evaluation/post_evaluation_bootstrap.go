@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package evaluation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PostEvaluationBootstrapHandlerFunc turns a function with the right signature into a post evaluation bootstrap handler
+type PostEvaluationBootstrapHandlerFunc func(PostEvaluationBootstrapParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostEvaluationBootstrapHandlerFunc) Handle(params PostEvaluationBootstrapParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostEvaluationBootstrapHandler interface for that can handle valid post evaluation bootstrap params
+type PostEvaluationBootstrapHandler interface {
+	Handle(PostEvaluationBootstrapParams) middleware.Responder
+}
+
+// NewPostEvaluationBootstrap creates a new http.Handler for the post evaluation bootstrap operation
+func NewPostEvaluationBootstrap(ctx *middleware.Context, handler PostEvaluationBootstrapHandler) *PostEvaluationBootstrap {
+	return &PostEvaluationBootstrap{Context: ctx, Handler: handler}
+}
+
+/*
+	PostEvaluationBootstrap swagger:route POST /evaluation/bootstrap evaluation postEvaluationBootstrap
+
+evaluates all the enabled flags, or the ones filtered by flagTags or clientVisibleOnly, for the entity, e.g. to bootstrap the flags of a frontend at page load. The response is escaped to be embedded in HTML.
+*/
+type PostEvaluationBootstrap struct {
+	Context *middleware.Context
+	Handler PostEvaluationBootstrapHandler
+}
+
+func (o *PostEvaluationBootstrap) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostEvaluationBootstrapParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
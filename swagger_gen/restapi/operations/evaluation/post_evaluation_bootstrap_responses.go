@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package evaluation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// PostEvaluationBootstrapOKCode is the HTTP code returned for type PostEvaluationBootstrapOK
+const PostEvaluationBootstrapOKCode int = 200
+
+/*
+PostEvaluationBootstrapOK the assignments of the flags keyed by flagKey
+
+swagger:response postEvaluationBootstrapOK
+*/
+type PostEvaluationBootstrapOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.EvaluationBootstrapResponse `json:"body,omitempty"`
+}
+
+// NewPostEvaluationBootstrapOK creates PostEvaluationBootstrapOK with default headers values
+func NewPostEvaluationBootstrapOK() *PostEvaluationBootstrapOK {
+
+	return &PostEvaluationBootstrapOK{}
+}
+
+// WithPayload adds the payload to the post evaluation bootstrap o k response
+func (o *PostEvaluationBootstrapOK) WithPayload(payload models.EvaluationBootstrapResponse) *PostEvaluationBootstrapOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post evaluation bootstrap o k response
+func (o *PostEvaluationBootstrapOK) SetPayload(payload models.EvaluationBootstrapResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostEvaluationBootstrapOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty map
+		payload = models.EvaluationBootstrapResponse{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+PostEvaluationBootstrapDefault generic error response
+
+swagger:response postEvaluationBootstrapDefault
+*/
+type PostEvaluationBootstrapDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostEvaluationBootstrapDefault creates PostEvaluationBootstrapDefault with default headers values
+func NewPostEvaluationBootstrapDefault(code int) *PostEvaluationBootstrapDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostEvaluationBootstrapDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post evaluation bootstrap default response
+func (o *PostEvaluationBootstrapDefault) WithStatusCode(code int) *PostEvaluationBootstrapDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post evaluation bootstrap default response
+func (o *PostEvaluationBootstrapDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post evaluation bootstrap default response
+func (o *PostEvaluationBootstrapDefault) WithPayload(payload *models.Error) *PostEvaluationBootstrapDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post evaluation bootstrap default response
+func (o *PostEvaluationBootstrapDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostEvaluationBootstrapDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package evaluation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PostEvaluationDryRunHandlerFunc turns a function with the right signature into a post evaluation dry run handler
+type PostEvaluationDryRunHandlerFunc func(PostEvaluationDryRunParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostEvaluationDryRunHandlerFunc) Handle(params PostEvaluationDryRunParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostEvaluationDryRunHandler interface for that can handle valid post evaluation dry run params
+type PostEvaluationDryRunHandler interface {
+	Handle(PostEvaluationDryRunParams) middleware.Responder
+}
+
+// NewPostEvaluationDryRun creates a new http.Handler for the post evaluation dry run operation
+func NewPostEvaluationDryRun(ctx *middleware.Context, handler PostEvaluationDryRunHandler) *PostEvaluationDryRun {
+	return &PostEvaluationDryRun{Context: ctx, Handler: handler}
+}
+
+/*
+	PostEvaluationDryRun swagger:route POST /evaluation/dry_run evaluation postEvaluationDryRun
+
+evaluates an unsaved flag definition with the entities, without saving the flag or recording the results. The debug logs are always included.
+*/
+type PostEvaluationDryRun struct {
+	Context *middleware.Context
+	Handler PostEvaluationDryRunHandler
+}
+
+func (o *PostEvaluationDryRun) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostEvaluationDryRunParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package evaluation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// PostEvaluationDryRunOKCode is the HTTP code returned for type PostEvaluationDryRunOK
+const PostEvaluationDryRunOKCode int = 200
+
+/*
+PostEvaluationDryRunOK evaluation dry run result
+
+swagger:response postEvaluationDryRunOK
+*/
+type PostEvaluationDryRunOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.EvaluationDryRunResponse `json:"body,omitempty"`
+}
+
+// NewPostEvaluationDryRunOK creates PostEvaluationDryRunOK with default headers values
+func NewPostEvaluationDryRunOK() *PostEvaluationDryRunOK {
+
+	return &PostEvaluationDryRunOK{}
+}
+
+// WithPayload adds the payload to the post evaluation dry run o k response
+func (o *PostEvaluationDryRunOK) WithPayload(payload *models.EvaluationDryRunResponse) *PostEvaluationDryRunOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post evaluation dry run o k response
+func (o *PostEvaluationDryRunOK) SetPayload(payload *models.EvaluationDryRunResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostEvaluationDryRunOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostEvaluationDryRunDefault generic error response
+
+swagger:response postEvaluationDryRunDefault
+*/
+type PostEvaluationDryRunDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostEvaluationDryRunDefault creates PostEvaluationDryRunDefault with default headers values
+func NewPostEvaluationDryRunDefault(code int) *PostEvaluationDryRunDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostEvaluationDryRunDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post evaluation dry run default response
+func (o *PostEvaluationDryRunDefault) WithStatusCode(code int) *PostEvaluationDryRunDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post evaluation dry run default response
+func (o *PostEvaluationDryRunDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post evaluation dry run default response
+func (o *PostEvaluationDryRunDefault) WithPayload(payload *models.Error) *PostEvaluationDryRunDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post evaluation dry run default response
+func (o *PostEvaluationDryRunDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostEvaluationDryRunDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package evaluation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PostEvaluationSimulationHandlerFunc turns a function with the right signature into a post evaluation simulation handler
+type PostEvaluationSimulationHandlerFunc func(PostEvaluationSimulationParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostEvaluationSimulationHandlerFunc) Handle(params PostEvaluationSimulationParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostEvaluationSimulationHandler interface for that can handle valid post evaluation simulation params
+type PostEvaluationSimulationHandler interface {
+	Handle(PostEvaluationSimulationParams) middleware.Responder
+}
+
+// NewPostEvaluationSimulation creates a new http.Handler for the post evaluation simulation operation
+func NewPostEvaluationSimulation(ctx *middleware.Context, handler PostEvaluationSimulationHandler) *PostEvaluationSimulation {
+	return &PostEvaluationSimulation{Context: ctx, Handler: handler}
+}
+
+/*
+	PostEvaluationSimulation swagger:route POST /evaluation/simulation evaluation postEvaluationSimulation
+
+simulates the rollout of a flag definition over a sample of entities, e.g. to preview how a change of the distributions or the constraints would split the population before saving it. It reports how many entities land in each segment and variant, and how many of them would get a different variant than from the live flag. Nothing is saved, and the results are not logged or recorded.
+*/
+type PostEvaluationSimulation struct {
+	Context *middleware.Context
+	Handler PostEvaluationSimulationHandler
+}
+
+func (o *PostEvaluationSimulation) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostEvaluationSimulationParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
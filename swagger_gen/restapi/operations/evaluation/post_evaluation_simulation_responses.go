@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package evaluation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// PostEvaluationSimulationOKCode is the HTTP code returned for type PostEvaluationSimulationOK
+const PostEvaluationSimulationOKCode int = 200
+
+/*
+PostEvaluationSimulationOK evaluation simulation result
+
+swagger:response postEvaluationSimulationOK
+*/
+type PostEvaluationSimulationOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.EvaluationSimulationResponse `json:"body,omitempty"`
+}
+
+// NewPostEvaluationSimulationOK creates PostEvaluationSimulationOK with default headers values
+func NewPostEvaluationSimulationOK() *PostEvaluationSimulationOK {
+
+	return &PostEvaluationSimulationOK{}
+}
+
+// WithPayload adds the payload to the post evaluation simulation o k response
+func (o *PostEvaluationSimulationOK) WithPayload(payload *models.EvaluationSimulationResponse) *PostEvaluationSimulationOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post evaluation simulation o k response
+func (o *PostEvaluationSimulationOK) SetPayload(payload *models.EvaluationSimulationResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostEvaluationSimulationOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostEvaluationSimulationDefault generic error response
+
+swagger:response postEvaluationSimulationDefault
+*/
+type PostEvaluationSimulationDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostEvaluationSimulationDefault creates PostEvaluationSimulationDefault with default headers values
+func NewPostEvaluationSimulationDefault(code int) *PostEvaluationSimulationDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostEvaluationSimulationDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post evaluation simulation default response
+func (o *PostEvaluationSimulationDefault) WithStatusCode(code int) *PostEvaluationSimulationDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post evaluation simulation default response
+func (o *PostEvaluationSimulationDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post evaluation simulation default response
+func (o *PostEvaluationSimulationDefault) WithPayload(payload *models.Error) *PostEvaluationSimulationDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post evaluation simulation default response
+func (o *PostEvaluationSimulationDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostEvaluationSimulationDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package evaluation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PostEvaluationSnapshotHandlerFunc turns a function with the right signature into a post evaluation snapshot handler
+type PostEvaluationSnapshotHandlerFunc func(PostEvaluationSnapshotParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostEvaluationSnapshotHandlerFunc) Handle(params PostEvaluationSnapshotParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostEvaluationSnapshotHandler interface for that can handle valid post evaluation snapshot params
+type PostEvaluationSnapshotHandler interface {
+	Handle(PostEvaluationSnapshotParams) middleware.Responder
+}
+
+// NewPostEvaluationSnapshot creates a new http.Handler for the post evaluation snapshot operation
+func NewPostEvaluationSnapshot(ctx *middleware.Context, handler PostEvaluationSnapshotHandler) *PostEvaluationSnapshot {
+	return &PostEvaluationSnapshot{Context: ctx, Handler: handler}
+}
+
+/*
+	PostEvaluationSnapshot swagger:route POST /evaluation/snapshot evaluation postEvaluationSnapshot
+
+evaluates a historical snapshot of the flag with the entities, i.e. what the entities would have got at that time. The results are not logged or recorded. It's not available in the eval only mode.
+*/
+type PostEvaluationSnapshot struct {
+	Context *middleware.Context
+	Handler PostEvaluationSnapshotHandler
+}
+
+func (o *PostEvaluationSnapshot) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostEvaluationSnapshotParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
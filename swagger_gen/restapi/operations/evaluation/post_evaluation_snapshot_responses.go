@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package evaluation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// PostEvaluationSnapshotOKCode is the HTTP code returned for type PostEvaluationSnapshotOK
+const PostEvaluationSnapshotOKCode int = 200
+
+/*
+PostEvaluationSnapshotOK evaluation snapshot result
+
+swagger:response postEvaluationSnapshotOK
+*/
+type PostEvaluationSnapshotOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.EvaluationSnapshotResponse `json:"body,omitempty"`
+}
+
+// NewPostEvaluationSnapshotOK creates PostEvaluationSnapshotOK with default headers values
+func NewPostEvaluationSnapshotOK() *PostEvaluationSnapshotOK {
+
+	return &PostEvaluationSnapshotOK{}
+}
+
+// WithPayload adds the payload to the post evaluation snapshot o k response
+func (o *PostEvaluationSnapshotOK) WithPayload(payload *models.EvaluationSnapshotResponse) *PostEvaluationSnapshotOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post evaluation snapshot o k response
+func (o *PostEvaluationSnapshotOK) SetPayload(payload *models.EvaluationSnapshotResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostEvaluationSnapshotOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostEvaluationSnapshotDefault generic error response
+
+swagger:response postEvaluationSnapshotDefault
+*/
+type PostEvaluationSnapshotDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostEvaluationSnapshotDefault creates PostEvaluationSnapshotDefault with default headers values
+func NewPostEvaluationSnapshotDefault(code int) *PostEvaluationSnapshotDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostEvaluationSnapshotDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post evaluation snapshot default response
+func (o *PostEvaluationSnapshotDefault) WithStatusCode(code int) *PostEvaluationSnapshotDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post evaluation snapshot default response
+func (o *PostEvaluationSnapshotDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post evaluation snapshot default response
+func (o *PostEvaluationSnapshotDefault) WithPayload(payload *models.Error) *PostEvaluationSnapshotDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post evaluation snapshot default response
+func (o *PostEvaluationSnapshotDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostEvaluationSnapshotDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -32,7 +32,7 @@ func NewDeleteFlag(ctx *middleware.Context, handler DeleteFlagHandler) *DeleteFl
 /*
 	DeleteFlag swagger:route DELETE /flags/{flagID} flag deleteFlag
 
-DeleteFlag delete flag API
+Delete the flag. It fails if any segment of the other flags requires the flag as a prerequisite.
 */
 type DeleteFlag struct {
 	Context *middleware.Context
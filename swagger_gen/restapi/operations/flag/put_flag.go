@@ -32,7 +32,7 @@ func NewPutFlag(ctx *middleware.Context, handler PutFlagHandler) *PutFlag {
 /*
 	PutFlag swagger:route PUT /flags/{flagID} flag putFlag
 
-PutFlag put flag API
+Update the flag. Changing the key fails if any segment of the other flags requires the flag as a prerequisite.
 */
 type PutFlag struct {
 	Context *middleware.Context
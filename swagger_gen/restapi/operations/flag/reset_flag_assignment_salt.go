@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package flag
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ResetFlagAssignmentSaltHandlerFunc turns a function with the right signature into a reset flag assignment salt handler
+type ResetFlagAssignmentSaltHandlerFunc func(ResetFlagAssignmentSaltParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ResetFlagAssignmentSaltHandlerFunc) Handle(params ResetFlagAssignmentSaltParams) middleware.Responder {
+	return fn(params)
+}
+
+// ResetFlagAssignmentSaltHandler interface for that can handle valid reset flag assignment salt params
+type ResetFlagAssignmentSaltHandler interface {
+	Handle(ResetFlagAssignmentSaltParams) middleware.Responder
+}
+
+// NewResetFlagAssignmentSalt creates a new http.Handler for the reset flag assignment salt operation
+func NewResetFlagAssignmentSalt(ctx *middleware.Context, handler ResetFlagAssignmentSaltHandler) *ResetFlagAssignmentSalt {
+	return &ResetFlagAssignmentSalt{Context: ctx, Handler: handler}
+}
+
+/*
+	ResetFlagAssignmentSalt swagger:route POST /flags/{flagID}/assignment_salt/reset flag resetFlagAssignmentSalt
+
+resets the assignment salt of the flag, so that all the sticky assignments of the flag are dropped and the entities get the variants of the current segments and distributions again
+*/
+type ResetFlagAssignmentSalt struct {
+	Context *middleware.Context
+	Handler ResetFlagAssignmentSaltHandler
+}
+
+func (o *ResetFlagAssignmentSalt) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewResetFlagAssignmentSaltParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package flag
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// ResetFlagAssignmentSaltOKCode is the HTTP code returned for type ResetFlagAssignmentSaltOK
+const ResetFlagAssignmentSaltOKCode int = 200
+
+/*
+ResetFlagAssignmentSaltOK returns the flag
+
+swagger:response resetFlagAssignmentSaltOK
+*/
+type ResetFlagAssignmentSaltOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Flag `json:"body,omitempty"`
+}
+
+// NewResetFlagAssignmentSaltOK creates ResetFlagAssignmentSaltOK with default headers values
+func NewResetFlagAssignmentSaltOK() *ResetFlagAssignmentSaltOK {
+
+	return &ResetFlagAssignmentSaltOK{}
+}
+
+// WithPayload adds the payload to the reset flag assignment salt o k response
+func (o *ResetFlagAssignmentSaltOK) WithPayload(payload *models.Flag) *ResetFlagAssignmentSaltOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset flag assignment salt o k response
+func (o *ResetFlagAssignmentSaltOK) SetPayload(payload *models.Flag) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResetFlagAssignmentSaltOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ResetFlagAssignmentSaltDefault generic error response
+
+swagger:response resetFlagAssignmentSaltDefault
+*/
+type ResetFlagAssignmentSaltDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewResetFlagAssignmentSaltDefault creates ResetFlagAssignmentSaltDefault with default headers values
+func NewResetFlagAssignmentSaltDefault(code int) *ResetFlagAssignmentSaltDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ResetFlagAssignmentSaltDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the reset flag assignment salt default response
+func (o *ResetFlagAssignmentSaltDefault) WithStatusCode(code int) *ResetFlagAssignmentSaltDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the reset flag assignment salt default response
+func (o *ResetFlagAssignmentSaltDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the reset flag assignment salt default response
+func (o *ResetFlagAssignmentSaltDefault) WithPayload(payload *models.Error) *ResetFlagAssignmentSaltDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset flag assignment salt default response
+func (o *ResetFlagAssignmentSaltDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResetFlagAssignmentSaltDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
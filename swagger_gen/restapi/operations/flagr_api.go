@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -19,15 +20,21 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/audience"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/constraint"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/distribution"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/evaluation"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/export"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/flag"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/health"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/id_list"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/ofrep"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/rollout_ramp"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/scheduled_change"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/segment"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/tag"
 	"github.com/openflagr/flagr/swagger_gen/restapi/operations/variant"
+	"github.com/openflagr/flagr/swagger_gen/restapi/operations/variant_override"
 )
 
 // NewFlagrAPI creates a new Flagr instance
@@ -48,17 +55,45 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
+		CsvConsumer: runtime.ConsumerFunc(func(r io.Reader, target interface{}) error {
+			return errors.NotImplemented("csv consumer has not yet been implemented")
+		}),
 		JSONConsumer: runtime.JSONConsumer(),
+		TxtConsumer:  runtime.TextConsumer(),
 
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
+		RolloutRampAbortRolloutRampHandler: rollout_ramp.AbortRolloutRampHandlerFunc(func(params rollout_ramp.AbortRolloutRampParams) middleware.Responder {
+			return middleware.NotImplemented("operation rollout_ramp.AbortRolloutRamp has not yet been implemented")
+		}),
+		IDListAppendIDListEntriesHandler: id_list.AppendIDListEntriesHandlerFunc(func(params id_list.AppendIDListEntriesParams) middleware.Responder {
+			return middleware.NotImplemented("operation id_list.AppendIDListEntries has not yet been implemented")
+		}),
+		ScheduledChangeCancelScheduledChangeHandler: scheduled_change.CancelScheduledChangeHandlerFunc(func(params scheduled_change.CancelScheduledChangeParams) middleware.Responder {
+			return middleware.NotImplemented("operation scheduled_change.CancelScheduledChange has not yet been implemented")
+		}),
+		AudienceCreateAudienceHandler: audience.CreateAudienceHandlerFunc(func(params audience.CreateAudienceParams) middleware.Responder {
+			return middleware.NotImplemented("operation audience.CreateAudience has not yet been implemented")
+		}),
 		ConstraintCreateConstraintHandler: constraint.CreateConstraintHandlerFunc(func(params constraint.CreateConstraintParams) middleware.Responder {
 			return middleware.NotImplemented("operation constraint.CreateConstraint has not yet been implemented")
 		}),
 		FlagCreateFlagHandler: flag.CreateFlagHandlerFunc(func(params flag.CreateFlagParams) middleware.Responder {
 			return middleware.NotImplemented("operation flag.CreateFlag has not yet been implemented")
 		}),
+		IDListCreateIDListHandler: id_list.CreateIDListHandlerFunc(func(params id_list.CreateIDListParams) middleware.Responder {
+			return middleware.NotImplemented("operation id_list.CreateIDList has not yet been implemented")
+		}),
+		RolloutRampCreateRolloutRampHandler: rollout_ramp.CreateRolloutRampHandlerFunc(func(params rollout_ramp.CreateRolloutRampParams) middleware.Responder {
+			return middleware.NotImplemented("operation rollout_ramp.CreateRolloutRamp has not yet been implemented")
+		}),
+		ScheduledChangeCreateScheduledChangeHandler: scheduled_change.CreateScheduledChangeHandlerFunc(func(params scheduled_change.CreateScheduledChangeParams) middleware.Responder {
+			return middleware.NotImplemented("operation scheduled_change.CreateScheduledChange has not yet been implemented")
+		}),
 		SegmentCreateSegmentHandler: segment.CreateSegmentHandlerFunc(func(params segment.CreateSegmentParams) middleware.Responder {
 			return middleware.NotImplemented("operation segment.CreateSegment has not yet been implemented")
 		}),
@@ -68,12 +103,21 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 		VariantCreateVariantHandler: variant.CreateVariantHandlerFunc(func(params variant.CreateVariantParams) middleware.Responder {
 			return middleware.NotImplemented("operation variant.CreateVariant has not yet been implemented")
 		}),
+		VariantOverrideCreateVariantOverrideHandler: variant_override.CreateVariantOverrideHandlerFunc(func(params variant_override.CreateVariantOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation variant_override.CreateVariantOverride has not yet been implemented")
+		}),
+		AudienceDeleteAudienceHandler: audience.DeleteAudienceHandlerFunc(func(params audience.DeleteAudienceParams) middleware.Responder {
+			return middleware.NotImplemented("operation audience.DeleteAudience has not yet been implemented")
+		}),
 		ConstraintDeleteConstraintHandler: constraint.DeleteConstraintHandlerFunc(func(params constraint.DeleteConstraintParams) middleware.Responder {
 			return middleware.NotImplemented("operation constraint.DeleteConstraint has not yet been implemented")
 		}),
 		FlagDeleteFlagHandler: flag.DeleteFlagHandlerFunc(func(params flag.DeleteFlagParams) middleware.Responder {
 			return middleware.NotImplemented("operation flag.DeleteFlag has not yet been implemented")
 		}),
+		IDListDeleteIDListHandler: id_list.DeleteIDListHandlerFunc(func(params id_list.DeleteIDListParams) middleware.Responder {
+			return middleware.NotImplemented("operation id_list.DeleteIDList has not yet been implemented")
+		}),
 		SegmentDeleteSegmentHandler: segment.DeleteSegmentHandlerFunc(func(params segment.DeleteSegmentParams) middleware.Responder {
 			return middleware.NotImplemented("operation segment.DeleteSegment has not yet been implemented")
 		}),
@@ -83,9 +127,18 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 		VariantDeleteVariantHandler: variant.DeleteVariantHandlerFunc(func(params variant.DeleteVariantParams) middleware.Responder {
 			return middleware.NotImplemented("operation variant.DeleteVariant has not yet been implemented")
 		}),
+		VariantOverrideDeleteVariantOverrideHandler: variant_override.DeleteVariantOverrideHandlerFunc(func(params variant_override.DeleteVariantOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation variant_override.DeleteVariantOverride has not yet been implemented")
+		}),
 		TagFindAllTagsHandler: tag.FindAllTagsHandlerFunc(func(params tag.FindAllTagsParams) middleware.Responder {
 			return middleware.NotImplemented("operation tag.FindAllTags has not yet been implemented")
 		}),
+		AudienceFindAudienceFlagsHandler: audience.FindAudienceFlagsHandlerFunc(func(params audience.FindAudienceFlagsParams) middleware.Responder {
+			return middleware.NotImplemented("operation audience.FindAudienceFlags has not yet been implemented")
+		}),
+		AudienceFindAudiencesHandler: audience.FindAudiencesHandlerFunc(func(params audience.FindAudiencesParams) middleware.Responder {
+			return middleware.NotImplemented("operation audience.FindAudiences has not yet been implemented")
+		}),
 		ConstraintFindConstraintsHandler: constraint.FindConstraintsHandlerFunc(func(params constraint.FindConstraintsParams) middleware.Responder {
 			return middleware.NotImplemented("operation constraint.FindConstraints has not yet been implemented")
 		}),
@@ -95,15 +148,33 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 		FlagFindFlagsHandler: flag.FindFlagsHandlerFunc(func(params flag.FindFlagsParams) middleware.Responder {
 			return middleware.NotImplemented("operation flag.FindFlags has not yet been implemented")
 		}),
+		IDListFindIDListsHandler: id_list.FindIDListsHandlerFunc(func(params id_list.FindIDListsParams) middleware.Responder {
+			return middleware.NotImplemented("operation id_list.FindIDLists has not yet been implemented")
+		}),
+		RolloutRampFindRolloutRampsHandler: rollout_ramp.FindRolloutRampsHandlerFunc(func(params rollout_ramp.FindRolloutRampsParams) middleware.Responder {
+			return middleware.NotImplemented("operation rollout_ramp.FindRolloutRamps has not yet been implemented")
+		}),
+		ScheduledChangeFindScheduledChangesHandler: scheduled_change.FindScheduledChangesHandlerFunc(func(params scheduled_change.FindScheduledChangesParams) middleware.Responder {
+			return middleware.NotImplemented("operation scheduled_change.FindScheduledChanges has not yet been implemented")
+		}),
 		SegmentFindSegmentsHandler: segment.FindSegmentsHandlerFunc(func(params segment.FindSegmentsParams) middleware.Responder {
 			return middleware.NotImplemented("operation segment.FindSegments has not yet been implemented")
 		}),
 		TagFindTagsHandler: tag.FindTagsHandlerFunc(func(params tag.FindTagsParams) middleware.Responder {
 			return middleware.NotImplemented("operation tag.FindTags has not yet been implemented")
 		}),
+		VariantOverrideFindVariantOverridesHandler: variant_override.FindVariantOverridesHandlerFunc(func(params variant_override.FindVariantOverridesParams) middleware.Responder {
+			return middleware.NotImplemented("operation variant_override.FindVariantOverrides has not yet been implemented")
+		}),
 		VariantFindVariantsHandler: variant.FindVariantsHandlerFunc(func(params variant.FindVariantsParams) middleware.Responder {
 			return middleware.NotImplemented("operation variant.FindVariants has not yet been implemented")
 		}),
+		AudienceGetAudienceHandler: audience.GetAudienceHandlerFunc(func(params audience.GetAudienceParams) middleware.Responder {
+			return middleware.NotImplemented("operation audience.GetAudience has not yet been implemented")
+		}),
+		EvaluationGetEvaluationChangesHandler: evaluation.GetEvaluationChangesHandlerFunc(func(params evaluation.GetEvaluationChangesParams) middleware.Responder {
+			return middleware.NotImplemented("operation evaluation.GetEvaluationChanges has not yet been implemented")
+		}),
 		ExportGetExportEvalCacheJSONHandler: export.GetExportEvalCacheJSONHandlerFunc(func(params export.GetExportEvalCacheJSONParams) middleware.Responder {
 			return middleware.NotImplemented("operation export.GetExportEvalCacheJSON has not yet been implemented")
 		}),
@@ -122,12 +193,39 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 		HealthGetHealthHandler: health.GetHealthHandlerFunc(func(params health.GetHealthParams) middleware.Responder {
 			return middleware.NotImplemented("operation health.GetHealth has not yet been implemented")
 		}),
+		IDListGetIDListHandler: id_list.GetIDListHandlerFunc(func(params id_list.GetIDListParams) middleware.Responder {
+			return middleware.NotImplemented("operation id_list.GetIDList has not yet been implemented")
+		}),
+		RolloutRampPauseRolloutRampHandler: rollout_ramp.PauseRolloutRampHandlerFunc(func(params rollout_ramp.PauseRolloutRampParams) middleware.Responder {
+			return middleware.NotImplemented("operation rollout_ramp.PauseRolloutRamp has not yet been implemented")
+		}),
 		EvaluationPostEvaluationHandler: evaluation.PostEvaluationHandlerFunc(func(params evaluation.PostEvaluationParams) middleware.Responder {
 			return middleware.NotImplemented("operation evaluation.PostEvaluation has not yet been implemented")
 		}),
 		EvaluationPostEvaluationBatchHandler: evaluation.PostEvaluationBatchHandlerFunc(func(params evaluation.PostEvaluationBatchParams) middleware.Responder {
 			return middleware.NotImplemented("operation evaluation.PostEvaluationBatch has not yet been implemented")
 		}),
+		EvaluationPostEvaluationBootstrapHandler: evaluation.PostEvaluationBootstrapHandlerFunc(func(params evaluation.PostEvaluationBootstrapParams) middleware.Responder {
+			return middleware.NotImplemented("operation evaluation.PostEvaluationBootstrap has not yet been implemented")
+		}),
+		EvaluationPostEvaluationDryRunHandler: evaluation.PostEvaluationDryRunHandlerFunc(func(params evaluation.PostEvaluationDryRunParams) middleware.Responder {
+			return middleware.NotImplemented("operation evaluation.PostEvaluationDryRun has not yet been implemented")
+		}),
+		EvaluationPostEvaluationSimulationHandler: evaluation.PostEvaluationSimulationHandlerFunc(func(params evaluation.PostEvaluationSimulationParams) middleware.Responder {
+			return middleware.NotImplemented("operation evaluation.PostEvaluationSimulation has not yet been implemented")
+		}),
+		EvaluationPostEvaluationSnapshotHandler: evaluation.PostEvaluationSnapshotHandlerFunc(func(params evaluation.PostEvaluationSnapshotParams) middleware.Responder {
+			return middleware.NotImplemented("operation evaluation.PostEvaluationSnapshot has not yet been implemented")
+		}),
+		OfrepPostOfrepEvaluateFlagHandler: ofrep.PostOfrepEvaluateFlagHandlerFunc(func(params ofrep.PostOfrepEvaluateFlagParams) middleware.Responder {
+			return middleware.NotImplemented("operation ofrep.PostOfrepEvaluateFlag has not yet been implemented")
+		}),
+		OfrepPostOfrepEvaluateFlagsHandler: ofrep.PostOfrepEvaluateFlagsHandlerFunc(func(params ofrep.PostOfrepEvaluateFlagsParams) middleware.Responder {
+			return middleware.NotImplemented("operation ofrep.PostOfrepEvaluateFlags has not yet been implemented")
+		}),
+		AudiencePutAudienceHandler: audience.PutAudienceHandlerFunc(func(params audience.PutAudienceParams) middleware.Responder {
+			return middleware.NotImplemented("operation audience.PutAudience has not yet been implemented")
+		}),
 		ConstraintPutConstraintHandler: constraint.PutConstraintHandlerFunc(func(params constraint.PutConstraintParams) middleware.Responder {
 			return middleware.NotImplemented("operation constraint.PutConstraint has not yet been implemented")
 		}),
@@ -146,9 +244,21 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 		VariantPutVariantHandler: variant.PutVariantHandlerFunc(func(params variant.PutVariantParams) middleware.Responder {
 			return middleware.NotImplemented("operation variant.PutVariant has not yet been implemented")
 		}),
+		IDListRemoveIDListEntriesHandler: id_list.RemoveIDListEntriesHandlerFunc(func(params id_list.RemoveIDListEntriesParams) middleware.Responder {
+			return middleware.NotImplemented("operation id_list.RemoveIDListEntries has not yet been implemented")
+		}),
+		IDListReplaceIDListEntriesHandler: id_list.ReplaceIDListEntriesHandlerFunc(func(params id_list.ReplaceIDListEntriesParams) middleware.Responder {
+			return middleware.NotImplemented("operation id_list.ReplaceIDListEntries has not yet been implemented")
+		}),
+		FlagResetFlagAssignmentSaltHandler: flag.ResetFlagAssignmentSaltHandlerFunc(func(params flag.ResetFlagAssignmentSaltParams) middleware.Responder {
+			return middleware.NotImplemented("operation flag.ResetFlagAssignmentSalt has not yet been implemented")
+		}),
 		FlagRestoreFlagHandler: flag.RestoreFlagHandlerFunc(func(params flag.RestoreFlagParams) middleware.Responder {
 			return middleware.NotImplemented("operation flag.RestoreFlag has not yet been implemented")
 		}),
+		RolloutRampResumeRolloutRampHandler: rollout_ramp.ResumeRolloutRampHandlerFunc(func(params rollout_ramp.ResumeRolloutRampParams) middleware.Responder {
+			return middleware.NotImplemented("operation rollout_ramp.ResumeRolloutRamp has not yet been implemented")
+		}),
 		FlagSetFlagEnabledHandler: flag.SetFlagEnabledHandlerFunc(func(params flag.SetFlagEnabledParams) middleware.Responder {
 			return middleware.NotImplemented("operation flag.SetFlagEnabled has not yet been implemented")
 		}),
@@ -181,9 +291,15 @@ type FlagrAPI struct {
 	// It has a default implementation in the security package, however you can replace it for your particular usage.
 	BearerAuthenticator func(string, security.ScopedTokenAuthentication) runtime.Authenticator
 
+	// CsvConsumer registers a consumer for the following mime types:
+	//   - text/csv
+	CsvConsumer runtime.Consumer
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
 	JSONConsumer runtime.Consumer
+	// TxtConsumer registers a consumer for the following mime types:
+	//   - text/plain
+	TxtConsumer runtime.Consumer
 
 	// BinProducer registers a producer for the following mime types:
 	//   - application/octet-stream
@@ -191,41 +307,82 @@ type FlagrAPI struct {
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
 	JSONProducer runtime.Producer
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
 
+	// RolloutRampAbortRolloutRampHandler sets the operation handler for the abort rollout ramp operation
+	RolloutRampAbortRolloutRampHandler rollout_ramp.AbortRolloutRampHandler
+	// IDListAppendIDListEntriesHandler sets the operation handler for the append ID list entries operation
+	IDListAppendIDListEntriesHandler id_list.AppendIDListEntriesHandler
+	// ScheduledChangeCancelScheduledChangeHandler sets the operation handler for the cancel scheduled change operation
+	ScheduledChangeCancelScheduledChangeHandler scheduled_change.CancelScheduledChangeHandler
+	// AudienceCreateAudienceHandler sets the operation handler for the create audience operation
+	AudienceCreateAudienceHandler audience.CreateAudienceHandler
 	// ConstraintCreateConstraintHandler sets the operation handler for the create constraint operation
 	ConstraintCreateConstraintHandler constraint.CreateConstraintHandler
 	// FlagCreateFlagHandler sets the operation handler for the create flag operation
 	FlagCreateFlagHandler flag.CreateFlagHandler
+	// IDListCreateIDListHandler sets the operation handler for the create ID list operation
+	IDListCreateIDListHandler id_list.CreateIDListHandler
+	// RolloutRampCreateRolloutRampHandler sets the operation handler for the create rollout ramp operation
+	RolloutRampCreateRolloutRampHandler rollout_ramp.CreateRolloutRampHandler
+	// ScheduledChangeCreateScheduledChangeHandler sets the operation handler for the create scheduled change operation
+	ScheduledChangeCreateScheduledChangeHandler scheduled_change.CreateScheduledChangeHandler
 	// SegmentCreateSegmentHandler sets the operation handler for the create segment operation
 	SegmentCreateSegmentHandler segment.CreateSegmentHandler
 	// TagCreateTagHandler sets the operation handler for the create tag operation
 	TagCreateTagHandler tag.CreateTagHandler
 	// VariantCreateVariantHandler sets the operation handler for the create variant operation
 	VariantCreateVariantHandler variant.CreateVariantHandler
+	// VariantOverrideCreateVariantOverrideHandler sets the operation handler for the create variant override operation
+	VariantOverrideCreateVariantOverrideHandler variant_override.CreateVariantOverrideHandler
+	// AudienceDeleteAudienceHandler sets the operation handler for the delete audience operation
+	AudienceDeleteAudienceHandler audience.DeleteAudienceHandler
 	// ConstraintDeleteConstraintHandler sets the operation handler for the delete constraint operation
 	ConstraintDeleteConstraintHandler constraint.DeleteConstraintHandler
 	// FlagDeleteFlagHandler sets the operation handler for the delete flag operation
 	FlagDeleteFlagHandler flag.DeleteFlagHandler
+	// IDListDeleteIDListHandler sets the operation handler for the delete ID list operation
+	IDListDeleteIDListHandler id_list.DeleteIDListHandler
 	// SegmentDeleteSegmentHandler sets the operation handler for the delete segment operation
 	SegmentDeleteSegmentHandler segment.DeleteSegmentHandler
 	// TagDeleteTagHandler sets the operation handler for the delete tag operation
 	TagDeleteTagHandler tag.DeleteTagHandler
 	// VariantDeleteVariantHandler sets the operation handler for the delete variant operation
 	VariantDeleteVariantHandler variant.DeleteVariantHandler
+	// VariantOverrideDeleteVariantOverrideHandler sets the operation handler for the delete variant override operation
+	VariantOverrideDeleteVariantOverrideHandler variant_override.DeleteVariantOverrideHandler
 	// TagFindAllTagsHandler sets the operation handler for the find all tags operation
 	TagFindAllTagsHandler tag.FindAllTagsHandler
+	// AudienceFindAudienceFlagsHandler sets the operation handler for the find audience flags operation
+	AudienceFindAudienceFlagsHandler audience.FindAudienceFlagsHandler
+	// AudienceFindAudiencesHandler sets the operation handler for the find audiences operation
+	AudienceFindAudiencesHandler audience.FindAudiencesHandler
 	// ConstraintFindConstraintsHandler sets the operation handler for the find constraints operation
 	ConstraintFindConstraintsHandler constraint.FindConstraintsHandler
 	// DistributionFindDistributionsHandler sets the operation handler for the find distributions operation
 	DistributionFindDistributionsHandler distribution.FindDistributionsHandler
 	// FlagFindFlagsHandler sets the operation handler for the find flags operation
 	FlagFindFlagsHandler flag.FindFlagsHandler
+	// IDListFindIDListsHandler sets the operation handler for the find ID lists operation
+	IDListFindIDListsHandler id_list.FindIDListsHandler
+	// RolloutRampFindRolloutRampsHandler sets the operation handler for the find rollout ramps operation
+	RolloutRampFindRolloutRampsHandler rollout_ramp.FindRolloutRampsHandler
+	// ScheduledChangeFindScheduledChangesHandler sets the operation handler for the find scheduled changes operation
+	ScheduledChangeFindScheduledChangesHandler scheduled_change.FindScheduledChangesHandler
 	// SegmentFindSegmentsHandler sets the operation handler for the find segments operation
 	SegmentFindSegmentsHandler segment.FindSegmentsHandler
 	// TagFindTagsHandler sets the operation handler for the find tags operation
 	TagFindTagsHandler tag.FindTagsHandler
+	// VariantOverrideFindVariantOverridesHandler sets the operation handler for the find variant overrides operation
+	VariantOverrideFindVariantOverridesHandler variant_override.FindVariantOverridesHandler
 	// VariantFindVariantsHandler sets the operation handler for the find variants operation
 	VariantFindVariantsHandler variant.FindVariantsHandler
+	// AudienceGetAudienceHandler sets the operation handler for the get audience operation
+	AudienceGetAudienceHandler audience.GetAudienceHandler
+	// EvaluationGetEvaluationChangesHandler sets the operation handler for the get evaluation changes operation
+	EvaluationGetEvaluationChangesHandler evaluation.GetEvaluationChangesHandler
 	// ExportGetExportEvalCacheJSONHandler sets the operation handler for the get export eval cache JSON operation
 	ExportGetExportEvalCacheJSONHandler export.GetExportEvalCacheJSONHandler
 	// ExportGetExportSqliteHandler sets the operation handler for the get export sqlite operation
@@ -238,10 +395,28 @@ type FlagrAPI struct {
 	FlagGetFlagSnapshotsHandler flag.GetFlagSnapshotsHandler
 	// HealthGetHealthHandler sets the operation handler for the get health operation
 	HealthGetHealthHandler health.GetHealthHandler
+	// IDListGetIDListHandler sets the operation handler for the get ID list operation
+	IDListGetIDListHandler id_list.GetIDListHandler
+	// RolloutRampPauseRolloutRampHandler sets the operation handler for the pause rollout ramp operation
+	RolloutRampPauseRolloutRampHandler rollout_ramp.PauseRolloutRampHandler
 	// EvaluationPostEvaluationHandler sets the operation handler for the post evaluation operation
 	EvaluationPostEvaluationHandler evaluation.PostEvaluationHandler
 	// EvaluationPostEvaluationBatchHandler sets the operation handler for the post evaluation batch operation
 	EvaluationPostEvaluationBatchHandler evaluation.PostEvaluationBatchHandler
+	// EvaluationPostEvaluationBootstrapHandler sets the operation handler for the post evaluation bootstrap operation
+	EvaluationPostEvaluationBootstrapHandler evaluation.PostEvaluationBootstrapHandler
+	// EvaluationPostEvaluationDryRunHandler sets the operation handler for the post evaluation dry run operation
+	EvaluationPostEvaluationDryRunHandler evaluation.PostEvaluationDryRunHandler
+	// EvaluationPostEvaluationSimulationHandler sets the operation handler for the post evaluation simulation operation
+	EvaluationPostEvaluationSimulationHandler evaluation.PostEvaluationSimulationHandler
+	// EvaluationPostEvaluationSnapshotHandler sets the operation handler for the post evaluation snapshot operation
+	EvaluationPostEvaluationSnapshotHandler evaluation.PostEvaluationSnapshotHandler
+	// OfrepPostOfrepEvaluateFlagHandler sets the operation handler for the post ofrep evaluate flag operation
+	OfrepPostOfrepEvaluateFlagHandler ofrep.PostOfrepEvaluateFlagHandler
+	// OfrepPostOfrepEvaluateFlagsHandler sets the operation handler for the post ofrep evaluate flags operation
+	OfrepPostOfrepEvaluateFlagsHandler ofrep.PostOfrepEvaluateFlagsHandler
+	// AudiencePutAudienceHandler sets the operation handler for the put audience operation
+	AudiencePutAudienceHandler audience.PutAudienceHandler
 	// ConstraintPutConstraintHandler sets the operation handler for the put constraint operation
 	ConstraintPutConstraintHandler constraint.PutConstraintHandler
 	// DistributionPutDistributionsHandler sets the operation handler for the put distributions operation
@@ -254,8 +429,16 @@ type FlagrAPI struct {
 	SegmentPutSegmentsReorderHandler segment.PutSegmentsReorderHandler
 	// VariantPutVariantHandler sets the operation handler for the put variant operation
 	VariantPutVariantHandler variant.PutVariantHandler
+	// IDListRemoveIDListEntriesHandler sets the operation handler for the remove ID list entries operation
+	IDListRemoveIDListEntriesHandler id_list.RemoveIDListEntriesHandler
+	// IDListReplaceIDListEntriesHandler sets the operation handler for the replace ID list entries operation
+	IDListReplaceIDListEntriesHandler id_list.ReplaceIDListEntriesHandler
+	// FlagResetFlagAssignmentSaltHandler sets the operation handler for the reset flag assignment salt operation
+	FlagResetFlagAssignmentSaltHandler flag.ResetFlagAssignmentSaltHandler
 	// FlagRestoreFlagHandler sets the operation handler for the restore flag operation
 	FlagRestoreFlagHandler flag.RestoreFlagHandler
+	// RolloutRampResumeRolloutRampHandler sets the operation handler for the resume rollout ramp operation
+	RolloutRampResumeRolloutRampHandler rollout_ramp.ResumeRolloutRampHandler
 	// FlagSetFlagEnabledHandler sets the operation handler for the set flag enabled operation
 	FlagSetFlagEnabledHandler flag.SetFlagEnabledHandler
 
@@ -327,9 +510,15 @@ func (o *FlagrAPI) RegisterFormat(name string, format strfmt.Format, validator s
 func (o *FlagrAPI) Validate() error {
 	var unregistered []string
 
+	if o.CsvConsumer == nil {
+		unregistered = append(unregistered, "CsvConsumer")
+	}
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
+	if o.TxtConsumer == nil {
+		unregistered = append(unregistered, "TxtConsumer")
+	}
 
 	if o.BinProducer == nil {
 		unregistered = append(unregistered, "BinProducer")
@@ -337,13 +526,37 @@ func (o *FlagrAPI) Validate() error {
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
+	if o.RolloutRampAbortRolloutRampHandler == nil {
+		unregistered = append(unregistered, "rollout_ramp.AbortRolloutRampHandler")
+	}
+	if o.IDListAppendIDListEntriesHandler == nil {
+		unregistered = append(unregistered, "id_list.AppendIDListEntriesHandler")
+	}
+	if o.ScheduledChangeCancelScheduledChangeHandler == nil {
+		unregistered = append(unregistered, "scheduled_change.CancelScheduledChangeHandler")
+	}
+	if o.AudienceCreateAudienceHandler == nil {
+		unregistered = append(unregistered, "audience.CreateAudienceHandler")
+	}
 	if o.ConstraintCreateConstraintHandler == nil {
 		unregistered = append(unregistered, "constraint.CreateConstraintHandler")
 	}
 	if o.FlagCreateFlagHandler == nil {
 		unregistered = append(unregistered, "flag.CreateFlagHandler")
 	}
+	if o.IDListCreateIDListHandler == nil {
+		unregistered = append(unregistered, "id_list.CreateIDListHandler")
+	}
+	if o.RolloutRampCreateRolloutRampHandler == nil {
+		unregistered = append(unregistered, "rollout_ramp.CreateRolloutRampHandler")
+	}
+	if o.ScheduledChangeCreateScheduledChangeHandler == nil {
+		unregistered = append(unregistered, "scheduled_change.CreateScheduledChangeHandler")
+	}
 	if o.SegmentCreateSegmentHandler == nil {
 		unregistered = append(unregistered, "segment.CreateSegmentHandler")
 	}
@@ -353,12 +566,21 @@ func (o *FlagrAPI) Validate() error {
 	if o.VariantCreateVariantHandler == nil {
 		unregistered = append(unregistered, "variant.CreateVariantHandler")
 	}
+	if o.VariantOverrideCreateVariantOverrideHandler == nil {
+		unregistered = append(unregistered, "variant_override.CreateVariantOverrideHandler")
+	}
+	if o.AudienceDeleteAudienceHandler == nil {
+		unregistered = append(unregistered, "audience.DeleteAudienceHandler")
+	}
 	if o.ConstraintDeleteConstraintHandler == nil {
 		unregistered = append(unregistered, "constraint.DeleteConstraintHandler")
 	}
 	if o.FlagDeleteFlagHandler == nil {
 		unregistered = append(unregistered, "flag.DeleteFlagHandler")
 	}
+	if o.IDListDeleteIDListHandler == nil {
+		unregistered = append(unregistered, "id_list.DeleteIDListHandler")
+	}
 	if o.SegmentDeleteSegmentHandler == nil {
 		unregistered = append(unregistered, "segment.DeleteSegmentHandler")
 	}
@@ -368,9 +590,18 @@ func (o *FlagrAPI) Validate() error {
 	if o.VariantDeleteVariantHandler == nil {
 		unregistered = append(unregistered, "variant.DeleteVariantHandler")
 	}
+	if o.VariantOverrideDeleteVariantOverrideHandler == nil {
+		unregistered = append(unregistered, "variant_override.DeleteVariantOverrideHandler")
+	}
 	if o.TagFindAllTagsHandler == nil {
 		unregistered = append(unregistered, "tag.FindAllTagsHandler")
 	}
+	if o.AudienceFindAudienceFlagsHandler == nil {
+		unregistered = append(unregistered, "audience.FindAudienceFlagsHandler")
+	}
+	if o.AudienceFindAudiencesHandler == nil {
+		unregistered = append(unregistered, "audience.FindAudiencesHandler")
+	}
 	if o.ConstraintFindConstraintsHandler == nil {
 		unregistered = append(unregistered, "constraint.FindConstraintsHandler")
 	}
@@ -380,15 +611,33 @@ func (o *FlagrAPI) Validate() error {
 	if o.FlagFindFlagsHandler == nil {
 		unregistered = append(unregistered, "flag.FindFlagsHandler")
 	}
+	if o.IDListFindIDListsHandler == nil {
+		unregistered = append(unregistered, "id_list.FindIDListsHandler")
+	}
+	if o.RolloutRampFindRolloutRampsHandler == nil {
+		unregistered = append(unregistered, "rollout_ramp.FindRolloutRampsHandler")
+	}
+	if o.ScheduledChangeFindScheduledChangesHandler == nil {
+		unregistered = append(unregistered, "scheduled_change.FindScheduledChangesHandler")
+	}
 	if o.SegmentFindSegmentsHandler == nil {
 		unregistered = append(unregistered, "segment.FindSegmentsHandler")
 	}
 	if o.TagFindTagsHandler == nil {
 		unregistered = append(unregistered, "tag.FindTagsHandler")
 	}
+	if o.VariantOverrideFindVariantOverridesHandler == nil {
+		unregistered = append(unregistered, "variant_override.FindVariantOverridesHandler")
+	}
 	if o.VariantFindVariantsHandler == nil {
 		unregistered = append(unregistered, "variant.FindVariantsHandler")
 	}
+	if o.AudienceGetAudienceHandler == nil {
+		unregistered = append(unregistered, "audience.GetAudienceHandler")
+	}
+	if o.EvaluationGetEvaluationChangesHandler == nil {
+		unregistered = append(unregistered, "evaluation.GetEvaluationChangesHandler")
+	}
 	if o.ExportGetExportEvalCacheJSONHandler == nil {
 		unregistered = append(unregistered, "export.GetExportEvalCacheJSONHandler")
 	}
@@ -407,12 +656,39 @@ func (o *FlagrAPI) Validate() error {
 	if o.HealthGetHealthHandler == nil {
 		unregistered = append(unregistered, "health.GetHealthHandler")
 	}
+	if o.IDListGetIDListHandler == nil {
+		unregistered = append(unregistered, "id_list.GetIDListHandler")
+	}
+	if o.RolloutRampPauseRolloutRampHandler == nil {
+		unregistered = append(unregistered, "rollout_ramp.PauseRolloutRampHandler")
+	}
 	if o.EvaluationPostEvaluationHandler == nil {
 		unregistered = append(unregistered, "evaluation.PostEvaluationHandler")
 	}
 	if o.EvaluationPostEvaluationBatchHandler == nil {
 		unregistered = append(unregistered, "evaluation.PostEvaluationBatchHandler")
 	}
+	if o.EvaluationPostEvaluationBootstrapHandler == nil {
+		unregistered = append(unregistered, "evaluation.PostEvaluationBootstrapHandler")
+	}
+	if o.EvaluationPostEvaluationDryRunHandler == nil {
+		unregistered = append(unregistered, "evaluation.PostEvaluationDryRunHandler")
+	}
+	if o.EvaluationPostEvaluationSimulationHandler == nil {
+		unregistered = append(unregistered, "evaluation.PostEvaluationSimulationHandler")
+	}
+	if o.EvaluationPostEvaluationSnapshotHandler == nil {
+		unregistered = append(unregistered, "evaluation.PostEvaluationSnapshotHandler")
+	}
+	if o.OfrepPostOfrepEvaluateFlagHandler == nil {
+		unregistered = append(unregistered, "ofrep.PostOfrepEvaluateFlagHandler")
+	}
+	if o.OfrepPostOfrepEvaluateFlagsHandler == nil {
+		unregistered = append(unregistered, "ofrep.PostOfrepEvaluateFlagsHandler")
+	}
+	if o.AudiencePutAudienceHandler == nil {
+		unregistered = append(unregistered, "audience.PutAudienceHandler")
+	}
 	if o.ConstraintPutConstraintHandler == nil {
 		unregistered = append(unregistered, "constraint.PutConstraintHandler")
 	}
@@ -431,9 +707,21 @@ func (o *FlagrAPI) Validate() error {
 	if o.VariantPutVariantHandler == nil {
 		unregistered = append(unregistered, "variant.PutVariantHandler")
 	}
+	if o.IDListRemoveIDListEntriesHandler == nil {
+		unregistered = append(unregistered, "id_list.RemoveIDListEntriesHandler")
+	}
+	if o.IDListReplaceIDListEntriesHandler == nil {
+		unregistered = append(unregistered, "id_list.ReplaceIDListEntriesHandler")
+	}
+	if o.FlagResetFlagAssignmentSaltHandler == nil {
+		unregistered = append(unregistered, "flag.ResetFlagAssignmentSaltHandler")
+	}
 	if o.FlagRestoreFlagHandler == nil {
 		unregistered = append(unregistered, "flag.RestoreFlagHandler")
 	}
+	if o.RolloutRampResumeRolloutRampHandler == nil {
+		unregistered = append(unregistered, "rollout_ramp.ResumeRolloutRampHandler")
+	}
 	if o.FlagSetFlagEnabledHandler == nil {
 		unregistered = append(unregistered, "flag.SetFlagEnabledHandler")
 	}
@@ -466,8 +754,12 @@ func (o *FlagrAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consumer
 	result := make(map[string]runtime.Consumer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "text/csv":
+			result["text/csv"] = o.CsvConsumer
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
+		case "text/plain":
+			result["text/plain"] = o.TxtConsumer
 		}
 
 		if c, ok := o.customConsumers[mt]; ok {
@@ -487,6 +779,8 @@ func (o *FlagrAPI) ProducersFor(mediaTypes []string) map[string]runtime.Producer
 			result["application/octet-stream"] = o.BinProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -527,6 +821,22 @@ func (o *FlagrAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/flags/{flagID}/segments/{segmentID}/rollout_ramps/{rolloutRampID}/abort"] = rollout_ramp.NewAbortRolloutRamp(o.context, o.RolloutRampAbortRolloutRampHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/idlists/{idListID}/entries"] = id_list.NewAppendIDListEntries(o.context, o.IDListAppendIDListEntriesHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/flags/{flagID}/scheduled_changes/{scheduledChangeID}"] = scheduled_change.NewCancelScheduledChange(o.context, o.ScheduledChangeCancelScheduledChangeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/audiences"] = audience.NewCreateAudience(o.context, o.AudienceCreateAudienceHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -538,6 +848,18 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/idlists"] = id_list.NewCreateIDList(o.context, o.IDListCreateIDListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/flags/{flagID}/segments/{segmentID}/rollout_ramps"] = rollout_ramp.NewCreateRolloutRamp(o.context, o.RolloutRampCreateRolloutRampHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/flags/{flagID}/scheduled_changes"] = scheduled_change.NewCreateScheduledChange(o.context, o.ScheduledChangeCreateScheduledChangeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/flags/{flagID}/segments"] = segment.NewCreateSegment(o.context, o.SegmentCreateSegmentHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -547,6 +869,14 @@ func (o *FlagrAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/flags/{flagID}/variants"] = variant.NewCreateVariant(o.context, o.VariantCreateVariantHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/flags/{flagID}/overrides"] = variant_override.NewCreateVariantOverride(o.context, o.VariantOverrideCreateVariantOverrideHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/audiences/{audienceID}"] = audience.NewDeleteAudience(o.context, o.AudienceDeleteAudienceHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -558,6 +888,10 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/idlists/{idListID}"] = id_list.NewDeleteIDList(o.context, o.IDListDeleteIDListHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/flags/{flagID}/segments/{segmentID}"] = segment.NewDeleteSegment(o.context, o.SegmentDeleteSegmentHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -567,6 +901,10 @@ func (o *FlagrAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/flags/{flagID}/variants/{variantID}"] = variant.NewDeleteVariant(o.context, o.VariantDeleteVariantHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/flags/{flagID}/overrides/{variantOverrideID}"] = variant_override.NewDeleteVariantOverride(o.context, o.VariantOverrideDeleteVariantOverrideHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -574,6 +912,14 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/audiences/{audienceID}/flags"] = audience.NewFindAudienceFlags(o.context, o.AudienceFindAudienceFlagsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/audiences"] = audience.NewFindAudiences(o.context, o.AudienceFindAudiencesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/flags/{flagID}/segments/{segmentID}/constraints"] = constraint.NewFindConstraints(o.context, o.ConstraintFindConstraintsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -586,6 +932,18 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/idlists"] = id_list.NewFindIDLists(o.context, o.IDListFindIDListsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/flags/{flagID}/segments/{segmentID}/rollout_ramps"] = rollout_ramp.NewFindRolloutRamps(o.context, o.RolloutRampFindRolloutRampsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/flags/{flagID}/scheduled_changes"] = scheduled_change.NewFindScheduledChanges(o.context, o.ScheduledChangeFindScheduledChangesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/flags/{flagID}/segments"] = segment.NewFindSegments(o.context, o.SegmentFindSegmentsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -594,10 +952,22 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/flags/{flagID}/overrides"] = variant_override.NewFindVariantOverrides(o.context, o.VariantOverrideFindVariantOverridesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/flags/{flagID}/variants"] = variant.NewFindVariants(o.context, o.VariantFindVariantsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/audiences/{audienceID}"] = audience.NewGetAudience(o.context, o.AudienceGetAudienceHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/evaluation/changes"] = evaluation.NewGetEvaluationChanges(o.context, o.EvaluationGetEvaluationChangesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/export/eval_cache/json"] = export.NewGetExportEvalCacheJSON(o.context, o.ExportGetExportEvalCacheJSONHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -619,6 +989,14 @@ func (o *FlagrAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/health"] = health.NewGetHealth(o.context, o.HealthGetHealthHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/idlists/{idListID}"] = id_list.NewGetIDList(o.context, o.IDListGetIDListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/flags/{flagID}/segments/{segmentID}/rollout_ramps/{rolloutRampID}/pause"] = rollout_ramp.NewPauseRolloutRamp(o.context, o.RolloutRampPauseRolloutRampHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -627,6 +1005,34 @@ func (o *FlagrAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/evaluation/batch"] = evaluation.NewPostEvaluationBatch(o.context, o.EvaluationPostEvaluationBatchHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/evaluation/bootstrap"] = evaluation.NewPostEvaluationBootstrap(o.context, o.EvaluationPostEvaluationBootstrapHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/evaluation/dry_run"] = evaluation.NewPostEvaluationDryRun(o.context, o.EvaluationPostEvaluationDryRunHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/evaluation/simulation"] = evaluation.NewPostEvaluationSimulation(o.context, o.EvaluationPostEvaluationSimulationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/evaluation/snapshot"] = evaluation.NewPostEvaluationSnapshot(o.context, o.EvaluationPostEvaluationSnapshotHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/ofrep/v1/evaluate/flags/{key}"] = ofrep.NewPostOfrepEvaluateFlag(o.context, o.OfrepPostOfrepEvaluateFlagHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/ofrep/v1/evaluate/flags"] = ofrep.NewPostOfrepEvaluateFlags(o.context, o.OfrepPostOfrepEvaluateFlagsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/audiences/{audienceID}"] = audience.NewPutAudience(o.context, o.AudiencePutAudienceHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -651,6 +1057,18 @@ func (o *FlagrAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/flags/{flagID}/variants/{variantID}"] = variant.NewPutVariant(o.context, o.VariantPutVariantHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/idlists/{idListID}/entries"] = id_list.NewRemoveIDListEntries(o.context, o.IDListRemoveIDListEntriesHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/idlists/{idListID}/entries"] = id_list.NewReplaceIDListEntries(o.context, o.IDListReplaceIDListEntriesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/flags/{flagID}/assignment_salt/reset"] = flag.NewResetFlagAssignmentSalt(o.context, o.FlagResetFlagAssignmentSaltHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -658,6 +1076,10 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/flags/{flagID}/segments/{segmentID}/rollout_ramps/{rolloutRampID}/resume"] = rollout_ramp.NewResumeRolloutRamp(o.context, o.RolloutRampResumeRolloutRampHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/flags/{flagID}/enabled"] = flag.NewSetFlagEnabled(o.context, o.FlagSetFlagEnabledHandler)
 }
 
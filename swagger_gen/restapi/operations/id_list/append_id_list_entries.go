@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// AppendIDListEntriesHandlerFunc turns a function with the right signature into a append ID list entries handler
+type AppendIDListEntriesHandlerFunc func(AppendIDListEntriesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AppendIDListEntriesHandlerFunc) Handle(params AppendIDListEntriesParams) middleware.Responder {
+	return fn(params)
+}
+
+// AppendIDListEntriesHandler interface for that can handle valid append ID list entries params
+type AppendIDListEntriesHandler interface {
+	Handle(AppendIDListEntriesParams) middleware.Responder
+}
+
+// NewAppendIDListEntries creates a new http.Handler for the append ID list entries operation
+func NewAppendIDListEntries(ctx *middleware.Context, handler AppendIDListEntriesHandler) *AppendIDListEntries {
+	return &AppendIDListEntries{Context: ctx, Handler: handler}
+}
+
+/*
+	AppendIDListEntries swagger:route POST /idlists/{idListID}/entries idList appendIdListEntries
+
+Append the entries to the ID list, the existing entries are kept. The body is either CSV or one entry per line.
+*/
+type AppendIDListEntries struct {
+	Context *middleware.Context
+	Handler AppendIDListEntriesHandler
+}
+
+func (o *AppendIDListEntries) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAppendIDListEntriesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
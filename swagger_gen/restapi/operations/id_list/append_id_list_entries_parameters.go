@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewAppendIDListEntriesParams creates a new AppendIDListEntriesParams object
+//
+// There are no default values defined in the spec.
+func NewAppendIDListEntriesParams() AppendIDListEntriesParams {
+
+	return AppendIDListEntriesParams{}
+}
+
+// AppendIDListEntriesParams contains all the bound params for the append ID list entries operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters appendIDListEntries
+type AppendIDListEntriesParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*the entries to append
+	  Required: true
+	  In: body
+	*/
+	Body string
+	/*numeric ID of the ID list
+	  Required: true
+	  Minimum: 1
+	  In: path
+	*/
+	IDListID int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewAppendIDListEntriesParams() beforehand.
+func (o *AppendIDListEntriesParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body string
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
+		} else {
+			// no validation required on inline body
+			o.Body = body
+		}
+	} else {
+		res = append(res, errors.Required("body", "body", ""))
+	}
+
+	rIDListID, rhkIDListID, _ := route.Params.GetOK("idListID")
+	if err := o.bindIDListID(rIDListID, rhkIDListID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindIDListID binds and validates parameter IDListID from path.
+func (o *AppendIDListEntriesParams) bindIDListID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("idListID", "path", "int64", raw)
+	}
+	o.IDListID = value
+
+	if err := o.validateIDListID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateIDListID carries on validations for parameter IDListID
+func (o *AppendIDListEntriesParams) validateIDListID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("idListID", "path", o.IDListID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// AppendIDListEntriesOKCode is the HTTP code returned for type AppendIDListEntriesOK
+const AppendIDListEntriesOKCode int = 200
+
+/*
+AppendIDListEntriesOK returns the ID list
+
+swagger:response appendIdListEntriesOK
+*/
+type AppendIDListEntriesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.IDList `json:"body,omitempty"`
+}
+
+// NewAppendIDListEntriesOK creates AppendIDListEntriesOK with default headers values
+func NewAppendIDListEntriesOK() *AppendIDListEntriesOK {
+
+	return &AppendIDListEntriesOK{}
+}
+
+// WithPayload adds the payload to the append Id list entries o k response
+func (o *AppendIDListEntriesOK) WithPayload(payload *models.IDList) *AppendIDListEntriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the append Id list entries o k response
+func (o *AppendIDListEntriesOK) SetPayload(payload *models.IDList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AppendIDListEntriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+AppendIDListEntriesDefault generic error response
+
+swagger:response appendIdListEntriesDefault
+*/
+type AppendIDListEntriesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAppendIDListEntriesDefault creates AppendIDListEntriesDefault with default headers values
+func NewAppendIDListEntriesDefault(code int) *AppendIDListEntriesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &AppendIDListEntriesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the append ID list entries default response
+func (o *AppendIDListEntriesDefault) WithStatusCode(code int) *AppendIDListEntriesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the append ID list entries default response
+func (o *AppendIDListEntriesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the append ID list entries default response
+func (o *AppendIDListEntriesDefault) WithPayload(payload *models.Error) *AppendIDListEntriesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the append ID list entries default response
+func (o *AppendIDListEntriesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AppendIDListEntriesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
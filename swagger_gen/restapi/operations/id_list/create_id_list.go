@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreateIDListHandlerFunc turns a function with the right signature into a create ID list handler
+type CreateIDListHandlerFunc func(CreateIDListParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateIDListHandlerFunc) Handle(params CreateIDListParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateIDListHandler interface for that can handle valid create ID list params
+type CreateIDListHandler interface {
+	Handle(CreateIDListParams) middleware.Responder
+}
+
+// NewCreateIDList creates a new http.Handler for the create ID list operation
+func NewCreateIDList(ctx *middleware.Context, handler CreateIDListHandler) *CreateIDList {
+	return &CreateIDList{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateIDList swagger:route POST /idlists idList createIdList
+
+CreateIDList create ID list API
+*/
+type CreateIDList struct {
+	Context *middleware.Context
+	Handler CreateIDListHandler
+}
+
+func (o *CreateIDList) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateIDListParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
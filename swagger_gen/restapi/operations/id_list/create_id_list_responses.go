@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// CreateIDListOKCode is the HTTP code returned for type CreateIDListOK
+const CreateIDListOKCode int = 200
+
+/*
+CreateIDListOK returns the created ID list
+
+swagger:response createIdListOK
+*/
+type CreateIDListOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.IDList `json:"body,omitempty"`
+}
+
+// NewCreateIDListOK creates CreateIDListOK with default headers values
+func NewCreateIDListOK() *CreateIDListOK {
+
+	return &CreateIDListOK{}
+}
+
+// WithPayload adds the payload to the create Id list o k response
+func (o *CreateIDListOK) WithPayload(payload *models.IDList) *CreateIDListOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create Id list o k response
+func (o *CreateIDListOK) SetPayload(payload *models.IDList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateIDListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+CreateIDListDefault generic error response
+
+swagger:response createIdListDefault
+*/
+type CreateIDListDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateIDListDefault creates CreateIDListDefault with default headers values
+func NewCreateIDListDefault(code int) *CreateIDListDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateIDListDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create ID list default response
+func (o *CreateIDListDefault) WithStatusCode(code int) *CreateIDListDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create ID list default response
+func (o *CreateIDListDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create ID list default response
+func (o *CreateIDListDefault) WithPayload(payload *models.Error) *CreateIDListDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create ID list default response
+func (o *CreateIDListDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateIDListDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
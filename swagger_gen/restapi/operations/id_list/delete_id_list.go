@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteIDListHandlerFunc turns a function with the right signature into a delete ID list handler
+type DeleteIDListHandlerFunc func(DeleteIDListParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteIDListHandlerFunc) Handle(params DeleteIDListParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteIDListHandler interface for that can handle valid delete ID list params
+type DeleteIDListHandler interface {
+	Handle(DeleteIDListParams) middleware.Responder
+}
+
+// NewDeleteIDList creates a new http.Handler for the delete ID list operation
+func NewDeleteIDList(ctx *middleware.Context, handler DeleteIDListHandler) *DeleteIDList {
+	return &DeleteIDList{Context: ctx, Handler: handler}
+}
+
+/*
+	DeleteIDList swagger:route DELETE /idlists/{idListID} idList deleteIdList
+
+Delete the ID list and its entries. It fails if any constraint still references the ID list.
+*/
+type DeleteIDList struct {
+	Context *middleware.Context
+	Handler DeleteIDListHandler
+}
+
+func (o *DeleteIDList) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteIDListParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
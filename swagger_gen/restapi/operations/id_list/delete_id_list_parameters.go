@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewDeleteIDListParams creates a new DeleteIDListParams object
+//
+// There are no default values defined in the spec.
+func NewDeleteIDListParams() DeleteIDListParams {
+
+	return DeleteIDListParams{}
+}
+
+// DeleteIDListParams contains all the bound params for the delete ID list operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters deleteIDList
+type DeleteIDListParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*numeric ID of the ID list
+	  Required: true
+	  Minimum: 1
+	  In: path
+	*/
+	IDListID int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewDeleteIDListParams() beforehand.
+func (o *DeleteIDListParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rIDListID, rhkIDListID, _ := route.Params.GetOK("idListID")
+	if err := o.bindIDListID(rIDListID, rhkIDListID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindIDListID binds and validates parameter IDListID from path.
+func (o *DeleteIDListParams) bindIDListID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("idListID", "path", "int64", raw)
+	}
+	o.IDListID = value
+
+	if err := o.validateIDListID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateIDListID carries on validations for parameter IDListID
+func (o *DeleteIDListParams) validateIDListID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("idListID", "path", o.IDListID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// DeleteIDListOKCode is the HTTP code returned for type DeleteIDListOK
+const DeleteIDListOKCode int = 200
+
+/*
+DeleteIDListOK OK deleted
+
+swagger:response deleteIdListOK
+*/
+type DeleteIDListOK struct {
+}
+
+// NewDeleteIDListOK creates DeleteIDListOK with default headers values
+func NewDeleteIDListOK() *DeleteIDListOK {
+
+	return &DeleteIDListOK{}
+}
+
+// WriteResponse to the client
+func (o *DeleteIDListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+/*
+DeleteIDListDefault generic error response
+
+swagger:response deleteIdListDefault
+*/
+type DeleteIDListDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteIDListDefault creates DeleteIDListDefault with default headers values
+func NewDeleteIDListDefault(code int) *DeleteIDListDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteIDListDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete ID list default response
+func (o *DeleteIDListDefault) WithStatusCode(code int) *DeleteIDListDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete ID list default response
+func (o *DeleteIDListDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete ID list default response
+func (o *DeleteIDListDefault) WithPayload(payload *models.Error) *DeleteIDListDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete ID list default response
+func (o *DeleteIDListDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteIDListDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
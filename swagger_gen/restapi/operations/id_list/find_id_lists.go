@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// FindIDListsHandlerFunc turns a function with the right signature into a find ID lists handler
+type FindIDListsHandlerFunc func(FindIDListsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn FindIDListsHandlerFunc) Handle(params FindIDListsParams) middleware.Responder {
+	return fn(params)
+}
+
+// FindIDListsHandler interface for that can handle valid find ID lists params
+type FindIDListsHandler interface {
+	Handle(FindIDListsParams) middleware.Responder
+}
+
+// NewFindIDLists creates a new http.Handler for the find ID lists operation
+func NewFindIDLists(ctx *middleware.Context, handler FindIDListsHandler) *FindIDLists {
+	return &FindIDLists{Context: ctx, Handler: handler}
+}
+
+/*
+	FindIDLists swagger:route GET /idlists idList findIdLists
+
+FindIDLists find ID lists API
+*/
+type FindIDLists struct {
+	Context *middleware.Context
+	Handler FindIDListsHandler
+}
+
+func (o *FindIDLists) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewFindIDListsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
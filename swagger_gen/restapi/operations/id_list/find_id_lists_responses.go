@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// FindIDListsOKCode is the HTTP code returned for type FindIDListsOK
+const FindIDListsOKCode int = 200
+
+/*
+FindIDListsOK list all the ID lists
+
+swagger:response findIdListsOK
+*/
+type FindIDListsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.IDList `json:"body,omitempty"`
+}
+
+// NewFindIDListsOK creates FindIDListsOK with default headers values
+func NewFindIDListsOK() *FindIDListsOK {
+
+	return &FindIDListsOK{}
+}
+
+// WithPayload adds the payload to the find Id lists o k response
+func (o *FindIDListsOK) WithPayload(payload []*models.IDList) *FindIDListsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find Id lists o k response
+func (o *FindIDListsOK) SetPayload(payload []*models.IDList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindIDListsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.IDList, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+FindIDListsDefault generic error response
+
+swagger:response findIdListsDefault
+*/
+type FindIDListsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindIDListsDefault creates FindIDListsDefault with default headers values
+func NewFindIDListsDefault(code int) *FindIDListsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &FindIDListsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the find ID lists default response
+func (o *FindIDListsDefault) WithStatusCode(code int) *FindIDListsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the find ID lists default response
+func (o *FindIDListsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the find ID lists default response
+func (o *FindIDListsDefault) WithPayload(payload *models.Error) *FindIDListsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find ID lists default response
+func (o *FindIDListsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindIDListsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetIDListHandlerFunc turns a function with the right signature into a get ID list handler
+type GetIDListHandlerFunc func(GetIDListParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetIDListHandlerFunc) Handle(params GetIDListParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetIDListHandler interface for that can handle valid get ID list params
+type GetIDListHandler interface {
+	Handle(GetIDListParams) middleware.Responder
+}
+
+// NewGetIDList creates a new http.Handler for the get ID list operation
+func NewGetIDList(ctx *middleware.Context, handler GetIDListHandler) *GetIDList {
+	return &GetIDList{Context: ctx, Handler: handler}
+}
+
+/*
+	GetIDList swagger:route GET /idlists/{idListID} idList getIdList
+
+GetIDList get ID list API
+*/
+type GetIDList struct {
+	Context *middleware.Context
+	Handler GetIDListHandler
+}
+
+func (o *GetIDList) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetIDListParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// GetIDListOKCode is the HTTP code returned for type GetIDListOK
+const GetIDListOKCode int = 200
+
+/*
+GetIDListOK returns the ID list
+
+swagger:response getIdListOK
+*/
+type GetIDListOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.IDList `json:"body,omitempty"`
+}
+
+// NewGetIDListOK creates GetIDListOK with default headers values
+func NewGetIDListOK() *GetIDListOK {
+
+	return &GetIDListOK{}
+}
+
+// WithPayload adds the payload to the get Id list o k response
+func (o *GetIDListOK) WithPayload(payload *models.IDList) *GetIDListOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Id list o k response
+func (o *GetIDListOK) SetPayload(payload *models.IDList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetIDListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+GetIDListDefault generic error response
+
+swagger:response getIdListDefault
+*/
+type GetIDListDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetIDListDefault creates GetIDListDefault with default headers values
+func NewGetIDListDefault(code int) *GetIDListDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetIDListDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get ID list default response
+func (o *GetIDListDefault) WithStatusCode(code int) *GetIDListDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get ID list default response
+func (o *GetIDListDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get ID list default response
+func (o *GetIDListDefault) WithPayload(payload *models.Error) *GetIDListDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get ID list default response
+func (o *GetIDListDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetIDListDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
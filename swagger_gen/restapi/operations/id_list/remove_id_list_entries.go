@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// RemoveIDListEntriesHandlerFunc turns a function with the right signature into a remove ID list entries handler
+type RemoveIDListEntriesHandlerFunc func(RemoveIDListEntriesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RemoveIDListEntriesHandlerFunc) Handle(params RemoveIDListEntriesParams) middleware.Responder {
+	return fn(params)
+}
+
+// RemoveIDListEntriesHandler interface for that can handle valid remove ID list entries params
+type RemoveIDListEntriesHandler interface {
+	Handle(RemoveIDListEntriesParams) middleware.Responder
+}
+
+// NewRemoveIDListEntries creates a new http.Handler for the remove ID list entries operation
+func NewRemoveIDListEntries(ctx *middleware.Context, handler RemoveIDListEntriesHandler) *RemoveIDListEntries {
+	return &RemoveIDListEntries{Context: ctx, Handler: handler}
+}
+
+/*
+	RemoveIDListEntries swagger:route DELETE /idlists/{idListID}/entries idList removeIdListEntries
+
+Remove the entries from the ID list. The body is either CSV or one entry per line.
+*/
+type RemoveIDListEntries struct {
+	Context *middleware.Context
+	Handler RemoveIDListEntriesHandler
+}
+
+func (o *RemoveIDListEntries) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRemoveIDListEntriesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// RemoveIDListEntriesOKCode is the HTTP code returned for type RemoveIDListEntriesOK
+const RemoveIDListEntriesOKCode int = 200
+
+/*
+RemoveIDListEntriesOK returns the ID list
+
+swagger:response removeIdListEntriesOK
+*/
+type RemoveIDListEntriesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.IDList `json:"body,omitempty"`
+}
+
+// NewRemoveIDListEntriesOK creates RemoveIDListEntriesOK with default headers values
+func NewRemoveIDListEntriesOK() *RemoveIDListEntriesOK {
+
+	return &RemoveIDListEntriesOK{}
+}
+
+// WithPayload adds the payload to the remove Id list entries o k response
+func (o *RemoveIDListEntriesOK) WithPayload(payload *models.IDList) *RemoveIDListEntriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the remove Id list entries o k response
+func (o *RemoveIDListEntriesOK) SetPayload(payload *models.IDList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RemoveIDListEntriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+RemoveIDListEntriesDefault generic error response
+
+swagger:response removeIdListEntriesDefault
+*/
+type RemoveIDListEntriesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRemoveIDListEntriesDefault creates RemoveIDListEntriesDefault with default headers values
+func NewRemoveIDListEntriesDefault(code int) *RemoveIDListEntriesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RemoveIDListEntriesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the remove ID list entries default response
+func (o *RemoveIDListEntriesDefault) WithStatusCode(code int) *RemoveIDListEntriesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the remove ID list entries default response
+func (o *RemoveIDListEntriesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the remove ID list entries default response
+func (o *RemoveIDListEntriesDefault) WithPayload(payload *models.Error) *RemoveIDListEntriesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the remove ID list entries default response
+func (o *RemoveIDListEntriesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RemoveIDListEntriesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ReplaceIDListEntriesHandlerFunc turns a function with the right signature into a replace ID list entries handler
+type ReplaceIDListEntriesHandlerFunc func(ReplaceIDListEntriesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplaceIDListEntriesHandlerFunc) Handle(params ReplaceIDListEntriesParams) middleware.Responder {
+	return fn(params)
+}
+
+// ReplaceIDListEntriesHandler interface for that can handle valid replace ID list entries params
+type ReplaceIDListEntriesHandler interface {
+	Handle(ReplaceIDListEntriesParams) middleware.Responder
+}
+
+// NewReplaceIDListEntries creates a new http.Handler for the replace ID list entries operation
+func NewReplaceIDListEntries(ctx *middleware.Context, handler ReplaceIDListEntriesHandler) *ReplaceIDListEntries {
+	return &ReplaceIDListEntries{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplaceIDListEntries swagger:route PUT /idlists/{idListID}/entries idList replaceIdListEntries
+
+Upload the entries of the ID list, replacing all the existing entries. The body is either CSV or one entry per line.
+*/
+type ReplaceIDListEntries struct {
+	Context *middleware.Context
+	Handler ReplaceIDListEntriesHandler
+}
+
+func (o *ReplaceIDListEntries) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplaceIDListEntriesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
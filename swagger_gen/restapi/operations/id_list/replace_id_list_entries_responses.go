@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package id_list
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// ReplaceIDListEntriesOKCode is the HTTP code returned for type ReplaceIDListEntriesOK
+const ReplaceIDListEntriesOKCode int = 200
+
+/*
+ReplaceIDListEntriesOK returns the ID list
+
+swagger:response replaceIdListEntriesOK
+*/
+type ReplaceIDListEntriesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.IDList `json:"body,omitempty"`
+}
+
+// NewReplaceIDListEntriesOK creates ReplaceIDListEntriesOK with default headers values
+func NewReplaceIDListEntriesOK() *ReplaceIDListEntriesOK {
+
+	return &ReplaceIDListEntriesOK{}
+}
+
+// WithPayload adds the payload to the replace Id list entries o k response
+func (o *ReplaceIDListEntriesOK) WithPayload(payload *models.IDList) *ReplaceIDListEntriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replace Id list entries o k response
+func (o *ReplaceIDListEntriesOK) SetPayload(payload *models.IDList) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplaceIDListEntriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ReplaceIDListEntriesDefault generic error response
+
+swagger:response replaceIdListEntriesDefault
+*/
+type ReplaceIDListEntriesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewReplaceIDListEntriesDefault creates ReplaceIDListEntriesDefault with default headers values
+func NewReplaceIDListEntriesDefault(code int) *ReplaceIDListEntriesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ReplaceIDListEntriesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the replace ID list entries default response
+func (o *ReplaceIDListEntriesDefault) WithStatusCode(code int) *ReplaceIDListEntriesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the replace ID list entries default response
+func (o *ReplaceIDListEntriesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the replace ID list entries default response
+func (o *ReplaceIDListEntriesDefault) WithPayload(payload *models.Error) *ReplaceIDListEntriesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replace ID list entries default response
+func (o *ReplaceIDListEntriesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplaceIDListEntriesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package ofrep
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PostOfrepEvaluateFlagHandlerFunc turns a function with the right signature into a post ofrep evaluate flag handler
+type PostOfrepEvaluateFlagHandlerFunc func(PostOfrepEvaluateFlagParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostOfrepEvaluateFlagHandlerFunc) Handle(params PostOfrepEvaluateFlagParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostOfrepEvaluateFlagHandler interface for that can handle valid post ofrep evaluate flag params
+type PostOfrepEvaluateFlagHandler interface {
+	Handle(PostOfrepEvaluateFlagParams) middleware.Responder
+}
+
+// NewPostOfrepEvaluateFlag creates a new http.Handler for the post ofrep evaluate flag operation
+func NewPostOfrepEvaluateFlag(ctx *middleware.Context, handler PostOfrepEvaluateFlagHandler) *PostOfrepEvaluateFlag {
+	return &PostOfrepEvaluateFlag{Context: ctx, Handler: handler}
+}
+
+/*
+	PostOfrepEvaluateFlag swagger:route POST /ofrep/v1/evaluate/flags/{key} ofrep postOfrepEvaluateFlag
+
+evaluates the flag for the context with the OpenFeature Remote Evaluation Protocol (OFREP), so that any OpenFeature SDK with the OFREP provider can evaluate the flags of flagr. The base URL of the provider is the base path of the APIs, e.g. http://localhost:18000/api/v1. Only the client visible flags are evaluated, the others are not found.
+*/
+type PostOfrepEvaluateFlag struct {
+	Context *middleware.Context
+	Handler PostOfrepEvaluateFlagHandler
+}
+
+func (o *PostOfrepEvaluateFlag) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostOfrepEvaluateFlagParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
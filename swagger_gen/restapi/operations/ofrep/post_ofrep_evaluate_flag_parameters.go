@@ -0,0 +1,124 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package ofrep
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// NewPostOfrepEvaluateFlagParams creates a new PostOfrepEvaluateFlagParams object
+//
+// There are no default values defined in the spec.
+func NewPostOfrepEvaluateFlagParams() PostOfrepEvaluateFlagParams {
+
+	return PostOfrepEvaluateFlagParams{}
+}
+
+// PostOfrepEvaluateFlagParams contains all the bound params for the post ofrep evaluate flag operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters postOfrepEvaluateFlag
+type PostOfrepEvaluateFlagParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*OFREP evaluation request
+	  Required: true
+	  In: body
+	*/
+	Body *models.OfrepEvaluationRequest
+	/*the flag key
+	  Required: true
+	  Min Length: 1
+	  In: path
+	*/
+	Key string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPostOfrepEvaluateFlagParams() beforehand.
+func (o *PostOfrepEvaluateFlagParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.OfrepEvaluationRequest
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(r.Context())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Body = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("body", "body", ""))
+	}
+
+	rKey, rhkKey, _ := route.Params.GetOK("key")
+	if err := o.bindKey(rKey, rhkKey, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindKey binds and validates parameter Key from path.
+func (o *PostOfrepEvaluateFlagParams) bindKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.Key = raw
+
+	if err := o.validateKey(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateKey carries on validations for parameter Key
+func (o *PostOfrepEvaluateFlagParams) validateKey(formats strfmt.Registry) error {
+
+	if err := validate.MinLength("key", "path", o.Key, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
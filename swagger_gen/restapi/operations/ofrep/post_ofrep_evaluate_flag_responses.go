@@ -0,0 +1,208 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package ofrep
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// PostOfrepEvaluateFlagOKCode is the HTTP code returned for type PostOfrepEvaluateFlagOK
+const PostOfrepEvaluateFlagOKCode int = 200
+
+/*
+PostOfrepEvaluateFlagOK the evaluation result of the flag
+
+swagger:response postOfrepEvaluateFlagOK
+*/
+type PostOfrepEvaluateFlagOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.OfrepEvaluationResult `json:"body,omitempty"`
+}
+
+// NewPostOfrepEvaluateFlagOK creates PostOfrepEvaluateFlagOK with default headers values
+func NewPostOfrepEvaluateFlagOK() *PostOfrepEvaluateFlagOK {
+
+	return &PostOfrepEvaluateFlagOK{}
+}
+
+// WithPayload adds the payload to the post ofrep evaluate flag o k response
+func (o *PostOfrepEvaluateFlagOK) WithPayload(payload *models.OfrepEvaluationResult) *PostOfrepEvaluateFlagOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post ofrep evaluate flag o k response
+func (o *PostOfrepEvaluateFlagOK) SetPayload(payload *models.OfrepEvaluationResult) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostOfrepEvaluateFlagOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostOfrepEvaluateFlagBadRequestCode is the HTTP code returned for type PostOfrepEvaluateFlagBadRequest
+const PostOfrepEvaluateFlagBadRequestCode int = 400
+
+/*
+PostOfrepEvaluateFlagBadRequest the evaluation failed, e.g. an invalid context or a variant value mismatching the flag's valueType
+
+swagger:response postOfrepEvaluateFlagBadRequest
+*/
+type PostOfrepEvaluateFlagBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.OfrepEvaluationResult `json:"body,omitempty"`
+}
+
+// NewPostOfrepEvaluateFlagBadRequest creates PostOfrepEvaluateFlagBadRequest with default headers values
+func NewPostOfrepEvaluateFlagBadRequest() *PostOfrepEvaluateFlagBadRequest {
+
+	return &PostOfrepEvaluateFlagBadRequest{}
+}
+
+// WithPayload adds the payload to the post ofrep evaluate flag bad request response
+func (o *PostOfrepEvaluateFlagBadRequest) WithPayload(payload *models.OfrepEvaluationResult) *PostOfrepEvaluateFlagBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post ofrep evaluate flag bad request response
+func (o *PostOfrepEvaluateFlagBadRequest) SetPayload(payload *models.OfrepEvaluationResult) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostOfrepEvaluateFlagBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostOfrepEvaluateFlagNotFoundCode is the HTTP code returned for type PostOfrepEvaluateFlagNotFound
+const PostOfrepEvaluateFlagNotFoundCode int = 404
+
+/*
+PostOfrepEvaluateFlagNotFound the flag is not found or not client visible
+
+swagger:response postOfrepEvaluateFlagNotFound
+*/
+type PostOfrepEvaluateFlagNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.OfrepEvaluationResult `json:"body,omitempty"`
+}
+
+// NewPostOfrepEvaluateFlagNotFound creates PostOfrepEvaluateFlagNotFound with default headers values
+func NewPostOfrepEvaluateFlagNotFound() *PostOfrepEvaluateFlagNotFound {
+
+	return &PostOfrepEvaluateFlagNotFound{}
+}
+
+// WithPayload adds the payload to the post ofrep evaluate flag not found response
+func (o *PostOfrepEvaluateFlagNotFound) WithPayload(payload *models.OfrepEvaluationResult) *PostOfrepEvaluateFlagNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post ofrep evaluate flag not found response
+func (o *PostOfrepEvaluateFlagNotFound) SetPayload(payload *models.OfrepEvaluationResult) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostOfrepEvaluateFlagNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostOfrepEvaluateFlagDefault generic error response
+
+swagger:response postOfrepEvaluateFlagDefault
+*/
+type PostOfrepEvaluateFlagDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostOfrepEvaluateFlagDefault creates PostOfrepEvaluateFlagDefault with default headers values
+func NewPostOfrepEvaluateFlagDefault(code int) *PostOfrepEvaluateFlagDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostOfrepEvaluateFlagDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post ofrep evaluate flag default response
+func (o *PostOfrepEvaluateFlagDefault) WithStatusCode(code int) *PostOfrepEvaluateFlagDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post ofrep evaluate flag default response
+func (o *PostOfrepEvaluateFlagDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post ofrep evaluate flag default response
+func (o *PostOfrepEvaluateFlagDefault) WithPayload(payload *models.Error) *PostOfrepEvaluateFlagDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post ofrep evaluate flag default response
+func (o *PostOfrepEvaluateFlagDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostOfrepEvaluateFlagDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package ofrep
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PostOfrepEvaluateFlagsHandlerFunc turns a function with the right signature into a post ofrep evaluate flags handler
+type PostOfrepEvaluateFlagsHandlerFunc func(PostOfrepEvaluateFlagsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostOfrepEvaluateFlagsHandlerFunc) Handle(params PostOfrepEvaluateFlagsParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostOfrepEvaluateFlagsHandler interface for that can handle valid post ofrep evaluate flags params
+type PostOfrepEvaluateFlagsHandler interface {
+	Handle(PostOfrepEvaluateFlagsParams) middleware.Responder
+}
+
+// NewPostOfrepEvaluateFlags creates a new http.Handler for the post ofrep evaluate flags operation
+func NewPostOfrepEvaluateFlags(ctx *middleware.Context, handler PostOfrepEvaluateFlagsHandler) *PostOfrepEvaluateFlags {
+	return &PostOfrepEvaluateFlags{Context: ctx, Handler: handler}
+}
+
+/*
+	PostOfrepEvaluateFlags swagger:route POST /ofrep/v1/evaluate/flags ofrep postOfrepEvaluateFlags
+
+evaluates all the enabled and client visible flags for the context with the OpenFeature Remote Evaluation Protocol (OFREP), e.g. for the OpenFeature SDKs of the static context paradigm to cache the flags. The ETag of the response is the hash of the results, and the request with a matching If-None-Match header gets 304. The results are not logged or recorded as data records.
+*/
+type PostOfrepEvaluateFlags struct {
+	Context *middleware.Context
+	Handler PostOfrepEvaluateFlagsHandler
+}
+
+func (o *PostOfrepEvaluateFlags) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostOfrepEvaluateFlagsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,210 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package ofrep
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// PostOfrepEvaluateFlagsOKCode is the HTTP code returned for type PostOfrepEvaluateFlagsOK
+const PostOfrepEvaluateFlagsOKCode int = 200
+
+/*
+PostOfrepEvaluateFlagsOK the evaluation results of the flags
+
+swagger:response postOfrepEvaluateFlagsOK
+*/
+type PostOfrepEvaluateFlagsOK struct {
+	/*
+
+	 */
+	ETag string `json:"ETag"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.OfrepBulkEvaluationResponse `json:"body,omitempty"`
+}
+
+// NewPostOfrepEvaluateFlagsOK creates PostOfrepEvaluateFlagsOK with default headers values
+func NewPostOfrepEvaluateFlagsOK() *PostOfrepEvaluateFlagsOK {
+
+	return &PostOfrepEvaluateFlagsOK{}
+}
+
+// WithETag adds the eTag to the post ofrep evaluate flags o k response
+func (o *PostOfrepEvaluateFlagsOK) WithETag(eTag string) *PostOfrepEvaluateFlagsOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the post ofrep evaluate flags o k response
+func (o *PostOfrepEvaluateFlagsOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithPayload adds the payload to the post ofrep evaluate flags o k response
+func (o *PostOfrepEvaluateFlagsOK) WithPayload(payload *models.OfrepBulkEvaluationResponse) *PostOfrepEvaluateFlagsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post ofrep evaluate flags o k response
+func (o *PostOfrepEvaluateFlagsOK) SetPayload(payload *models.OfrepBulkEvaluationResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostOfrepEvaluateFlagsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostOfrepEvaluateFlagsNotModifiedCode is the HTTP code returned for type PostOfrepEvaluateFlagsNotModified
+const PostOfrepEvaluateFlagsNotModifiedCode int = 304
+
+/*
+PostOfrepEvaluateFlagsNotModified the results are the same as the ones of the If-None-Match header
+
+swagger:response postOfrepEvaluateFlagsNotModified
+*/
+type PostOfrepEvaluateFlagsNotModified struct {
+}
+
+// NewPostOfrepEvaluateFlagsNotModified creates PostOfrepEvaluateFlagsNotModified with default headers values
+func NewPostOfrepEvaluateFlagsNotModified() *PostOfrepEvaluateFlagsNotModified {
+
+	return &PostOfrepEvaluateFlagsNotModified{}
+}
+
+// WriteResponse to the client
+func (o *PostOfrepEvaluateFlagsNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
+// PostOfrepEvaluateFlagsBadRequestCode is the HTTP code returned for type PostOfrepEvaluateFlagsBadRequest
+const PostOfrepEvaluateFlagsBadRequestCode int = 400
+
+/*
+PostOfrepEvaluateFlagsBadRequest the evaluation failed, e.g. an invalid context
+
+swagger:response postOfrepEvaluateFlagsBadRequest
+*/
+type PostOfrepEvaluateFlagsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.OfrepBulkEvaluationFailure `json:"body,omitempty"`
+}
+
+// NewPostOfrepEvaluateFlagsBadRequest creates PostOfrepEvaluateFlagsBadRequest with default headers values
+func NewPostOfrepEvaluateFlagsBadRequest() *PostOfrepEvaluateFlagsBadRequest {
+
+	return &PostOfrepEvaluateFlagsBadRequest{}
+}
+
+// WithPayload adds the payload to the post ofrep evaluate flags bad request response
+func (o *PostOfrepEvaluateFlagsBadRequest) WithPayload(payload *models.OfrepBulkEvaluationFailure) *PostOfrepEvaluateFlagsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post ofrep evaluate flags bad request response
+func (o *PostOfrepEvaluateFlagsBadRequest) SetPayload(payload *models.OfrepBulkEvaluationFailure) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostOfrepEvaluateFlagsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PostOfrepEvaluateFlagsDefault generic error response
+
+swagger:response postOfrepEvaluateFlagsDefault
+*/
+type PostOfrepEvaluateFlagsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostOfrepEvaluateFlagsDefault creates PostOfrepEvaluateFlagsDefault with default headers values
+func NewPostOfrepEvaluateFlagsDefault(code int) *PostOfrepEvaluateFlagsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostOfrepEvaluateFlagsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post ofrep evaluate flags default response
+func (o *PostOfrepEvaluateFlagsDefault) WithStatusCode(code int) *PostOfrepEvaluateFlagsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post ofrep evaluate flags default response
+func (o *PostOfrepEvaluateFlagsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post ofrep evaluate flags default response
+func (o *PostOfrepEvaluateFlagsDefault) WithPayload(payload *models.Error) *PostOfrepEvaluateFlagsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post ofrep evaluate flags default response
+func (o *PostOfrepEvaluateFlagsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostOfrepEvaluateFlagsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
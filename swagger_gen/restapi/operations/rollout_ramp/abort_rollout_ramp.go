@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package rollout_ramp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// AbortRolloutRampHandlerFunc turns a function with the right signature into a abort rollout ramp handler
+type AbortRolloutRampHandlerFunc func(AbortRolloutRampParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AbortRolloutRampHandlerFunc) Handle(params AbortRolloutRampParams) middleware.Responder {
+	return fn(params)
+}
+
+// AbortRolloutRampHandler interface for that can handle valid abort rollout ramp params
+type AbortRolloutRampHandler interface {
+	Handle(AbortRolloutRampParams) middleware.Responder
+}
+
+// NewAbortRolloutRamp creates a new http.Handler for the abort rollout ramp operation
+func NewAbortRolloutRamp(ctx *middleware.Context, handler AbortRolloutRampHandler) *AbortRolloutRamp {
+	return &AbortRolloutRamp{Context: ctx, Handler: handler}
+}
+
+/*
+	AbortRolloutRamp swagger:route PUT /flags/{flagID}/segments/{segmentID}/rollout_ramps/{rolloutRampID}/abort rolloutRamp abortRolloutRamp
+
+Abort the active or paused rollout ramp, the rollout percent of the segment stays at the current step
+*/
+type AbortRolloutRamp struct {
+	Context *middleware.Context
+	Handler AbortRolloutRampHandler
+}
+
+func (o *AbortRolloutRamp) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAbortRolloutRampParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package rollout_ramp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// AbortRolloutRampOKCode is the HTTP code returned for type AbortRolloutRampOK
+const AbortRolloutRampOKCode int = 200
+
+/*
+AbortRolloutRampOK the rollout ramp
+
+swagger:response abortRolloutRampOK
+*/
+type AbortRolloutRampOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.RolloutRamp `json:"body,omitempty"`
+}
+
+// NewAbortRolloutRampOK creates AbortRolloutRampOK with default headers values
+func NewAbortRolloutRampOK() *AbortRolloutRampOK {
+
+	return &AbortRolloutRampOK{}
+}
+
+// WithPayload adds the payload to the abort rollout ramp o k response
+func (o *AbortRolloutRampOK) WithPayload(payload *models.RolloutRamp) *AbortRolloutRampOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the abort rollout ramp o k response
+func (o *AbortRolloutRampOK) SetPayload(payload *models.RolloutRamp) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AbortRolloutRampOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+AbortRolloutRampDefault generic error response
+
+swagger:response abortRolloutRampDefault
+*/
+type AbortRolloutRampDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAbortRolloutRampDefault creates AbortRolloutRampDefault with default headers values
+func NewAbortRolloutRampDefault(code int) *AbortRolloutRampDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &AbortRolloutRampDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the abort rollout ramp default response
+func (o *AbortRolloutRampDefault) WithStatusCode(code int) *AbortRolloutRampDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the abort rollout ramp default response
+func (o *AbortRolloutRampDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the abort rollout ramp default response
+func (o *AbortRolloutRampDefault) WithPayload(payload *models.Error) *AbortRolloutRampDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the abort rollout ramp default response
+func (o *AbortRolloutRampDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AbortRolloutRampDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package rollout_ramp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreateRolloutRampHandlerFunc turns a function with the right signature into a create rollout ramp handler
+type CreateRolloutRampHandlerFunc func(CreateRolloutRampParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateRolloutRampHandlerFunc) Handle(params CreateRolloutRampParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateRolloutRampHandler interface for that can handle valid create rollout ramp params
+type CreateRolloutRampHandler interface {
+	Handle(CreateRolloutRampParams) middleware.Responder
+}
+
+// NewCreateRolloutRamp creates a new http.Handler for the create rollout ramp operation
+func NewCreateRolloutRamp(ctx *middleware.Context, handler CreateRolloutRampHandler) *CreateRolloutRamp {
+	return &CreateRolloutRamp{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateRolloutRamp swagger:route POST /flags/{flagID}/segments/{segmentID}/rollout_ramps rolloutRamp createRolloutRamp
+
+Ramp the rollout percent of the segment through the steps automatically. A segment can only have one active or paused rollout ramp.
+*/
+type CreateRolloutRamp struct {
+	Context *middleware.Context
+	Handler CreateRolloutRampHandler
+}
+
+func (o *CreateRolloutRamp) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateRolloutRampParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
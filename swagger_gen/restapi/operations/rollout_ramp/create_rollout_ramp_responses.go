@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package rollout_ramp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// CreateRolloutRampOKCode is the HTTP code returned for type CreateRolloutRampOK
+const CreateRolloutRampOKCode int = 200
+
+/*
+CreateRolloutRampOK the rollout ramp
+
+swagger:response createRolloutRampOK
+*/
+type CreateRolloutRampOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.RolloutRamp `json:"body,omitempty"`
+}
+
+// NewCreateRolloutRampOK creates CreateRolloutRampOK with default headers values
+func NewCreateRolloutRampOK() *CreateRolloutRampOK {
+
+	return &CreateRolloutRampOK{}
+}
+
+// WithPayload adds the payload to the create rollout ramp o k response
+func (o *CreateRolloutRampOK) WithPayload(payload *models.RolloutRamp) *CreateRolloutRampOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create rollout ramp o k response
+func (o *CreateRolloutRampOK) SetPayload(payload *models.RolloutRamp) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRolloutRampOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+CreateRolloutRampDefault generic error response
+
+swagger:response createRolloutRampDefault
+*/
+type CreateRolloutRampDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateRolloutRampDefault creates CreateRolloutRampDefault with default headers values
+func NewCreateRolloutRampDefault(code int) *CreateRolloutRampDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateRolloutRampDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create rollout ramp default response
+func (o *CreateRolloutRampDefault) WithStatusCode(code int) *CreateRolloutRampDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create rollout ramp default response
+func (o *CreateRolloutRampDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create rollout ramp default response
+func (o *CreateRolloutRampDefault) WithPayload(payload *models.Error) *CreateRolloutRampDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create rollout ramp default response
+func (o *CreateRolloutRampDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateRolloutRampDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package rollout_ramp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// FindRolloutRampsHandlerFunc turns a function with the right signature into a find rollout ramps handler
+type FindRolloutRampsHandlerFunc func(FindRolloutRampsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn FindRolloutRampsHandlerFunc) Handle(params FindRolloutRampsParams) middleware.Responder {
+	return fn(params)
+}
+
+// FindRolloutRampsHandler interface for that can handle valid find rollout ramps params
+type FindRolloutRampsHandler interface {
+	Handle(FindRolloutRampsParams) middleware.Responder
+}
+
+// NewFindRolloutRamps creates a new http.Handler for the find rollout ramps operation
+func NewFindRolloutRamps(ctx *middleware.Context, handler FindRolloutRampsHandler) *FindRolloutRamps {
+	return &FindRolloutRamps{Context: ctx, Handler: handler}
+}
+
+/*
+	FindRolloutRamps swagger:route GET /flags/{flagID}/segments/{segmentID}/rollout_ramps rolloutRamp findRolloutRamps
+
+FindRolloutRamps find rollout ramps API
+*/
+type FindRolloutRamps struct {
+	Context *middleware.Context
+	Handler FindRolloutRampsHandler
+}
+
+func (o *FindRolloutRamps) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewFindRolloutRampsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
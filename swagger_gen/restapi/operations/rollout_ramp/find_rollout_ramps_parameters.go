@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package rollout_ramp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewFindRolloutRampsParams creates a new FindRolloutRampsParams object
+//
+// There are no default values defined in the spec.
+func NewFindRolloutRampsParams() FindRolloutRampsParams {
+
+	return FindRolloutRampsParams{}
+}
+
+// FindRolloutRampsParams contains all the bound params for the find rollout ramps operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters findRolloutRamps
+type FindRolloutRampsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*numeric ID of the flag
+	  Required: true
+	  Minimum: 1
+	  In: path
+	*/
+	FlagID int64
+	/*numeric ID of the segment
+	  Required: true
+	  Minimum: 1
+	  In: path
+	*/
+	SegmentID int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewFindRolloutRampsParams() beforehand.
+func (o *FindRolloutRampsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rFlagID, rhkFlagID, _ := route.Params.GetOK("flagID")
+	if err := o.bindFlagID(rFlagID, rhkFlagID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rSegmentID, rhkSegmentID, _ := route.Params.GetOK("segmentID")
+	if err := o.bindSegmentID(rSegmentID, rhkSegmentID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindFlagID binds and validates parameter FlagID from path.
+func (o *FindRolloutRampsParams) bindFlagID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("flagID", "path", "int64", raw)
+	}
+	o.FlagID = value
+
+	if err := o.validateFlagID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFlagID carries on validations for parameter FlagID
+func (o *FindRolloutRampsParams) validateFlagID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("flagID", "path", o.FlagID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSegmentID binds and validates parameter SegmentID from path.
+func (o *FindRolloutRampsParams) bindSegmentID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("segmentID", "path", "int64", raw)
+	}
+	o.SegmentID = value
+
+	if err := o.validateSegmentID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSegmentID carries on validations for parameter SegmentID
+func (o *FindRolloutRampsParams) validateSegmentID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("segmentID", "path", o.SegmentID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package rollout_ramp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// FindRolloutRampsOKCode is the HTTP code returned for type FindRolloutRampsOK
+const FindRolloutRampsOKCode int = 200
+
+/*
+FindRolloutRampsOK rollout ramps of the segment
+
+swagger:response findRolloutRampsOK
+*/
+type FindRolloutRampsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.RolloutRamp `json:"body,omitempty"`
+}
+
+// NewFindRolloutRampsOK creates FindRolloutRampsOK with default headers values
+func NewFindRolloutRampsOK() *FindRolloutRampsOK {
+
+	return &FindRolloutRampsOK{}
+}
+
+// WithPayload adds the payload to the find rollout ramps o k response
+func (o *FindRolloutRampsOK) WithPayload(payload []*models.RolloutRamp) *FindRolloutRampsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find rollout ramps o k response
+func (o *FindRolloutRampsOK) SetPayload(payload []*models.RolloutRamp) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindRolloutRampsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.RolloutRamp, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+FindRolloutRampsDefault generic error response
+
+swagger:response findRolloutRampsDefault
+*/
+type FindRolloutRampsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindRolloutRampsDefault creates FindRolloutRampsDefault with default headers values
+func NewFindRolloutRampsDefault(code int) *FindRolloutRampsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &FindRolloutRampsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the find rollout ramps default response
+func (o *FindRolloutRampsDefault) WithStatusCode(code int) *FindRolloutRampsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the find rollout ramps default response
+func (o *FindRolloutRampsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the find rollout ramps default response
+func (o *FindRolloutRampsDefault) WithPayload(payload *models.Error) *FindRolloutRampsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find rollout ramps default response
+func (o *FindRolloutRampsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindRolloutRampsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
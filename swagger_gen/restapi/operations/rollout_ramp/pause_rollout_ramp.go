@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package rollout_ramp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PauseRolloutRampHandlerFunc turns a function with the right signature into a pause rollout ramp handler
+type PauseRolloutRampHandlerFunc func(PauseRolloutRampParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PauseRolloutRampHandlerFunc) Handle(params PauseRolloutRampParams) middleware.Responder {
+	return fn(params)
+}
+
+// PauseRolloutRampHandler interface for that can handle valid pause rollout ramp params
+type PauseRolloutRampHandler interface {
+	Handle(PauseRolloutRampParams) middleware.Responder
+}
+
+// NewPauseRolloutRamp creates a new http.Handler for the pause rollout ramp operation
+func NewPauseRolloutRamp(ctx *middleware.Context, handler PauseRolloutRampHandler) *PauseRolloutRamp {
+	return &PauseRolloutRamp{Context: ctx, Handler: handler}
+}
+
+/*
+	PauseRolloutRamp swagger:route PUT /flags/{flagID}/segments/{segmentID}/rollout_ramps/{rolloutRampID}/pause rolloutRamp pauseRolloutRamp
+
+Pause the active rollout ramp, the time left until the next step is kept for resuming
+*/
+type PauseRolloutRamp struct {
+	Context *middleware.Context
+	Handler PauseRolloutRampHandler
+}
+
+func (o *PauseRolloutRamp) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPauseRolloutRampParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,181 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package rollout_ramp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewPauseRolloutRampParams creates a new PauseRolloutRampParams object
+//
+// There are no default values defined in the spec.
+func NewPauseRolloutRampParams() PauseRolloutRampParams {
+
+	return PauseRolloutRampParams{}
+}
+
+// PauseRolloutRampParams contains all the bound params for the pause rollout ramp operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters pauseRolloutRamp
+type PauseRolloutRampParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*numeric ID of the flag
+	  Required: true
+	  Minimum: 1
+	  In: path
+	*/
+	FlagID int64
+	/*numeric ID of the rollout ramp
+	  Required: true
+	  Minimum: 1
+	  In: path
+	*/
+	RolloutRampID int64
+	/*numeric ID of the segment
+	  Required: true
+	  Minimum: 1
+	  In: path
+	*/
+	SegmentID int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPauseRolloutRampParams() beforehand.
+func (o *PauseRolloutRampParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rFlagID, rhkFlagID, _ := route.Params.GetOK("flagID")
+	if err := o.bindFlagID(rFlagID, rhkFlagID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rRolloutRampID, rhkRolloutRampID, _ := route.Params.GetOK("rolloutRampID")
+	if err := o.bindRolloutRampID(rRolloutRampID, rhkRolloutRampID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rSegmentID, rhkSegmentID, _ := route.Params.GetOK("segmentID")
+	if err := o.bindSegmentID(rSegmentID, rhkSegmentID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindFlagID binds and validates parameter FlagID from path.
+func (o *PauseRolloutRampParams) bindFlagID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("flagID", "path", "int64", raw)
+	}
+	o.FlagID = value
+
+	if err := o.validateFlagID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFlagID carries on validations for parameter FlagID
+func (o *PauseRolloutRampParams) validateFlagID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("flagID", "path", o.FlagID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindRolloutRampID binds and validates parameter RolloutRampID from path.
+func (o *PauseRolloutRampParams) bindRolloutRampID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("rolloutRampID", "path", "int64", raw)
+	}
+	o.RolloutRampID = value
+
+	if err := o.validateRolloutRampID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateRolloutRampID carries on validations for parameter RolloutRampID
+func (o *PauseRolloutRampParams) validateRolloutRampID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("rolloutRampID", "path", o.RolloutRampID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSegmentID binds and validates parameter SegmentID from path.
+func (o *PauseRolloutRampParams) bindSegmentID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("segmentID", "path", "int64", raw)
+	}
+	o.SegmentID = value
+
+	if err := o.validateSegmentID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSegmentID carries on validations for parameter SegmentID
+func (o *PauseRolloutRampParams) validateSegmentID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("segmentID", "path", o.SegmentID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package rollout_ramp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// PauseRolloutRampOKCode is the HTTP code returned for type PauseRolloutRampOK
+const PauseRolloutRampOKCode int = 200
+
+/*
+PauseRolloutRampOK the rollout ramp
+
+swagger:response pauseRolloutRampOK
+*/
+type PauseRolloutRampOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.RolloutRamp `json:"body,omitempty"`
+}
+
+// NewPauseRolloutRampOK creates PauseRolloutRampOK with default headers values
+func NewPauseRolloutRampOK() *PauseRolloutRampOK {
+
+	return &PauseRolloutRampOK{}
+}
+
+// WithPayload adds the payload to the pause rollout ramp o k response
+func (o *PauseRolloutRampOK) WithPayload(payload *models.RolloutRamp) *PauseRolloutRampOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pause rollout ramp o k response
+func (o *PauseRolloutRampOK) SetPayload(payload *models.RolloutRamp) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PauseRolloutRampOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+PauseRolloutRampDefault generic error response
+
+swagger:response pauseRolloutRampDefault
+*/
+type PauseRolloutRampDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPauseRolloutRampDefault creates PauseRolloutRampDefault with default headers values
+func NewPauseRolloutRampDefault(code int) *PauseRolloutRampDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PauseRolloutRampDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the pause rollout ramp default response
+func (o *PauseRolloutRampDefault) WithStatusCode(code int) *PauseRolloutRampDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the pause rollout ramp default response
+func (o *PauseRolloutRampDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the pause rollout ramp default response
+func (o *PauseRolloutRampDefault) WithPayload(payload *models.Error) *PauseRolloutRampDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the pause rollout ramp default response
+func (o *PauseRolloutRampDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PauseRolloutRampDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package rollout_ramp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// ResumeRolloutRampHandlerFunc turns a function with the right signature into a resume rollout ramp handler
+type ResumeRolloutRampHandlerFunc func(ResumeRolloutRampParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ResumeRolloutRampHandlerFunc) Handle(params ResumeRolloutRampParams) middleware.Responder {
+	return fn(params)
+}
+
+// ResumeRolloutRampHandler interface for that can handle valid resume rollout ramp params
+type ResumeRolloutRampHandler interface {
+	Handle(ResumeRolloutRampParams) middleware.Responder
+}
+
+// NewResumeRolloutRamp creates a new http.Handler for the resume rollout ramp operation
+func NewResumeRolloutRamp(ctx *middleware.Context, handler ResumeRolloutRampHandler) *ResumeRolloutRamp {
+	return &ResumeRolloutRamp{Context: ctx, Handler: handler}
+}
+
+/*
+	ResumeRolloutRamp swagger:route PUT /flags/{flagID}/segments/{segmentID}/rollout_ramps/{rolloutRampID}/resume rolloutRamp resumeRolloutRamp
+
+Resume the paused rollout ramp
+*/
+type ResumeRolloutRamp struct {
+	Context *middleware.Context
+	Handler ResumeRolloutRampHandler
+}
+
+func (o *ResumeRolloutRamp) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewResumeRolloutRampParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
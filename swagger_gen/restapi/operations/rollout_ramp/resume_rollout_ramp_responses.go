@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package rollout_ramp
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// ResumeRolloutRampOKCode is the HTTP code returned for type ResumeRolloutRampOK
+const ResumeRolloutRampOKCode int = 200
+
+/*
+ResumeRolloutRampOK the rollout ramp
+
+swagger:response resumeRolloutRampOK
+*/
+type ResumeRolloutRampOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.RolloutRamp `json:"body,omitempty"`
+}
+
+// NewResumeRolloutRampOK creates ResumeRolloutRampOK with default headers values
+func NewResumeRolloutRampOK() *ResumeRolloutRampOK {
+
+	return &ResumeRolloutRampOK{}
+}
+
+// WithPayload adds the payload to the resume rollout ramp o k response
+func (o *ResumeRolloutRampOK) WithPayload(payload *models.RolloutRamp) *ResumeRolloutRampOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the resume rollout ramp o k response
+func (o *ResumeRolloutRampOK) SetPayload(payload *models.RolloutRamp) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResumeRolloutRampOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+ResumeRolloutRampDefault generic error response
+
+swagger:response resumeRolloutRampDefault
+*/
+type ResumeRolloutRampDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewResumeRolloutRampDefault creates ResumeRolloutRampDefault with default headers values
+func NewResumeRolloutRampDefault(code int) *ResumeRolloutRampDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ResumeRolloutRampDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the resume rollout ramp default response
+func (o *ResumeRolloutRampDefault) WithStatusCode(code int) *ResumeRolloutRampDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the resume rollout ramp default response
+func (o *ResumeRolloutRampDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the resume rollout ramp default response
+func (o *ResumeRolloutRampDefault) WithPayload(payload *models.Error) *ResumeRolloutRampDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the resume rollout ramp default response
+func (o *ResumeRolloutRampDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResumeRolloutRampDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scheduled_change
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CancelScheduledChangeHandlerFunc turns a function with the right signature into a cancel scheduled change handler
+type CancelScheduledChangeHandlerFunc func(CancelScheduledChangeParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CancelScheduledChangeHandlerFunc) Handle(params CancelScheduledChangeParams) middleware.Responder {
+	return fn(params)
+}
+
+// CancelScheduledChangeHandler interface for that can handle valid cancel scheduled change params
+type CancelScheduledChangeHandler interface {
+	Handle(CancelScheduledChangeParams) middleware.Responder
+}
+
+// NewCancelScheduledChange creates a new http.Handler for the cancel scheduled change operation
+func NewCancelScheduledChange(ctx *middleware.Context, handler CancelScheduledChangeHandler) *CancelScheduledChange {
+	return &CancelScheduledChange{Context: ctx, Handler: handler}
+}
+
+/*
+	CancelScheduledChange swagger:route DELETE /flags/{flagID}/scheduled_changes/{scheduledChangeID} scheduledChange cancelScheduledChange
+
+Cancel the scheduled change, only a pending one can be canceled
+*/
+type CancelScheduledChange struct {
+	Context *middleware.Context
+	Handler CancelScheduledChangeHandler
+}
+
+func (o *CancelScheduledChange) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCancelScheduledChangeParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
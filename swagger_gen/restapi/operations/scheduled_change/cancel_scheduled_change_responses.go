@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scheduled_change
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// CancelScheduledChangeOKCode is the HTTP code returned for type CancelScheduledChangeOK
+const CancelScheduledChangeOKCode int = 200
+
+/*
+CancelScheduledChangeOK the canceled scheduled change
+
+swagger:response cancelScheduledChangeOK
+*/
+type CancelScheduledChangeOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ScheduledChange `json:"body,omitempty"`
+}
+
+// NewCancelScheduledChangeOK creates CancelScheduledChangeOK with default headers values
+func NewCancelScheduledChangeOK() *CancelScheduledChangeOK {
+
+	return &CancelScheduledChangeOK{}
+}
+
+// WithPayload adds the payload to the cancel scheduled change o k response
+func (o *CancelScheduledChangeOK) WithPayload(payload *models.ScheduledChange) *CancelScheduledChangeOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel scheduled change o k response
+func (o *CancelScheduledChangeOK) SetPayload(payload *models.ScheduledChange) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelScheduledChangeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+CancelScheduledChangeDefault generic error response
+
+swagger:response cancelScheduledChangeDefault
+*/
+type CancelScheduledChangeDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCancelScheduledChangeDefault creates CancelScheduledChangeDefault with default headers values
+func NewCancelScheduledChangeDefault(code int) *CancelScheduledChangeDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CancelScheduledChangeDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the cancel scheduled change default response
+func (o *CancelScheduledChangeDefault) WithStatusCode(code int) *CancelScheduledChangeDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the cancel scheduled change default response
+func (o *CancelScheduledChangeDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the cancel scheduled change default response
+func (o *CancelScheduledChangeDefault) WithPayload(payload *models.Error) *CancelScheduledChangeDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel scheduled change default response
+func (o *CancelScheduledChangeDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelScheduledChangeDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scheduled_change
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreateScheduledChangeHandlerFunc turns a function with the right signature into a create scheduled change handler
+type CreateScheduledChangeHandlerFunc func(CreateScheduledChangeParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateScheduledChangeHandlerFunc) Handle(params CreateScheduledChangeParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateScheduledChangeHandler interface for that can handle valid create scheduled change params
+type CreateScheduledChangeHandler interface {
+	Handle(CreateScheduledChangeParams) middleware.Responder
+}
+
+// NewCreateScheduledChange creates a new http.Handler for the create scheduled change operation
+func NewCreateScheduledChange(ctx *middleware.Context, handler CreateScheduledChangeHandler) *CreateScheduledChange {
+	return &CreateScheduledChange{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateScheduledChange swagger:route POST /flags/{flagID}/scheduled_changes scheduledChange createScheduledChange
+
+CreateScheduledChange create scheduled change API
+*/
+type CreateScheduledChange struct {
+	Context *middleware.Context
+	Handler CreateScheduledChangeHandler
+}
+
+func (o *CreateScheduledChange) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateScheduledChangeParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
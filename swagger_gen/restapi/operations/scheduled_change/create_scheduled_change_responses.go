@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scheduled_change
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// CreateScheduledChangeOKCode is the HTTP code returned for type CreateScheduledChangeOK
+const CreateScheduledChangeOKCode int = 200
+
+/*
+CreateScheduledChangeOK the scheduled change created
+
+swagger:response createScheduledChangeOK
+*/
+type CreateScheduledChangeOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ScheduledChange `json:"body,omitempty"`
+}
+
+// NewCreateScheduledChangeOK creates CreateScheduledChangeOK with default headers values
+func NewCreateScheduledChangeOK() *CreateScheduledChangeOK {
+
+	return &CreateScheduledChangeOK{}
+}
+
+// WithPayload adds the payload to the create scheduled change o k response
+func (o *CreateScheduledChangeOK) WithPayload(payload *models.ScheduledChange) *CreateScheduledChangeOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create scheduled change o k response
+func (o *CreateScheduledChangeOK) SetPayload(payload *models.ScheduledChange) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateScheduledChangeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+CreateScheduledChangeDefault generic error response
+
+swagger:response createScheduledChangeDefault
+*/
+type CreateScheduledChangeDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateScheduledChangeDefault creates CreateScheduledChangeDefault with default headers values
+func NewCreateScheduledChangeDefault(code int) *CreateScheduledChangeDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateScheduledChangeDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create scheduled change default response
+func (o *CreateScheduledChangeDefault) WithStatusCode(code int) *CreateScheduledChangeDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create scheduled change default response
+func (o *CreateScheduledChangeDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create scheduled change default response
+func (o *CreateScheduledChangeDefault) WithPayload(payload *models.Error) *CreateScheduledChangeDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create scheduled change default response
+func (o *CreateScheduledChangeDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateScheduledChangeDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
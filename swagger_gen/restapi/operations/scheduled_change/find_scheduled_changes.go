@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scheduled_change
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// FindScheduledChangesHandlerFunc turns a function with the right signature into a find scheduled changes handler
+type FindScheduledChangesHandlerFunc func(FindScheduledChangesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn FindScheduledChangesHandlerFunc) Handle(params FindScheduledChangesParams) middleware.Responder {
+	return fn(params)
+}
+
+// FindScheduledChangesHandler interface for that can handle valid find scheduled changes params
+type FindScheduledChangesHandler interface {
+	Handle(FindScheduledChangesParams) middleware.Responder
+}
+
+// NewFindScheduledChanges creates a new http.Handler for the find scheduled changes operation
+func NewFindScheduledChanges(ctx *middleware.Context, handler FindScheduledChangesHandler) *FindScheduledChanges {
+	return &FindScheduledChanges{Context: ctx, Handler: handler}
+}
+
+/*
+	FindScheduledChanges swagger:route GET /flags/{flagID}/scheduled_changes scheduledChange findScheduledChanges
+
+FindScheduledChanges find scheduled changes API
+*/
+type FindScheduledChanges struct {
+	Context *middleware.Context
+	Handler FindScheduledChangesHandler
+}
+
+func (o *FindScheduledChanges) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewFindScheduledChangesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scheduled_change
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// FindScheduledChangesOKCode is the HTTP code returned for type FindScheduledChangesOK
+const FindScheduledChangesOKCode int = 200
+
+/*
+FindScheduledChangesOK scheduled changes of the flag ordered by executeAt
+
+swagger:response findScheduledChangesOK
+*/
+type FindScheduledChangesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.ScheduledChange `json:"body,omitempty"`
+}
+
+// NewFindScheduledChangesOK creates FindScheduledChangesOK with default headers values
+func NewFindScheduledChangesOK() *FindScheduledChangesOK {
+
+	return &FindScheduledChangesOK{}
+}
+
+// WithPayload adds the payload to the find scheduled changes o k response
+func (o *FindScheduledChangesOK) WithPayload(payload []*models.ScheduledChange) *FindScheduledChangesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find scheduled changes o k response
+func (o *FindScheduledChangesOK) SetPayload(payload []*models.ScheduledChange) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindScheduledChangesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.ScheduledChange, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+FindScheduledChangesDefault generic error response
+
+swagger:response findScheduledChangesDefault
+*/
+type FindScheduledChangesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindScheduledChangesDefault creates FindScheduledChangesDefault with default headers values
+func NewFindScheduledChangesDefault(code int) *FindScheduledChangesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &FindScheduledChangesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the find scheduled changes default response
+func (o *FindScheduledChangesDefault) WithStatusCode(code int) *FindScheduledChangesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the find scheduled changes default response
+func (o *FindScheduledChangesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the find scheduled changes default response
+func (o *FindScheduledChangesDefault) WithPayload(payload *models.Error) *FindScheduledChangesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find scheduled changes default response
+func (o *FindScheduledChangesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindScheduledChangesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package variant_override
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// CreateVariantOverrideHandlerFunc turns a function with the right signature into a create variant override handler
+type CreateVariantOverrideHandlerFunc func(CreateVariantOverrideParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateVariantOverrideHandlerFunc) Handle(params CreateVariantOverrideParams) middleware.Responder {
+	return fn(params)
+}
+
+// CreateVariantOverrideHandler interface for that can handle valid create variant override params
+type CreateVariantOverrideHandler interface {
+	Handle(CreateVariantOverrideParams) middleware.Responder
+}
+
+// NewCreateVariantOverride creates a new http.Handler for the create variant override operation
+func NewCreateVariantOverride(ctx *middleware.Context, handler CreateVariantOverrideHandler) *CreateVariantOverride {
+	return &CreateVariantOverride{Context: ctx, Handler: handler}
+}
+
+/*
+	CreateVariantOverride swagger:route POST /flags/{flagID}/overrides variantOverride createVariantOverride
+
+Pin the entity to the variant regardless of the segments and the rollout. An entity can only have one override per entityType of the flag.
+*/
+type CreateVariantOverride struct {
+	Context *middleware.Context
+	Handler CreateVariantOverrideHandler
+}
+
+func (o *CreateVariantOverride) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateVariantOverrideParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package variant_override
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// CreateVariantOverrideOKCode is the HTTP code returned for type CreateVariantOverrideOK
+const CreateVariantOverrideOKCode int = 200
+
+/*
+CreateVariantOverrideOK variant override just created
+
+swagger:response createVariantOverrideOK
+*/
+type CreateVariantOverrideOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.VariantOverride `json:"body,omitempty"`
+}
+
+// NewCreateVariantOverrideOK creates CreateVariantOverrideOK with default headers values
+func NewCreateVariantOverrideOK() *CreateVariantOverrideOK {
+
+	return &CreateVariantOverrideOK{}
+}
+
+// WithPayload adds the payload to the create variant override o k response
+func (o *CreateVariantOverrideOK) WithPayload(payload *models.VariantOverride) *CreateVariantOverrideOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create variant override o k response
+func (o *CreateVariantOverrideOK) SetPayload(payload *models.VariantOverride) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateVariantOverrideOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*
+CreateVariantOverrideDefault generic error response
+
+swagger:response createVariantOverrideDefault
+*/
+type CreateVariantOverrideDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateVariantOverrideDefault creates CreateVariantOverrideDefault with default headers values
+func NewCreateVariantOverrideDefault(code int) *CreateVariantOverrideDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateVariantOverrideDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create variant override default response
+func (o *CreateVariantOverrideDefault) WithStatusCode(code int) *CreateVariantOverrideDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create variant override default response
+func (o *CreateVariantOverrideDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create variant override default response
+func (o *CreateVariantOverrideDefault) WithPayload(payload *models.Error) *CreateVariantOverrideDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create variant override default response
+func (o *CreateVariantOverrideDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateVariantOverrideDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
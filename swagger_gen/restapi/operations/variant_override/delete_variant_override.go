@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package variant_override
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteVariantOverrideHandlerFunc turns a function with the right signature into a delete variant override handler
+type DeleteVariantOverrideHandlerFunc func(DeleteVariantOverrideParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteVariantOverrideHandlerFunc) Handle(params DeleteVariantOverrideParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteVariantOverrideHandler interface for that can handle valid delete variant override params
+type DeleteVariantOverrideHandler interface {
+	Handle(DeleteVariantOverrideParams) middleware.Responder
+}
+
+// NewDeleteVariantOverride creates a new http.Handler for the delete variant override operation
+func NewDeleteVariantOverride(ctx *middleware.Context, handler DeleteVariantOverrideHandler) *DeleteVariantOverride {
+	return &DeleteVariantOverride{Context: ctx, Handler: handler}
+}
+
+/*
+	DeleteVariantOverride swagger:route DELETE /flags/{flagID}/overrides/{variantOverrideID} variantOverride deleteVariantOverride
+
+DeleteVariantOverride delete variant override API
+*/
+type DeleteVariantOverride struct {
+	Context *middleware.Context
+	Handler DeleteVariantOverrideHandler
+}
+
+func (o *DeleteVariantOverride) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteVariantOverrideParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package variant_override
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewDeleteVariantOverrideParams creates a new DeleteVariantOverrideParams object
+//
+// There are no default values defined in the spec.
+func NewDeleteVariantOverrideParams() DeleteVariantOverrideParams {
+
+	return DeleteVariantOverrideParams{}
+}
+
+// DeleteVariantOverrideParams contains all the bound params for the delete variant override operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters deleteVariantOverride
+type DeleteVariantOverrideParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*numeric ID of the flag
+	  Required: true
+	  Minimum: 1
+	  In: path
+	*/
+	FlagID int64
+	/*numeric ID of the variant override
+	  Required: true
+	  Minimum: 1
+	  In: path
+	*/
+	VariantOverrideID int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewDeleteVariantOverrideParams() beforehand.
+func (o *DeleteVariantOverrideParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rFlagID, rhkFlagID, _ := route.Params.GetOK("flagID")
+	if err := o.bindFlagID(rFlagID, rhkFlagID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rVariantOverrideID, rhkVariantOverrideID, _ := route.Params.GetOK("variantOverrideID")
+	if err := o.bindVariantOverrideID(rVariantOverrideID, rhkVariantOverrideID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindFlagID binds and validates parameter FlagID from path.
+func (o *DeleteVariantOverrideParams) bindFlagID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("flagID", "path", "int64", raw)
+	}
+	o.FlagID = value
+
+	if err := o.validateFlagID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFlagID carries on validations for parameter FlagID
+func (o *DeleteVariantOverrideParams) validateFlagID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("flagID", "path", o.FlagID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindVariantOverrideID binds and validates parameter VariantOverrideID from path.
+func (o *DeleteVariantOverrideParams) bindVariantOverrideID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("variantOverrideID", "path", "int64", raw)
+	}
+	o.VariantOverrideID = value
+
+	if err := o.validateVariantOverrideID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateVariantOverrideID carries on validations for parameter VariantOverrideID
+func (o *DeleteVariantOverrideParams) validateVariantOverrideID(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("variantOverrideID", "path", o.VariantOverrideID, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
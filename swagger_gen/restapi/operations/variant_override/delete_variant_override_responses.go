@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package variant_override
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// DeleteVariantOverrideOKCode is the HTTP code returned for type DeleteVariantOverrideOK
+const DeleteVariantOverrideOKCode int = 200
+
+/*
+DeleteVariantOverrideOK OK deleted
+
+swagger:response deleteVariantOverrideOK
+*/
+type DeleteVariantOverrideOK struct {
+}
+
+// NewDeleteVariantOverrideOK creates DeleteVariantOverrideOK with default headers values
+func NewDeleteVariantOverrideOK() *DeleteVariantOverrideOK {
+
+	return &DeleteVariantOverrideOK{}
+}
+
+// WriteResponse to the client
+func (o *DeleteVariantOverrideOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+/*
+DeleteVariantOverrideDefault generic error response
+
+swagger:response deleteVariantOverrideDefault
+*/
+type DeleteVariantOverrideDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteVariantOverrideDefault creates DeleteVariantOverrideDefault with default headers values
+func NewDeleteVariantOverrideDefault(code int) *DeleteVariantOverrideDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteVariantOverrideDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete variant override default response
+func (o *DeleteVariantOverrideDefault) WithStatusCode(code int) *DeleteVariantOverrideDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete variant override default response
+func (o *DeleteVariantOverrideDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete variant override default response
+func (o *DeleteVariantOverrideDefault) WithPayload(payload *models.Error) *DeleteVariantOverrideDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete variant override default response
+func (o *DeleteVariantOverrideDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteVariantOverrideDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
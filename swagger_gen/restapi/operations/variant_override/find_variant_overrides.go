@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package variant_override
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// FindVariantOverridesHandlerFunc turns a function with the right signature into a find variant overrides handler
+type FindVariantOverridesHandlerFunc func(FindVariantOverridesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn FindVariantOverridesHandlerFunc) Handle(params FindVariantOverridesParams) middleware.Responder {
+	return fn(params)
+}
+
+// FindVariantOverridesHandler interface for that can handle valid find variant overrides params
+type FindVariantOverridesHandler interface {
+	Handle(FindVariantOverridesParams) middleware.Responder
+}
+
+// NewFindVariantOverrides creates a new http.Handler for the find variant overrides operation
+func NewFindVariantOverrides(ctx *middleware.Context, handler FindVariantOverridesHandler) *FindVariantOverrides {
+	return &FindVariantOverrides{Context: ctx, Handler: handler}
+}
+
+/*
+	FindVariantOverrides swagger:route GET /flags/{flagID}/overrides variantOverride findVariantOverrides
+
+FindVariantOverrides find variant overrides API
+*/
+type FindVariantOverrides struct {
+	Context *middleware.Context
+	Handler FindVariantOverridesHandler
+}
+
+func (o *FindVariantOverrides) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewFindVariantOverridesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
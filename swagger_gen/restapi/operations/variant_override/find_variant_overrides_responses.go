@@ -0,0 +1,121 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package variant_override
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openflagr/flagr/swagger_gen/models"
+)
+
+// FindVariantOverridesOKCode is the HTTP code returned for type FindVariantOverridesOK
+const FindVariantOverridesOKCode int = 200
+
+/*
+FindVariantOverridesOK variant overrides of the flag
+
+swagger:response findVariantOverridesOK
+*/
+type FindVariantOverridesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.VariantOverride `json:"body,omitempty"`
+}
+
+// NewFindVariantOverridesOK creates FindVariantOverridesOK with default headers values
+func NewFindVariantOverridesOK() *FindVariantOverridesOK {
+
+	return &FindVariantOverridesOK{}
+}
+
+// WithPayload adds the payload to the find variant overrides o k response
+func (o *FindVariantOverridesOK) WithPayload(payload []*models.VariantOverride) *FindVariantOverridesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find variant overrides o k response
+func (o *FindVariantOverridesOK) SetPayload(payload []*models.VariantOverride) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVariantOverridesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.VariantOverride, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*
+FindVariantOverridesDefault generic error response
+
+swagger:response findVariantOverridesDefault
+*/
+type FindVariantOverridesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVariantOverridesDefault creates FindVariantOverridesDefault with default headers values
+func NewFindVariantOverridesDefault(code int) *FindVariantOverridesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &FindVariantOverridesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the find variant overrides default response
+func (o *FindVariantOverridesDefault) WithStatusCode(code int) *FindVariantOverridesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the find variant overrides default response
+func (o *FindVariantOverridesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the find variant overrides default response
+func (o *FindVariantOverridesDefault) WithPayload(payload *models.Error) *FindVariantOverridesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find variant overrides default response
+func (o *FindVariantOverridesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVariantOverridesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}